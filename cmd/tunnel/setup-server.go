@@ -6,6 +6,7 @@ import (
 	"net/http/pprof"
 
 	"github.com/gradusp/crispy-tunnel/internal/api/tunnel"
+	"github.com/gradusp/crispy-tunnel/internal/app"
 	tracing "github.com/gradusp/go-platform/app/tracing/ot"
 	"github.com/gradusp/go-platform/server"
 	"github.com/gradusp/go-platform/server/interceptors"
@@ -37,8 +38,43 @@ func pprofHandler() http.Handler { //TODO: Maybe it`s better to place 'pprofHand
 	return r
 }
 
-func setupServer(ctx context.Context) (*server.APIServer, error) {
-	service := tunnel.NewTunnelService(ctx)
+//setupServerOptions makes options of API server; the same options serve every listener the service is exposed on
+func setupServerOptions(ctx context.Context) ([]server.APIServerOption, error) {
+	serviceOpts, err := tunnelOptionsFromConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	//если есть регистр Прометеуса то - подключим метрики внешних команд, вебхука и семафора
+	WhenHaveMetricsRegistry(func(reg *prometheus.Registry) {
+		em := tunnel.NewExecMetrics()
+		if err = reg.Register(em); err != nil {
+			return
+		}
+		wm := tunnel.NewWebhookMetrics()
+		if err = reg.Register(wm); err != nil {
+			return
+		}
+		cm := tunnel.NewConcurrencyMetrics()
+		if err = reg.Register(cm); err != nil {
+			return
+		}
+		serviceOpts = append(serviceOpts,
+			tunnel.WithExecMetrics(em), tunnel.WithWebhookMetrics(wm), tunnel.WithConcurrencyMetrics(cm),
+		)
+		//метрики каждого туннеля - только если включены явно: их число растет с числом туннелей
+		if perTunnel, _ := app.MetricsPerTunnel.Maybe(ctx); perTunnel {
+			maxSeries, _ := app.MetricsPerTunnelMaxSeries.Maybe(ctx)
+			tm := tunnel.NewTunnelMetrics(maxSeries)
+			if err = reg.Register(tm); err != nil {
+				return
+			}
+			serviceOpts = append(serviceOpts, tunnel.WithTunnelMetrics(tm))
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	service := tunnel.NewTunnelService(ctx, serviceOpts...)
 	doc, err := tunnel.GetSwaggerDocs()
 	if err != nil {
 		return nil, err
@@ -86,5 +122,21 @@ func setupServer(ctx context.Context) (*server.APIServer, error) {
 		return nil, err
 	}
 	opts = append(opts, server.WithHttpHandler("/debug", pprofHandler()))
-	return server.NewAPIServer(opts...)
+
+	//swagger с адресом сервера из конфигурации
+	endpoint, _ := app.ServerEndpoint.Maybe(ctx)
+	externalURL, _ := app.ServerExternalURL.Maybe(ctx)
+	var swaggerHandler http.Handler
+	if swaggerHandler, err = tunnel.DynamicSwaggerHandler(endpoint, externalURL); err != nil {
+		return nil, err
+	}
+	opts = append(opts, server.WithHttpHandler("/swagger.json", swaggerHandler))
+
+	//REST шлюз сжимающий ответы для клиентов с 'Accept-Encoding: gzip'
+	var gatewayHandler http.Handler
+	if gatewayHandler, err = tunnel.GzipGatewayHandler(ctx, endpoint); err != nil {
+		return nil, err
+	}
+	opts = append(opts, server.WithHttpHandler(tunnel.GatewayPathPrefix, gatewayHandler))
+	return opts, nil
 }
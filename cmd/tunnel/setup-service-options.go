@@ -0,0 +1,267 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/gradusp/crispy-tunnel/internal/api/tunnel"
+	"github.com/gradusp/crispy-tunnel/internal/app"
+	"github.com/gradusp/crispy-tunnel/internal/config"
+	tunnelPb "github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/pkg/errors"
+)
+
+//tunnelOptionsFromConfig makes tunnel service options of 'tunnel/...' config keys;
+//missing key keeps service default, key of wrong type fails the setup
+func tunnelOptionsFromConfig(ctx context.Context) ([]tunnel.TunnelServiceOption, error) {
+	c := &optionsReader{ctx: ctx}
+	var ret []tunnel.TunnelServiceOption
+
+	if c.flag(app.TunnelReadOnly) {
+		ret = append(ret, tunnel.WithReadOnly())
+	}
+	if d, ok := c.duration(app.TunnelReadTimeout); ok {
+		ret = append(ret, tunnel.WithReadTimeout(d))
+	}
+	if d, ok := c.duration(app.TunnelWriteTimeout); ok {
+		ret = append(ret, tunnel.WithWriteTimeout(d))
+	}
+	if n, ok := c.integer(app.TunnelMaxConcurrency); ok {
+		ret = append(ret, tunnel.WithMaxConcurrency(n))
+	}
+	if n, ok := c.unsigned(app.TunnelMaxTunnels); ok {
+		ret = append(ret, tunnel.WithMaxTunnels(uint32(n)))
+	}
+	if n, ok := c.unsigned(app.TunnelMinMtu); ok {
+		ret = append(ret, tunnel.WithMinMtu(uint32(n)))
+	}
+	if s, ok := c.str(app.TunnelFirewallBackend); ok {
+		ret = append(ret, tunnel.WithFirewallBackend(s))
+	}
+	if s, ok := c.str(app.TunnelMaintenanceStateFile); ok && len(s) > 0 {
+		ret = append(ret, tunnel.WithMaintenanceStateFile(s))
+	}
+	if s, ok := c.str(app.TunnelExecEnv); ok {
+		ret = append(ret, tunnel.WithExecEnv(strings.Fields(s)))
+	}
+	if on, ok := c.boolean(app.TunnelExecTracePropagation); ok {
+		ret = append(ret, tunnel.WithExecTracePropagation(on))
+	}
+	if s, ok := c.str(app.TunnelProcPath); ok && len(s) > 0 {
+		ret = append(ret, tunnel.WithProcPath(s))
+	}
+	if s, ok := c.str(app.TunnelPingPath); ok && len(s) > 0 {
+		ret = append(ret, tunnel.WithPingPath(s))
+	}
+	if on, ok := c.boolean(app.TunnelSpans); ok {
+		ret = append(ret, tunnel.WithSpans(on))
+	}
+	if on, ok := c.boolean(app.TunnelIndexAnnotation); ok {
+		ret = append(ret, tunnel.WithTunnelIndexAnnotation(on))
+	}
+	if on, ok := c.boolean(app.TunnelBestEffortSysctl); ok {
+		ret = append(ret, tunnel.WithBestEffortSysctl(on))
+	}
+	if c.flag(app.TunnelUnmanagedAcknowledged) {
+		ret = append(ret, tunnel.WithUnmanagedAcknowledged())
+	}
+	if c.flag(app.TunnelResumableAdd) {
+		ret = append(ret, tunnel.WithResumableAdd())
+	}
+	if d, ok := c.duration(app.TunnelOperUpWait); ok {
+		ret = append(ret, tunnel.WithOperUpWait(d))
+	}
+	if sysctls, ok := c.sysctls(app.TunnelSysctls); ok {
+		ret = append(ret, tunnel.WithTunnelSysctls(sysctls))
+	}
+
+	downGrace, ok1 := c.duration(app.TunnelHealthDownGrace)
+	upStable, ok2 := c.duration(app.TunnelHealthUpStable)
+	if ok1 || ok2 {
+		ret = append(ret, tunnel.WithHealthDebounce(downGrace, upStable))
+	}
+	healthTTL, ok1 := c.duration(app.TunnelHealthCacheTTL)
+	healthJitter, ok2 := c.duration(app.TunnelHealthCacheJitter)
+	if ok1 || ok2 {
+		ret = append(ret, tunnel.WithHealthCache(
+			orDuration(healthTTL, ok1, tunnel.DefaultHealthCacheTTL),
+			orDuration(healthJitter, ok2, tunnel.DefaultHealthCacheJitter),
+		))
+	}
+	idemTTL, ok1 := c.duration(app.TunnelIdempotencyTTL)
+	idemSize, ok2 := c.integer(app.TunnelIdempotencyCacheSize)
+	if ok1 || ok2 {
+		ret = append(ret, tunnel.WithIdempotencyCache(
+			orDuration(idemTTL, ok1, tunnel.DefaultIdempotencyTTL),
+			orInt(idemSize, ok2, tunnel.DefaultIdempotencyCacheSize),
+		))
+	}
+	tombTTL, ok1 := c.duration(app.TunnelTombstonesTTL)
+	tombSize, ok2 := c.integer(app.TunnelTombstonesSize)
+	if ok1 || ok2 {
+		ret = append(ret, tunnel.WithRemoveTombstones(tombTTL, tombSize))
+	}
+	perTunnel, ok1 := c.integer(app.TunnelHistoryPerTunnel)
+	historyTTL, ok2 := c.duration(app.TunnelHistoryTTL)
+	if ok1 || ok2 {
+		ret = append(ret, tunnel.WithTunnelHistory(perTunnel, historyTTL))
+	}
+	attempts, ok1 := c.integer(app.TunnelModuleLoadAttempts)
+	backoff, ok2 := c.duration(app.TunnelModuleLoadBackoff)
+	if ok1 || ok2 {
+		ret = append(ret, tunnel.WithModuleLoad(attempts, backoff))
+	}
+	if url, ok := c.str(app.TunnelWebhookURL); ok && len(url) > 0 {
+		timeout, ok1 := c.duration(app.TunnelWebhookTimeout)
+		retries, ok2 := c.integer(app.TunnelWebhookRetries)
+		ret = append(ret, tunnel.WithWebhook(url,
+			orDuration(timeout, ok1, tunnel.DefaultWebhookTimeout),
+			orInt(retries, ok2, tunnel.DefaultWebhookRetries),
+		))
+	}
+	if names, ok := c.str(app.TunnelTemplateNames); ok {
+		for _, name := range strings.FieldsFunc(names, isNamesSeparator) {
+			ret = append(ret, tunnel.WithTemplate(name, c.template(name)))
+		}
+	}
+	if c.err != nil {
+		return nil, c.err
+	}
+	return ret, nil
+}
+
+//optionsReader reads optional config keys; the first malformed key is kept in 'err'
+type optionsReader struct {
+	ctx context.Context
+	err error
+}
+
+//check tells if key value is present; malformed value is remembered as error and is treated as missing
+func (c *optionsReader) check(err error) bool {
+	if err == nil {
+		return true
+	}
+	if !errors.Is(err, config.ErrNotFound) && c.err == nil {
+		c.err = err
+	}
+	return false
+}
+
+func (c *optionsReader) boolean(v config.ValueBool) (bool, bool) {
+	x, err := v.Maybe(c.ctx)
+	return x, c.check(err)
+}
+
+//flag is true only if key is present and is true
+func (c *optionsReader) flag(v config.ValueBool) bool {
+	x, ok := c.boolean(v)
+	return ok && x
+}
+
+func (c *optionsReader) integer(v config.ValueInt) (int, bool) {
+	x, err := v.Maybe(c.ctx)
+	return x, c.check(err)
+}
+
+func (c *optionsReader) unsigned(v config.ValueUInt) (uint, bool) {
+	x, err := v.Maybe(c.ctx)
+	return x, c.check(err)
+}
+
+func (c *optionsReader) str(v config.ValueString) (string, bool) {
+	x, err := v.Maybe(c.ctx)
+	return x, c.check(err)
+}
+
+func (c *optionsReader) duration(v config.ValueDuration) (time.Duration, bool) {
+	x, err := v.Maybe(c.ctx)
+	return x, c.check(err)
+}
+
+//template reads AddTunnel template 'name' of 'tunnel/templates/<name>/...' keys
+func (c *optionsReader) template(name string) tunnel.TunnelTemplate {
+	key := func(field string) string {
+		return app.TunnelTemplateKey(name, field)
+	}
+	var t tunnel.TunnelTemplate
+	t.Type, _ = c.str(config.ValueString(key("type")))
+	if n, ok := c.unsigned(config.ValueUInt(key("mtu"))); ok {
+		t.Mtu = uint32(n)
+	}
+	if n, ok := c.unsigned(config.ValueUInt(key("ttl"))); ok {
+		t.Ttl = uint32(n)
+	}
+	t.NoArp = c.linkFlag(key("no-arp"))
+	t.Multicast = c.linkFlag(key("multicast"))
+	if n, ok := c.unsigned(config.ValueUInt(key("weight"))); ok {
+		t.Weight = uint32(n)
+	}
+	t.ClampMss, _ = c.boolean(config.ValueBool(key("clamp-mss")))
+	if n, ok := c.unsigned(config.ValueUInt(key("mss-value"))); ok {
+		t.MssValue = uint32(n)
+	}
+	t.FwMark, _ = c.str(config.ValueString(key("fw-mark")))
+	t.Sysctls, _ = c.sysctls(config.ValueString(key("sysctls")))
+	return t
+}
+
+//sysctls reads space separated 'name=value' sysctls
+func (c *optionsReader) sysctls(v config.ValueString) (map[string]string, bool) {
+	s, ok := c.str(v)
+	if !ok {
+		return nil, false
+	}
+	ret := make(map[string]string)
+	for _, kv := range strings.Fields(s) {
+		i := strings.IndexByte(kv, '=')
+		if i <= 0 {
+			c.check(errors.Errorf("'%s': '%s' is not 'name=value'", v, kv))
+			continue
+		}
+		ret[kv[:i]] = kv[i+1:]
+	}
+	return ret, true
+}
+
+//linkFlag reads link flag as enum name 'LINK_FLAG_ON' or as 'on'/'off'/'default';
+//YAML reads bare on/off as booleans so 'true'/'false' are taken for them
+func (c *optionsReader) linkFlag(key string) tunnelPb.LinkFlag {
+	s, ok := c.str(config.ValueString(key))
+	if !ok || len(s) == 0 {
+		return tunnelPb.LinkFlag_LINK_FLAG_DEFAULT
+	}
+	name := strings.ToUpper(s)
+	switch name {
+	case "TRUE":
+		name = "ON"
+	case "FALSE":
+		name = "OFF"
+	}
+	if !strings.HasPrefix(name, "LINK_FLAG_") {
+		name = "LINK_FLAG_" + name
+	}
+	v, found := tunnelPb.LinkFlag_value[name]
+	if !found {
+		c.check(errors.Errorf("'%s': '%s' is not link flag", key, s))
+	}
+	return tunnelPb.LinkFlag(v)
+}
+
+func isNamesSeparator(r rune) bool {
+	return r == ',' || r == ' ' || r == '\t'
+}
+
+func orDuration(v time.Duration, ok bool, def time.Duration) time.Duration {
+	if ok {
+		return v
+	}
+	return def
+}
+
+func orInt(v int, ok bool, def int) int {
+	if ok {
+		return v
+	}
+	return def
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/gradusp/crispy-tunnel/internal/app"
+	"github.com/gradusp/crispy-tunnel/internal/config"
+	"github.com/gradusp/go-platform/logger"
+	pkgNet "github.com/gradusp/go-platform/pkg/net"
+	"github.com/pkg/errors"
+)
+
+const (
+	//defUnixSocketMode socket is reachable by owner and its group
+	defUnixSocketMode os.FileMode = 0660
+
+	//unixSocketPollInterval how often socket file is checked until server creates it
+	unixSocketPollInterval = 50 * time.Millisecond
+)
+
+//unixSocket Unix domain socket the server listens on in addition to TCP endpoint
+type unixSocket struct {
+	path string
+	mode os.FileMode
+}
+
+//setupUnixSocket reads optional Unix domain socket from config; nil if it is not configured
+func setupUnixSocket(ctx context.Context) (*unixSocket, error) {
+	path, err := app.ServerUnixSocket.Maybe(ctx)
+	if errors.Is(err, config.ErrNotFound) || (err == nil && len(path) == 0) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	ret := &unixSocket{path: filepath.Clean(path), mode: defUnixSocketMode}
+	if m, e := app.ServerUnixSocketMode.Maybe(ctx); e == nil && len(m) > 0 {
+		var mode uint64
+		if mode, err = strconv.ParseUint(m, 8, 32); err != nil || mode > 0777 {
+			return nil, errors.Errorf("'%s': '%s' is not octal file mode", app.ServerUnixSocketMode, m)
+		}
+		ret.mode = os.FileMode(mode)
+	}
+	return ret, nil
+}
+
+//endpoint prepares socket path and makes server endpoint of it;
+//stale socket left by previous run is removed but any other file on the path is kept and reported
+func (s *unixSocket) endpoint() (*pkgNet.Endpoint, error) {
+	if err := os.MkdirAll(filepath.Dir(s.path), 0755); err != nil {
+		return nil, errors.Wrap(err, "os.MkdirAll")
+	}
+	fi, err := os.Lstat(s.path)
+	switch {
+	case err == nil && fi.Mode()&os.ModeSocket == 0:
+		return nil, errors.Errorf("'%s' exists but is not socket", s.path)
+	case err == nil:
+		if err = os.Remove(s.path); err != nil {
+			return nil, errors.Wrap(err, "os.Remove")
+		}
+	case !os.IsNotExist(err):
+		return nil, errors.Wrap(err, "os.Lstat")
+	}
+	return pkgNet.ParseEndpoint("unix://" + s.path)
+}
+
+//applyMode sets socket permissions once server has created it; until then socket has permissions by process umask
+func (s *unixSocket) applyMode(ctx context.Context) {
+	t := time.NewTicker(unixSocketPollInterval)
+	defer t.Stop()
+	for {
+		if _, err := os.Lstat(s.path); err == nil {
+			if err = os.Chmod(s.path, s.mode); err != nil {
+				logger.FromContext(ctx).Errorf("unix socket '%s' chmod %o: %v", s.path, s.mode, err)
+			}
+			return
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
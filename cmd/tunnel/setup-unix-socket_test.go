@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gradusp/crispy-tunnel/internal/api/tunnel"
+	"github.com/gradusp/go-platform/server"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func Test_UnixSocket(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	path := filepath.Join(t.TempDir(), "run", "tunnel.sock")
+
+	//stale socket of previous run is replaced
+	if !assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0755)) {
+		return
+	}
+	stale, err := net.ListenUnix("unix", &net.UnixAddr{Name: path, Net: "unix"})
+	if !assert.NoError(t, err) {
+		return
+	}
+	stale.SetUnlinkOnClose(false)
+	_ = stale.Close()
+
+	sock := &unixSocket{path: path, mode: 0600}
+	ep, err := sock.endpoint()
+	if !assert.NoError(t, err) {
+		return
+	}
+	srv, err := server.NewAPIServer(server.WithServices(tunnel.NewTunnelService(ctx, tunnel.WithReadOnly())))
+	if !assert.NoError(t, err) {
+		return
+	}
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- srv.Run(ctx, ep, server.RunWithGracefulStop(time.Second))
+	}()
+	go sock.applyMode(ctx)
+
+	dialCtx, dialCancel := context.WithTimeout(ctx, 5*time.Second)
+	defer dialCancel()
+	conn, err := grpc.DialContext(dialCtx, "unix://"+path, grpc.WithInsecure(), grpc.WithBlock())
+	if assert.NoError(t, err, "server is reachable by socket path") {
+		_ = conn.Close()
+	}
+	assert.Eventually(t, func() bool {
+		fi, e := os.Stat(path)
+		return e == nil && fi.Mode()&os.ModeSocket != 0 && fi.Mode().Perm() == 0600
+	}, 2*time.Second, unixSocketPollInterval)
+
+	cancel()
+	select {
+	case <-runErr:
+	case <-time.After(5 * time.Second):
+		t.Error("server is not stopped")
+	}
+
+	//anything but socket on the path is kept
+	notSock := &unixSocket{path: filepath.Join(filepath.Dir(path), "file"), mode: 0600}
+	if !assert.NoError(t, os.WriteFile(notSock.path, nil, 0644)) {
+		return
+	}
+	_, err = notSock.endpoint()
+	assert.Error(t, err)
+	_, err = os.Stat(notSock.path)
+	assert.NoError(t, err)
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"flag"
 
 	"github.com/gradusp/crispy-tunnel/internal/app"
 	"github.com/gradusp/crispy-tunnel/internal/config"
@@ -13,6 +14,7 @@ import (
 )
 
 func main() {
+	flag.Parse()
 	setupContext()
 	ctx := app.Context()
 	logger.SetLevel(zap.InfoLevel)
@@ -39,8 +41,12 @@ func main() {
 	if err = setupTracer(); err != nil {
 		logger.Fatalf(ctx, "setup tracer: %v", err)
 	}
+	var srvOpts []server.APIServerOption
+	if srvOpts, err = setupServerOptions(ctx); err != nil {
+		logger.Fatalf(ctx, "setup server: %v", err)
+	}
 	var srv *server.APIServer
-	if srv, err = setupServer(ctx); err != nil {
+	if srv, err = server.NewAPIServer(srvOpts...); err != nil {
 		logger.Fatalf(ctx, "setup server: %v", err)
 	}
 	var endPointAddress string
@@ -51,9 +57,36 @@ func main() {
 	if ep, err = pkgNet.ParseEndpoint(endPointAddress); err != nil {
 		logger.Fatalf(ctx, "parse server endpoint (%s): %v", endPointAddress, err)
 	}
+	var sock *unixSocket
+	if sock, err = setupUnixSocket(ctx); err != nil {
+		logger.Fatalf(ctx, "setup unix socket: %v", err)
+	}
 	gracefulDuration, _ := app.ServerGracefulShutdown.Maybe(ctx)
-	if err = srv.Run(ctx, ep, server.RunWithGracefulStop(gracefulDuration)); err != nil {
-		logger.Fatalf(ctx, "run server: %v", err)
+	runErrs := make(chan error, 2)
+	go func() {
+		runErrs <- srv.Run(ctx, ep, server.RunWithGracefulStop(gracefulDuration))
+	}()
+	running := 1
+	//если задан Unix сокет то - тот же сервис слушает и на нем
+	if sock != nil {
+		var sockSrv *server.APIServer
+		if sockSrv, err = server.NewAPIServer(srvOpts...); err != nil {
+			logger.Fatalf(ctx, "setup unix socket server: %v", err)
+		}
+		var sockEp *pkgNet.Endpoint
+		if sockEp, err = sock.endpoint(); err != nil {
+			logger.Fatalf(ctx, "unix socket endpoint (%s): %v", sock.path, err)
+		}
+		go func() {
+			runErrs <- sockSrv.Run(ctx, sockEp, server.RunWithGracefulStop(gracefulDuration))
+		}()
+		go sock.applyMode(ctx)
+		running++
+	}
+	for ; running > 0; running-- {
+		if err = <-runErrs; err != nil {
+			logger.Fatalf(ctx, "run server: %v", err)
+		}
 	}
 	WhenHaveTracerProvider(func(tp ot.TracerProvider) {
 		_ = tp.Shutdown(context.Background())
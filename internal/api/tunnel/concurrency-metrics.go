@@ -0,0 +1,56 @@
+package tunnel
+
+import (
+	"sync/atomic"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+//ConcurrencyMetrics metrics of the service semaphore limiting concurrent RPCs ('WithMaxConcurrency');
+//they are sampled on scrape from the semaphore itself and an atomic count of waiters
+type ConcurrencyMetrics struct {
+	utilization *prometheus.Desc
+	held        *prometheus.Desc
+	waiting     *prometheus.Desc
+	src         atomic.Value
+}
+
+var _ prometheus.Collector = (*ConcurrencyMetrics)(nil)
+
+//NewConcurrencyMetrics creates semaphore metrics; register them and pass to 'WithConcurrencyMetrics'
+func NewConcurrencyMetrics() *ConcurrencyMetrics {
+	const (
+		namespace = "tunnel"
+		subsystem = "concurrency"
+	)
+	return &ConcurrencyMetrics{
+		utilization: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "utilization_ratio"),
+			"held semaphore slots / semaphore capacity", nil, nil),
+		held: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "slots_held"),
+			"semaphore slots held by running RPCs", nil, nil),
+		waiting: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "waiting"),
+			"RPCs waiting for semaphore slot", nil, nil),
+	}
+}
+
+//Describe impl prometheus.Collector
+func (m *ConcurrencyMetrics) Describe(ch chan<- *prometheus.Desc) {
+	ch <- m.utilization
+	ch <- m.held
+	ch <- m.waiting
+}
+
+//Collect impl prometheus.Collector
+func (m *ConcurrencyMetrics) Collect(ch chan<- prometheus.Metric) {
+	srv, _ := m.src.Load().(*tunnelService)
+	if srv == nil || srv.sema == nil {
+		return
+	}
+	held, capacity := len(srv.sema), cap(srv.sema)
+	ch <- prometheus.MustNewConstMetric(m.utilization, prometheus.GaugeValue, float64(held)/float64(capacity))
+	ch <- prometheus.MustNewConstMetric(m.held, prometheus.GaugeValue, float64(held))
+	ch <- prometheus.MustNewConstMetric(m.waiting, prometheus.GaugeValue, float64(atomic.LoadInt64(&srv.semaWaiting)))
+}
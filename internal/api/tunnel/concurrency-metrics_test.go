@@ -0,0 +1,56 @@
+package tunnel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ConcurrencyMetrics(t *testing.T) {
+	ctx := context.Background()
+	cm := NewConcurrencyMetrics()
+	srv := NewTunnelService(ctx, WithMaxConcurrency(2), WithConcurrencyMetrics(cm)).(*tunnelService)
+	expect := func(utilization float64, held, waiting int) error {
+		return testutil.CollectAndCompare(cm, strings.NewReader(fmt.Sprintf(`
+# HELP tunnel_concurrency_slots_held semaphore slots held by running RPCs
+# TYPE tunnel_concurrency_slots_held gauge
+tunnel_concurrency_slots_held %v
+# HELP tunnel_concurrency_utilization_ratio held semaphore slots / semaphore capacity
+# TYPE tunnel_concurrency_utilization_ratio gauge
+tunnel_concurrency_utilization_ratio %v
+# HELP tunnel_concurrency_waiting RPCs waiting for semaphore slot
+# TYPE tunnel_concurrency_waiting gauge
+tunnel_concurrency_waiting %v
+`, held, utilization, waiting)))
+	}
+
+	assert.NoError(t, expect(0, 0, 0))
+	leave1, err := srv.enter(ctx)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.NoError(t, expect(0.5, 1, 0))
+	leave2, err := srv.enter(ctx)
+	if !assert.NoError(t, err) {
+		return
+	}
+	entered := make(chan struct{})
+	go func() {
+		defer close(entered)
+		if leave, e := srv.enter(ctx); e == nil {
+			leave()
+		}
+	}()
+	assert.Eventually(t, func() bool {
+		return expect(1, 2, 1) == nil
+	}, 5*time.Second, 10*time.Millisecond)
+	leave1()
+	<-entered
+	leave2()
+	assert.NoError(t, expect(0, 0, 0))
+}
@@ -0,0 +1,51 @@
+package tunnel
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	//DefaultExecPath PATH suggested for fixed environment of external commands
+	DefaultExecPath = "/usr/local/sbin:/usr/local/bin:/usr/sbin:/usr/bin:/sbin:/bin"
+)
+
+//execBaseEnv environment external commands start with: the fixed one if it is set or inherited one otherwise
+func (srv *tunnelService) execBaseEnv() []string {
+	if srv.execEnv != nil {
+		return append([]string(nil), srv.execEnv...)
+	}
+	return os.Environ()
+}
+
+//execCommandPath resolves command by PATH of the fixed environment; with inherited environment
+//or command given with path it is left for 'exec.Command'
+func (srv *tunnelService) execCommandPath(command string) (string, error) {
+	if srv.execEnv == nil || strings.ContainsRune(command, filepath.Separator) {
+		return command, nil
+	}
+	for _, dir := range filepath.SplitList(envValue(srv.execEnv, "PATH")) {
+		if len(dir) == 0 {
+			continue
+		}
+		p := filepath.Join(dir, command)
+		if fi, err := os.Stat(p); err == nil && !fi.IsDir() && fi.Mode()&0111 != 0 {
+			return p, nil
+		}
+	}
+	return "", errors.Errorf("'%s' is not found in PATH of exec environment", command)
+}
+
+//envValue gets the last value of 'key' in environment
+func envValue(env []string, key string) string {
+	var ret string
+	for _, kv := range env {
+		if strings.HasPrefix(kv, key+"=") {
+			ret = kv[len(key)+1:]
+		}
+	}
+	return ret
+}
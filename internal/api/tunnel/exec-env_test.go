@@ -0,0 +1,37 @@
+package tunnel
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ExecEnv(t *testing.T) {
+	dir := t.TempDir()
+	//no shell in between, it would export variables of its own
+	if !assert.NoError(t, os.Symlink("/usr/bin/env", filepath.Join(dir, "dump-env"))) {
+		return
+	}
+	ctx := context.Background()
+	srv := NewTunnelService(ctx, WithExecEnv([]string{"LC_ALL=C", "PATH=" + dir})).(*tunnelService)
+	var out bytes.Buffer
+	_, err := srv.execExternal(ctx, &out, nil, "dump-env")
+	if assert.NoError(t, err) {
+		env := strings.Fields(out.String())
+		assert.Contains(t, env, "LC_ALL=C")
+		assert.Contains(t, env, "PATH="+dir)
+		assert.Len(t, env, 2)
+	}
+	_, err = srv.execExternal(ctx, nil, nil, "sh", "-c", "exit 0")
+	assert.Error(t, err)
+
+	srv = NewTunnelService(ctx).(*tunnelService)
+	_, err = srv.execExternal(ctx, nil, nil, "sh", "-c", "exit 0")
+	assert.NoError(t, err)
+	assert.Equal(t, "/bin", envValue([]string{"PATH=/usr/bin", "PATH=/bin"}, "PATH"))
+}
@@ -0,0 +1,72 @@
+package tunnel
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+//ExecMetrics metrics of external commands the service runs
+type ExecMetrics struct {
+	runs     *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+	kills    *prometheus.CounterVec
+}
+
+var _ prometheus.Collector = (*ExecMetrics)(nil)
+
+//NewExecMetrics creates external command metrics; register them and pass to 'WithExecMetrics'
+func NewExecMetrics() *ExecMetrics {
+	const (
+		namespace = "tunnel"
+		subsystem = "exec"
+	)
+	return &ExecMetrics{
+		runs: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "runs_total",
+			Help:      "external command runs by command and exit code ('-1' - did not exit by itself)",
+		}, []string{"command", "exit_code"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "duration_seconds",
+			Help:      "external command run duration by command and exit code",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"command", "exit_code"}),
+		kills: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "kills_total",
+			Help:      "external commands killed because of context cancellation",
+		}, []string{"command"}),
+	}
+}
+
+//Describe impl prometheus.Collector
+func (m *ExecMetrics) Describe(ch chan<- *prometheus.Desc) {
+	m.runs.Describe(ch)
+	m.duration.Describe(ch)
+	m.kills.Describe(ch)
+}
+
+//Collect impl prometheus.Collector
+func (m *ExecMetrics) Collect(ch chan<- prometheus.Metric) {
+	m.runs.Collect(ch)
+	m.duration.Collect(ch)
+	m.kills.Collect(ch)
+}
+
+func (m *ExecMetrics) observe(command, exitCode string, killed bool, d time.Duration) {
+	if m == nil {
+		return
+	}
+	command = filepath.Base(command)
+	m.runs.WithLabelValues(command, exitCode).Inc()
+	m.duration.WithLabelValues(command, exitCode).Observe(d.Seconds())
+	if killed {
+		m.kills.WithLabelValues(command).Inc()
+	}
+}
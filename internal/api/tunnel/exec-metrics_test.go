@@ -0,0 +1,28 @@
+package tunnel
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ExecMetrics(t *testing.T) {
+	ctx := context.Background()
+	em := NewExecMetrics()
+	srv := NewTunnelService(ctx, WithExecMetrics(em)).(*tunnelService)
+
+	_, _ = srv.execExternal(ctx, nil, nil, "sh", "-c", "exit 0")
+	_, _ = srv.execExternal(ctx, nil, nil, "sh", "-c", "exit 3")
+	assert.Equal(t, float64(1), testutil.ToFloat64(em.runs.WithLabelValues("sh", "0")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(em.runs.WithLabelValues("sh", "3")))
+
+	ctx1, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	_, err := srv.execExternal(ctx1, nil, nil, "sleep", "10")
+	assert.Error(t, err)
+	assert.Equal(t, float64(1), testutil.ToFloat64(em.kills.WithLabelValues("sleep")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(em.runs.WithLabelValues("sleep", "-1")))
+}
@@ -0,0 +1,21 @@
+package tunnel
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"go.opentelemetry.io/otel/propagation"
+)
+
+//traceContextEnv makes env vars carrying W3C trace context of 'ctx' for child processes
+func traceContextEnv(ctx context.Context) []string {
+	carrier := propagation.HeaderCarrier(make(http.Header))
+	propagation.TraceContext{}.Inject(ctx, carrier)
+	ret := make([]string, 0, len(carrier))
+	for _, k := range carrier.Keys() {
+		ret = append(ret, fmt.Sprintf("%s=%s", strings.ToUpper(k), carrier.Get(k)))
+	}
+	return ret
+}
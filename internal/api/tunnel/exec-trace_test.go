@@ -0,0 +1,22 @@
+package tunnel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func Test_TraceContextEnv(t *testing.T) {
+	ctx := context.Background()
+	assert.Empty(t, traceContextEnv(ctx))
+
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+		SpanID:     trace.SpanID{1, 2, 3, 4, 5, 6, 7, 8},
+		TraceFlags: trace.FlagsSampled,
+	})
+	env := traceContextEnv(trace.ContextWithSpanContext(ctx, sc))
+	assert.Contains(t, env, "TRACEPARENT=00-0102030405060708090a0b0c0d0e0f10-0102030405060708-01")
+}
@@ -0,0 +1,40 @@
+package tunnel
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+//fakeCommands makes exec environment where each of 'commands' succeeds doing nothing
+func fakeCommands(t testing.TB, commands ...string) []string {
+	dir := t.TempDir()
+	for _, c := range commands {
+		if err := os.WriteFile(filepath.Join(dir, c), []byte("#!/bin/sh\nexit 0\n"), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return []string{"PATH=" + dir}
+}
+
+//recordCommands makes exec environment where each of 'commands' succeeds doing nothing;
+//'calls' returns their command lines in order they were run
+func recordCommands(t *testing.T, commands ...string) (env []string, calls func() []string) {
+	dir := t.TempDir()
+	log := filepath.Join(dir, "calls.log")
+	script := fmt.Sprintf("#!/bin/sh\necho \"${0##*/} $*\" >> %s\n", log)
+	for _, c := range commands {
+		if err := os.WriteFile(filepath.Join(dir, c), []byte(script), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return []string{"PATH=" + dir}, func() []string {
+		raw, err := os.ReadFile(log)
+		if err != nil && !os.IsNotExist(err) {
+			t.Fatal(err)
+		}
+		return strings.FieldsFunc(string(raw), func(r rune) bool { return r == '\n' })
+	}
+}
@@ -0,0 +1,403 @@
+package tunnel
+
+import (
+	"context"
+	"net"
+	"sync"
+	"syscall"
+
+	"github.com/vishvananda/netlink"
+)
+
+//fakeNetlink in-memory kernel standing in for netlink in tests; calls of operations named in 'fail' fail.
+//Missing links are reported by zero 'netlink.LinkNotFoundError' which is good for 'errors.As' but not for printing
+type fakeNetlink struct {
+	mu        sync.Mutex
+	links     []netlink.Link
+	routes    []netlink.Route
+	rules     []netlink.Rule
+	qdiscs    []netlink.Qdisc
+	classes   []netlink.Class
+	addrs     []netlink.Addr
+	lastIndex int
+	fail      map[string]error
+	calls     []string //link operations as '<op> <link name>'
+}
+
+var _ netlinkOps = (*fakeNetlink)(nil)
+
+//useFakeNetlink makes service work on fake kernel having 'links' as they are and detects unmanaged links anew
+func useFakeNetlink(srv *tunnelService, links ...netlink.Link) *fakeNetlink {
+	f := &fakeNetlink{fail: make(map[string]error)}
+	for _, l := range links {
+		f.lastIndex++
+		l.Attrs().Index = f.lastIndex
+		f.links = append(f.links, l)
+	}
+	srv.nl, srv.linkList, srv.linkAdd, srv.linkByIndex = f, f.LinkList, f.LinkAdd, f.LinkByIndex
+	srv.detectUnmanaged(context.Background())
+	return f
+}
+
+func (f *fakeNetlink) op(op string, link netlink.Link) (netlink.Link, error) {
+	if link != nil {
+		f.calls = append(f.calls, op+" "+link.Attrs().Name)
+	}
+	if err := f.fail[op]; err != nil {
+		return nil, err
+	}
+	if link == nil {
+		return nil, nil
+	}
+	for _, l := range f.links {
+		if l.Attrs().Index == link.Attrs().Index {
+			return l, nil
+		}
+	}
+	return nil, netlink.LinkNotFoundError{}
+}
+
+func (f *fakeNetlink) AddrList(_ netlink.Link, family int) ([]netlink.Addr, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, err := f.op("AddrList", nil); err != nil {
+		return nil, err
+	}
+	var ret []netlink.Addr
+	for _, a := range f.addrs {
+		if family == netlink.FAMILY_ALL || (family == netlink.FAMILY_V4) == (a.IP.To4() != nil) {
+			ret = append(ret, a)
+		}
+	}
+	return ret, nil
+}
+
+func (f *fakeNetlink) names() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var ret []string
+	for _, l := range f.links {
+		ret = append(ret, l.Attrs().Name)
+	}
+	return ret
+}
+
+func (f *fakeNetlink) LinkList() ([]netlink.Link, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, err := f.op("LinkList", nil); err != nil {
+		return nil, err
+	}
+	return append([]netlink.Link(nil), f.links...), nil
+}
+
+func (f *fakeNetlink) LinkByName(name string) (netlink.Link, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, l := range f.links {
+		if l.Attrs().Name == name {
+			return l, nil
+		}
+	}
+	return nil, netlink.LinkNotFoundError{}
+}
+
+func (f *fakeNetlink) LinkByIndex(index int) (netlink.Link, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, l := range f.links {
+		if l.Attrs().Index == index {
+			return l, nil
+		}
+	}
+	return nil, netlink.LinkNotFoundError{}
+}
+
+//LinkAdd adds link like kernel does: it gets index and IPIP link gets NOARP
+func (f *fakeNetlink) LinkAdd(link netlink.Link) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, "LinkAdd "+link.Attrs().Name)
+	if err := f.fail["LinkAdd"]; err != nil {
+		return err
+	}
+	for _, l := range f.links {
+		if l.Attrs().Name == link.Attrs().Name {
+			return syscall.EEXIST
+		}
+	}
+	f.lastIndex++
+	a := link.Attrs()
+	a.Index = f.lastIndex
+	if _, ok := link.(*netlink.Iptun); ok {
+		a.RawFlags |= syscall.IFF_NOARP
+	}
+	f.links = append(f.links, link)
+	return nil
+}
+
+func (f *fakeNetlink) LinkDel(link netlink.Link) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	l, err := f.op("LinkDel", link)
+	if err != nil {
+		return err
+	}
+	index := l.Attrs().Index
+	for i := range f.links {
+		if f.links[i] == l {
+			f.links = append(f.links[:i], f.links[i+1:]...)
+			break
+		}
+	}
+	routes := f.routes[:0]
+	for _, r := range f.routes {
+		if r.LinkIndex != index {
+			routes = append(routes, r)
+		}
+	}
+	f.routes = routes
+	qdiscs := f.qdiscs[:0]
+	for _, q := range f.qdiscs {
+		if q.Attrs().LinkIndex != index {
+			qdiscs = append(qdiscs, q)
+		}
+	}
+	f.qdiscs = qdiscs
+	return nil
+}
+
+//LinkSetUp brings link up; IPIP links report UNKNOWN oper state when they are up
+func (f *fakeNetlink) LinkSetUp(link netlink.Link) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	l, err := f.op("LinkSetUp", link)
+	if err != nil {
+		return err
+	}
+	a := l.Attrs()
+	a.Flags |= net.FlagUp
+	a.RawFlags |= syscall.IFF_UP
+	a.OperState = netlink.OperUnknown
+	return nil
+}
+
+func (f *fakeNetlink) LinkSetDown(link netlink.Link) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	l, err := f.op("LinkSetDown", link)
+	if err != nil {
+		return err
+	}
+	a := l.Attrs()
+	a.Flags &^= net.FlagUp
+	a.RawFlags &^= syscall.IFF_UP
+	a.OperState = netlink.OperDown
+	return nil
+}
+
+func (f *fakeNetlink) LinkSetName(link netlink.Link, name string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	l, err := f.op("LinkSetName", link)
+	if err != nil {
+		return err
+	}
+	if l.Attrs().Flags&net.FlagUp != 0 {
+		return syscall.EBUSY
+	}
+	for _, other := range f.links {
+		if other.Attrs().Name == name {
+			return syscall.EEXIST
+		}
+	}
+	l.Attrs().Name = name
+	return nil
+}
+
+func (f *fakeNetlink) LinkSetAlias(link netlink.Link, alias string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	l, err := f.op("LinkSetAlias", link)
+	if err != nil {
+		return err
+	}
+	l.Attrs().Alias = alias
+	return nil
+}
+
+func (f *fakeNetlink) LinkSetARPOff(link netlink.Link) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	l, err := f.op("LinkSetARPOff", link)
+	if err != nil {
+		return err
+	}
+	l.Attrs().RawFlags |= syscall.IFF_NOARP
+	return nil
+}
+
+func (f *fakeNetlink) LinkSetARPOn(link netlink.Link) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	l, err := f.op("LinkSetARPOn", link)
+	if err != nil {
+		return err
+	}
+	l.Attrs().RawFlags &^= syscall.IFF_NOARP
+	return nil
+}
+
+func sameRoute(a, b *netlink.Route) bool {
+	return a.LinkIndex == b.LinkIndex && a.Table == b.Table && a.Dst.String() == b.Dst.String()
+}
+
+func (f *fakeNetlink) RouteAdd(route *netlink.Route) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, err := f.op("RouteAdd", nil); err != nil {
+		return err
+	}
+	for i := range f.routes {
+		if sameRoute(&f.routes[i], route) {
+			return syscall.EEXIST
+		}
+	}
+	f.routes = append(f.routes, *route)
+	return nil
+}
+
+func (f *fakeNetlink) RouteDel(route *netlink.Route) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, err := f.op("RouteDel", nil); err != nil {
+		return err
+	}
+	for i := range f.routes {
+		if sameRoute(&f.routes[i], route) {
+			f.routes = append(f.routes[:i], f.routes[i+1:]...)
+			return nil
+		}
+	}
+	return syscall.ESRCH
+}
+
+func (f *fakeNetlink) RouteReplace(route *netlink.Route) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, err := f.op("RouteReplace", nil); err != nil {
+		return err
+	}
+	for i := range f.routes {
+		if f.routes[i].Table == route.Table && f.routes[i].Dst.String() == route.Dst.String() {
+			f.routes[i] = *route
+			return nil
+		}
+	}
+	f.routes = append(f.routes, *route)
+	return nil
+}
+
+//RouteListFiltered filters by link and table like netlink does; table 0 matches all tables
+func (f *fakeNetlink) RouteListFiltered(_ int, filter *netlink.Route, filterMask uint64) ([]netlink.Route, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, err := f.op("RouteListFiltered", nil); err != nil {
+		return nil, err
+	}
+	var ret []netlink.Route
+	for _, r := range f.routes {
+		if filterMask&netlink.RT_FILTER_OIF != 0 && r.LinkIndex != filter.LinkIndex {
+			continue
+		}
+		if filterMask&netlink.RT_FILTER_TABLE != 0 && filter.Table != 0 && r.Table != filter.Table {
+			continue
+		}
+		ret = append(ret, r)
+	}
+	return ret, nil
+}
+
+func sameRule(a, b *netlink.Rule) bool {
+	return a.Priority == b.Priority && a.Table == b.Table && a.Mark == b.Mark && a.Mask == b.Mask &&
+		a.IifName == b.IifName && a.OifName == b.OifName
+}
+
+func (f *fakeNetlink) RuleAdd(rule *netlink.Rule) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, err := f.op("RuleAdd", nil); err != nil {
+		return err
+	}
+	f.rules = append(f.rules, *rule)
+	return nil
+}
+
+func (f *fakeNetlink) RuleDel(rule *netlink.Rule) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, err := f.op("RuleDel", nil); err != nil {
+		return err
+	}
+	for i := range f.rules {
+		if sameRule(&f.rules[i], rule) {
+			f.rules = append(f.rules[:i], f.rules[i+1:]...)
+			return nil
+		}
+	}
+	return syscall.ENOENT
+}
+
+func (f *fakeNetlink) RuleList(int) ([]netlink.Rule, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, err := f.op("RuleList", nil); err != nil {
+		return nil, err
+	}
+	return append([]netlink.Rule(nil), f.rules...), nil
+}
+
+func (f *fakeNetlink) QdiscAdd(qdisc netlink.Qdisc) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, l := range f.links {
+		if l.Attrs().Index == qdisc.Attrs().LinkIndex {
+			f.calls = append(f.calls, "QdiscAdd "+l.Attrs().Name)
+		}
+	}
+	if _, err := f.op("QdiscAdd", nil); err != nil {
+		return err
+	}
+	for _, q := range f.qdiscs {
+		if q.Attrs().LinkIndex == qdisc.Attrs().LinkIndex && q.Attrs().Parent == qdisc.Attrs().Parent {
+			return syscall.EEXIST
+		}
+	}
+	f.qdiscs = append(f.qdiscs, qdisc)
+	return nil
+}
+
+func (f *fakeNetlink) QdiscDel(qdisc netlink.Qdisc) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, err := f.op("QdiscDel", nil); err != nil {
+		return err
+	}
+	for i, q := range f.qdiscs {
+		if q.Attrs().LinkIndex == qdisc.Attrs().LinkIndex && q.Attrs().Parent == qdisc.Attrs().Parent {
+			f.qdiscs = append(f.qdiscs[:i], f.qdiscs[i+1:]...)
+			return nil
+		}
+	}
+	return syscall.ENOENT
+}
+
+func (f *fakeNetlink) ClassAdd(class netlink.Class) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, err := f.op("ClassAdd", nil); err != nil {
+		return err
+	}
+	f.classes = append(f.classes, class)
+	return nil
+}
@@ -0,0 +1,91 @@
+package tunnel
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+)
+
+const (
+	//DefaultHealthCacheTTL default time the health result is served from cache
+	DefaultHealthCacheTTL = 2 * time.Second
+
+	//DefaultHealthCacheJitter default max jitter the background refresh starts before TTL expiry
+	DefaultHealthCacheJitter = 500 * time.Millisecond
+)
+
+//healthCache caches health probe result
+//  - result older than 'ttl' is never served: callers wait for the single in-flight probe
+//  - result older than 'ttl' minus random jitter is served but refreshed in background
+type healthCache struct {
+	mu        sync.Mutex
+	ttl       time.Duration
+	jitter    time.Duration
+	probe     func() *tunnel.HealthResponse
+	now       func() time.Time
+	result    *tunnel.HealthResponse
+	at        time.Time
+	refreshAt time.Time
+	inflight  chan struct{}
+}
+
+func newHealthCache(ttl, jitter time.Duration, probe func() *tunnel.HealthResponse) *healthCache {
+	if jitter > ttl/2 {
+		jitter = ttl / 2
+	}
+	return &healthCache{
+		ttl:    ttl,
+		jitter: jitter,
+		probe:  probe,
+		now:    time.Now,
+	}
+}
+
+func (c *healthCache) get(ctx context.Context) (*tunnel.HealthResponse, error) {
+	if c.ttl <= 0 {
+		return c.probe(), nil
+	}
+	for {
+		c.mu.Lock()
+		now := c.now()
+		if c.result != nil && now.Sub(c.at) < c.ttl {
+			if !now.Before(c.refreshAt) && c.inflight == nil {
+				_ = c.refreshLocked()
+			}
+			ret := c.result
+			c.mu.Unlock()
+			return ret, nil
+		}
+		ch := c.inflight
+		if ch == nil {
+			ch = c.refreshLocked()
+		}
+		c.mu.Unlock()
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ch:
+		}
+	}
+}
+
+func (c *healthCache) refreshLocked() chan struct{} {
+	ch := make(chan struct{})
+	c.inflight = ch
+	go func() {
+		defer close(ch)
+		r := c.probe()
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		c.result, c.at = r, c.now()
+		c.refreshAt = c.at.Add(c.ttl)
+		if c.jitter > 0 {
+			c.refreshAt = c.refreshAt.Add(-time.Duration(rand.Int63n(int64(c.jitter)))) //nolint:gosec
+		}
+		c.inflight = nil
+	}()
+	return ch
+}
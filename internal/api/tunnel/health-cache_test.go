@@ -0,0 +1,44 @@
+package tunnel
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/vishvananda/netlink"
+)
+
+func Test_HealthCache(t *testing.T) {
+	ctx := context.Background()
+	srv := NewTunnelService(ctx, WithHealthCache(time.Minute, 0)).(*tunnelService)
+	var calls int
+	var failure error
+	srv.linkList = func() ([]netlink.Link, error) {
+		calls++
+		if failure != nil {
+			return nil, failure
+		}
+		return []netlink.Link{&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun1"}}}, nil
+	}
+	now := time.Now()
+	srv.health.now = func() time.Time { return now }
+
+	resp, err := srv.Health(ctx, nil)
+	if assert.NoError(t, err) {
+		assert.True(t, resp.GetHealthy())
+		assert.Equal(t, int32(1), resp.GetTunnels())
+	}
+	_, _ = srv.Health(ctx, nil)
+	assert.Equal(t, 1, calls)
+
+	failure = errors.New("netlink is unavailable")
+	now = now.Add(time.Minute)
+	resp, err = srv.Health(ctx, nil)
+	if assert.NoError(t, err) {
+		assert.False(t, resp.GetHealthy())
+		assert.NotEmpty(t, resp.GetError())
+	}
+	assert.Equal(t, 2, calls)
+}
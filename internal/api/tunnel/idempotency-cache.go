@@ -0,0 +1,146 @@
+package tunnel
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	//DefaultIdempotencyTTL default time result of mutation is kept for replays of its idempotency key
+	DefaultIdempotencyTTL = 5 * time.Minute
+
+	//DefaultIdempotencyCacheSize default max count of idempotency keys kept
+	DefaultIdempotencyCacheSize = 1024
+)
+
+//idempotencyCache keeps recent results of mutations by their idempotency keys
+//  - replay of a key returns kept result instead of running the mutation again;
+//    replay that comes while the mutation is still running waits for it
+//  - replay with the same key but another request is rejected
+//  - only successful results are kept so a failed mutation may be retried with the same key
+//  - keys live no longer than 'ttl'; the oldest keys are evicted when there are more than 'maxSize';
+//    keys of running mutations are never evicted
+type idempotencyCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	maxSize int
+	now     func() time.Time
+	entries map[string]*idempotencyEntry
+	order   []*idempotencyEntry
+}
+
+type idempotencyEntry struct {
+	key         string
+	fingerprint []byte
+	at          time.Time
+	done        chan struct{}
+	resp        proto.Message
+	err         error
+}
+
+func newIdempotencyCache(ttl time.Duration, maxSize int) *idempotencyCache {
+	return &idempotencyCache{
+		ttl:     ttl,
+		maxSize: maxSize,
+		now:     time.Now,
+		entries: make(map[string]*idempotencyEntry),
+	}
+}
+
+//do runs 'mutation' once per 'key' of 'method'; empty key or turned off cache always runs it
+func (c *idempotencyCache) do(ctx context.Context, method, key string, req proto.Message, mutation func() (proto.Message, error)) (proto.Message, error) {
+	if len(key) == 0 || c.ttl <= 0 || c.maxSize <= 0 {
+		return mutation()
+	}
+	fp, err := requestFingerprint(req)
+	if err != nil {
+		return nil, err
+	}
+	key = method + ":" + key
+	c.mu.Lock()
+	c.evictLocked()
+	if e := c.entries[key]; e != nil {
+		c.mu.Unlock()
+		if string(e.fingerprint) != string(fp) {
+			return nil, status.Errorf(codes.InvalidArgument, "'idempotencyKey': is already used by another %s request", method)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, status.FromContextError(ctx.Err()).Err()
+		case <-e.done:
+		}
+		if e.err != nil {
+			return nil, e.err
+		}
+		return e.resp, nil
+	}
+	e := &idempotencyEntry{key: key, fingerprint: fp, at: c.now(), done: make(chan struct{})}
+	c.entries[key] = e
+	c.order = append(c.order, e)
+	c.mu.Unlock()
+
+	e.resp, e.err = mutation()
+	if e.err != nil {
+		c.mu.Lock()
+		if c.entries[key] == e {
+			delete(c.entries, key)
+		}
+		c.mu.Unlock()
+	}
+	close(e.done)
+	return e.resp, e.err
+}
+
+//evictLocked drops expired keys and the oldest ones above max size; keys are ordered by time they are added.
+//Keys of mutations which are still running are kept whatever their age so replays keep waiting for them
+func (c *idempotencyCache) evictLocked() {
+	now := c.now()
+	order := c.order[:0]
+	for i, e := range c.order {
+		if c.entries[e.key] != e {
+			continue
+		}
+		if now.Sub(e.at) < c.ttl && len(c.entries) < c.maxSize {
+			order = append(order, c.order[i:]...)
+			break
+		}
+		if e.running() {
+			order = append(order, e)
+			continue
+		}
+		delete(c.entries, e.key)
+	}
+	for i := len(order); i < len(c.order); i++ {
+		c.order[i] = nil
+	}
+	c.order = order
+}
+
+//running checks if mutation of the entry is not done yet
+func (e *idempotencyEntry) running() bool {
+	select {
+	case <-e.done:
+		return false
+	default:
+		return true
+	}
+}
+
+//requestFingerprint serializes request without its per call timeout so retries with another timeout match
+func requestFingerprint(req proto.Message) ([]byte, error) {
+	r := proto.Clone(req).ProtoReflect()
+	if fd := r.Descriptor().Fields().ByName(protoreflect.Name("timeoutMs")); fd != nil {
+		r.Clear(fd)
+	}
+	ret, err := proto.MarshalOptions{Deterministic: true}.Marshal(r.Interface())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "proto.Marshal: %v", err)
+	}
+	return ret, nil
+}
@@ -0,0 +1,145 @@
+package tunnel
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func Test_IdempotencyCache(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	c := newIdempotencyCache(time.Minute, 2)
+	c.now = func() time.Time { return now }
+
+	runs := 0
+	mutation := func() (proto.Message, error) {
+		runs++
+		return &tunnel.RemoveTunnelResponse{RemovedRoutes: []string{"r"}}, nil
+	}
+	req := &tunnel.RemoveTunnelRequest{TunDestIP: "1.1.1.1", IdempotencyKey: "k1"}
+	r1, err := c.do(ctx, "RemoveTunnel", req.GetIdempotencyKey(), req, mutation)
+	assert.NoError(t, err)
+	req.TimeoutMs = 100
+	r2, err := c.do(ctx, "RemoveTunnel", req.GetIdempotencyKey(), req, mutation)
+	assert.NoError(t, err)
+	assert.Same(t, r1, r2)
+	assert.Equal(t, 1, runs)
+
+	_, err = c.do(ctx, "RemoveTunnel", "k1", &tunnel.RemoveTunnelRequest{TunDestIP: "2.2.2.2"}, mutation)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = c.do(ctx, "RemoveTunnel", "", req, mutation)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, runs)
+
+	failed := func() (proto.Message, error) {
+		runs++
+		return nil, status.Error(codes.Unavailable, "busy")
+	}
+	_, err = c.do(ctx, "RemoveTunnel", "k2", req, failed)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	_, err = c.do(ctx, "RemoveTunnel", "k2", req, mutation)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, runs)
+
+	now = now.Add(2 * time.Minute)
+	_, err = c.do(ctx, "RemoveTunnel", "k1", req, mutation)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, runs)
+	assert.LessOrEqual(t, len(c.entries), 2)
+
+	//running mutation is not evicted by age nor by size so its replay waits for it
+	started, release := make(chan struct{}), make(chan struct{})
+	blocked := func() (proto.Message, error) {
+		close(started)
+		<-release
+		return mutation()
+	}
+	first := make(chan proto.Message, 1)
+	go func() {
+		r, _ := c.do(ctx, "RemoveTunnel", "k3", req, blocked)
+		first <- r
+	}()
+	<-started
+	c.mu.Lock()
+	now = now.Add(2 * time.Minute)
+	c.mu.Unlock()
+	for _, k := range []string{"k4", "k5", "k6"} {
+		_, err = c.do(ctx, "RemoveTunnel", k, req, mutation)
+		assert.NoError(t, err)
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	_, err = c.do(waitCtx, "RemoveTunnel", "k3", req, mutation)
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	close(release)
+	assert.NotNil(t, <-first)
+	assert.Equal(t, 9, runs)
+}
+
+func Test_IdempotencyKeyOfMutations(t *testing.T) {
+	ctx := context.Background()
+	srv := NewTunnelService(ctx, WithExecEnv(fakeCommands(t, "sysctl"))).(*tunnelService)
+	fake := useFakeNetlink(srv)
+	const ip = "10.0.0.1"
+	_, err := srv.AddTunnel(ctx, &tunnel.AddTunnelRequest{TunDestIP: ip})
+	if !assert.NoError(t, err) {
+		return
+	}
+	name := mustTunnelName(net.ParseIP(ip))
+	countCalls := func(call string) int {
+		n := 0
+		for _, c := range fake.calls {
+			if c == call+" "+name {
+				n++
+			}
+		}
+		return n
+	}
+
+	q1, err := srv.QuarantineTunnel(ctx, &tunnel.QuarantineTunnelRequest{TunDestIP: ip, IdempotencyKey: "q"})
+	assert.NoError(t, err)
+	q2, err := srv.QuarantineTunnel(ctx, &tunnel.QuarantineTunnelRequest{TunDestIP: ip, IdempotencyKey: "q"})
+	assert.NoError(t, err)
+	assert.Same(t, q1, q2)
+	_, err = srv.RestoreTunnel(ctx, &tunnel.RestoreTunnelRequest{TunDestIP: ip, IdempotencyKey: "r"})
+	assert.NoError(t, err)
+	_, err = srv.RestoreTunnel(ctx, &tunnel.RestoreTunnelRequest{TunDestIP: ip, IdempotencyKey: "r"})
+	assert.NoError(t, err)
+
+	fake.calls = nil
+	for i := 0; i < 2; i++ {
+		_, err = srv.SetTunnelState(ctx, &tunnel.SetTunnelStateRequest{TunDestIP: ip, Up: false, IdempotencyKey: "s"})
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 1, countCalls("LinkSetDown"))
+	_, err = srv.SetTunnelState(ctx, &tunnel.SetTunnelStateRequest{TunDestIP: ip, Up: true, IdempotencyKey: "s"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	for i := 0; i < 2; i++ {
+		_, err = srv.SetTunnelAlias(ctx, &tunnel.SetTunnelAliasRequest{TunDestIP: ip, Alias: "edge", IdempotencyKey: "a"})
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 1, countCalls("LinkSetAlias"))
+
+	for i := 0; i < 2; i++ {
+		_, err = srv.CordonTunnel(ctx, &tunnel.CordonTunnelRequest{TunDestIP: ip, IdempotencyKey: "c"})
+		assert.NoError(t, err)
+		_, err = srv.UncordonTunnel(ctx, &tunnel.UncordonTunnelRequest{TunDestIP: ip, IdempotencyKey: "u"})
+		assert.NoError(t, err)
+	}
+
+	w1, err := srv.SwapRemote(ctx, &tunnel.SwapRemoteRequest{CurrentIP: ip, NewIP: "10.0.0.2", IdempotencyKey: "w"})
+	assert.NoError(t, err)
+	w2, err := srv.SwapRemote(ctx, &tunnel.SwapRemoteRequest{CurrentIP: ip, NewIP: "10.0.0.2", IdempotencyKey: "w"})
+	assert.NoError(t, err)
+	assert.Same(t, w1, w2)
+}
@@ -0,0 +1,83 @@
+package tunnel
+
+import (
+	"context"
+	"sort"
+	"sync/atomic"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+//gatherSamples collects 'collectors' once and flattens them into samples ordered by name;
+//histograms and summaries give only '_count' and '_sum' samples
+func gatherSamples(collectors []prometheus.Collector) ([]*tunnel.MetricSample, error) {
+	reg := prometheus.NewRegistry()
+	for _, c := range collectors {
+		if err := reg.Register(c); err != nil && !errors.As(err, new(prometheus.AlreadyRegisteredError)) {
+			return nil, errors.Wrap(err, "prometheus.Register")
+		}
+	}
+	families, err := reg.Gather()
+	if err != nil {
+		return nil, errors.Wrap(err, "prometheus.Gather")
+	}
+	var ret []*tunnel.MetricSample
+	add := func(name string, labels []*tunnel.MetricLabel, v float64) {
+		ret = append(ret, &tunnel.MetricSample{Name: name, Labels: labels, Value: v})
+	}
+	for _, f := range families {
+		name := f.GetName()
+		for _, m := range f.GetMetric() {
+			labels := make([]*tunnel.MetricLabel, 0, len(m.GetLabel()))
+			for _, l := range m.GetLabel() {
+				labels = append(labels, &tunnel.MetricLabel{Name: l.GetName(), Value: l.GetValue()})
+			}
+			switch f.GetType() {
+			case dto.MetricType_COUNTER:
+				add(name, labels, m.GetCounter().GetValue())
+			case dto.MetricType_GAUGE:
+				add(name, labels, m.GetGauge().GetValue())
+			case dto.MetricType_HISTOGRAM:
+				add(name+"_count", labels, float64(m.GetHistogram().GetSampleCount()))
+				add(name+"_sum", labels, m.GetHistogram().GetSampleSum())
+			case dto.MetricType_SUMMARY:
+				add(name+"_count", labels, float64(m.GetSummary().GetSampleCount()))
+				add(name+"_sum", labels, m.GetSummary().GetSampleSum())
+			default:
+				add(name, labels, m.GetUntyped().GetValue())
+			}
+		}
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		return ret[i].GetName() < ret[j].GetName()
+	})
+	return ret, nil
+}
+
+//GetMetricsSnapshot impl tunnel service
+//  - it does not take semaphore slot so it sees the semaphore as other RPCs hold it
+//  - samples are of metrics the service is given by 'With...Metrics' options; without them there are no samples
+func (srv *tunnelService) GetMetricsSnapshot(_ context.Context, _ *emptypb.Empty) (resp *tunnel.MetricsSnapshot, err error) {
+	defer func() {
+		err = srv.correctError(err)
+	}()
+	resp = &tunnel.MetricsSnapshot{At: timestamppb.Now()}
+	var managed int
+	if managed, _, err = srv.countTunnels(); err != nil {
+		return nil, err
+	}
+	resp.Tunnels = uint32(managed)
+	if srv.sema != nil {
+		resp.SemaphoreHeld, resp.SemaphoreCapacity = uint32(len(srv.sema)), uint32(cap(srv.sema))
+	}
+	resp.SemaphoreWaiting = uint32(atomic.LoadInt64(&srv.semaWaiting))
+	if resp.Samples, err = gatherSamples(srv.collectors); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
@@ -0,0 +1,52 @@
+package tunnel
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vishvananda/netlink"
+)
+
+func Test_GetMetricsSnapshot(t *testing.T) {
+	ctx := context.Background()
+	em := NewExecMetrics()
+	srv := NewTunnelService(ctx, WithMaxConcurrency(4), WithExecMetrics(em), WithConcurrencyMetrics(NewConcurrencyMetrics())).(*tunnelService)
+	srv.linkList = func() ([]netlink.Link, error) {
+		return []netlink.Link{
+			&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun1"}},
+			&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun2"}},
+			&netlink.Dummy{LinkAttrs: netlink.LinkAttrs{Name: "eth0"}},
+		}, nil
+	}
+	em.observe("/sbin/modprobe", "1", false, time.Second)
+	srv.sema <- struct{}{}
+	resp, err := srv.GetMetricsSnapshot(ctx, nil)
+	<-srv.sema
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, uint32(2), resp.GetTunnels())
+	assert.Equal(t, uint32(1), resp.GetSemaphoreHeld())
+	assert.Equal(t, uint32(4), resp.GetSemaphoreCapacity())
+	values := make(map[string]float64)
+	for _, s := range resp.GetSamples() {
+		key := s.GetName()
+		for _, l := range s.GetLabels() {
+			key += "," + l.GetName() + "=" + l.GetValue()
+		}
+		values[key] = s.GetValue()
+	}
+	assert.Equal(t, 1.0, values["tunnel_exec_runs_total,command=modprobe,exit_code=1"])
+	assert.Equal(t, 1.0, values["tunnel_exec_duration_seconds_count,command=modprobe,exit_code=1"])
+	assert.Equal(t, 1.0, values["tunnel_exec_duration_seconds_sum,command=modprobe,exit_code=1"])
+	assert.Equal(t, 0.25, values["tunnel_concurrency_utilization_ratio"])
+
+	bare := NewTunnelService(ctx).(*tunnelService)
+	bare.linkList = srv.linkList
+	resp, err = bare.GetMetricsSnapshot(ctx, nil)
+	if assert.NoError(t, err) {
+		assert.Empty(t, resp.GetSamples())
+	}
+}
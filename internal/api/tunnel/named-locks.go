@@ -0,0 +1,126 @@
+package tunnel
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"google.golang.org/grpc/status"
+)
+
+//namedLocks serializes operations over the same name and lets different names proceed in parallel
+type namedLocks struct {
+	mu    sync.Mutex
+	locks map[string]*namedLock
+	idle  []chan struct{}
+}
+
+type namedLock struct {
+	refs int
+	ch   chan struct{}
+}
+
+//heldLockKey context key marking lock of 'name' held by the caller
+type heldLockKey struct {
+	nl   *namedLocks
+	name string
+}
+
+//hold acquires lock for name and returns context under which lock of that name is taken as already held;
+//the caller runs operations which lock the name themselves under that context
+func (nl *namedLocks) hold(ctx context.Context, name string) (context.Context, func(), error) {
+	unlock, err := nl.lock(ctx, name)
+	if err != nil {
+		return ctx, nil, err
+	}
+	return context.WithValue(ctx, heldLockKey{nl: nl, name: name}, true), unlock, nil
+}
+
+//lock acquires lock for name until ctx is done; lock held by 'hold' of ctx is not acquired again
+func (nl *namedLocks) lock(ctx context.Context, name string) (unlock func(), err error) {
+	if ctx.Value(heldLockKey{nl: nl, name: name}) != nil {
+		return func() {}, nil
+	}
+	nl.mu.Lock()
+	if nl.locks == nil {
+		nl.locks = make(map[string]*namedLock)
+	}
+	l := nl.locks[name]
+	if l == nil {
+		l = &namedLock{ch: make(chan struct{}, 1)}
+		nl.locks[name] = l
+	}
+	l.refs++
+	nl.mu.Unlock()
+
+	select {
+	case l.ch <- struct{}{}:
+		var o sync.Once
+		unlock = func() {
+			o.Do(func() {
+				<-l.ch
+				nl.release(name, l)
+			})
+		}
+		return unlock, nil
+	case <-ctx.Done():
+		nl.release(name, l)
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+}
+
+func (nl *namedLocks) release(name string, l *namedLock) {
+	nl.mu.Lock()
+	defer nl.mu.Unlock()
+	if l.refs--; l.refs == 0 {
+		delete(nl.locks, name)
+	}
+	if len(nl.locks) == 0 {
+		for _, ch := range nl.idle {
+			close(ch)
+		}
+		nl.idle = nil
+	}
+}
+
+//waitIdle waits until no name is locked or waited for
+func (nl *namedLocks) waitIdle(ctx context.Context) error {
+	nl.mu.Lock()
+	if len(nl.locks) == 0 {
+		nl.mu.Unlock()
+		return nil
+	}
+	ch := make(chan struct{})
+	nl.idle = append(nl.idle, ch)
+	nl.mu.Unlock()
+	select {
+	case <-ch:
+		return nil
+	case <-ctx.Done():
+		return status.FromContextError(ctx.Err()).Err()
+	}
+}
+
+//lockAll acquires locks for all names in sorted order so concurrent callers never deadlock
+func (nl *namedLocks) lockAll(ctx context.Context, names ...string) (unlock func(), err error) {
+	sorted := append([]string(nil), names...)
+	sort.Strings(sorted)
+	unlocks := make([]func(), 0, len(sorted))
+	unlock = func() {
+		for i := len(unlocks) - 1; i >= 0; i-- {
+			unlocks[i]()
+		}
+	}
+	for i, name := range sorted {
+		if i > 0 && name == sorted[i-1] {
+			continue
+		}
+		var u func()
+		if u, err = nl.lock(ctx, name); err != nil {
+			unlock()
+			return nil, err
+		}
+		unlocks = append(unlocks, u)
+	}
+	return unlock, nil
+}
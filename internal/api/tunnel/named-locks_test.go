@@ -0,0 +1,99 @@
+package tunnel
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_NamedLocks(t *testing.T) {
+	ctx := context.Background()
+	var locks namedLocks
+	name := mustTunnelName(net.ParseIP("1.1.1.1"))
+
+	var inside, maxInside int32
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			unlock, err := locks.lock(ctx, name)
+			if !assert.NoError(t, err) {
+				return
+			}
+			defer unlock()
+			mu.Lock()
+			inside++
+			if inside > maxInside {
+				maxInside = inside
+			}
+			mu.Unlock()
+			time.Sleep(time.Millisecond)
+			mu.Lock()
+			inside--
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), maxInside)
+	assert.Empty(t, locks.locks)
+
+	unlock1, err := locks.lock(ctx, "tun1")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer unlock1()
+	unlock2, err := locks.lock(ctx, "tun2")
+	if assert.NoError(t, err) {
+		unlock2()
+	}
+	ctx1, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	_, err = locks.lock(ctx1, "tun1")
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+
+	//lock held by 'hold' is taken again only under its context
+	held, unlock3, err := locks.hold(ctx, "tun3")
+	if !assert.NoError(t, err) {
+		return
+	}
+	unlock4, err := locks.lock(held, "tun3")
+	if assert.NoError(t, err) {
+		unlock4()
+	}
+	ctx2, cancel2 := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel2()
+	_, err = locks.lock(ctx2, "tun3")
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	unlock3()
+	unlock5, err := locks.lock(ctx, "tun3")
+	if assert.NoError(t, err) {
+		unlock5()
+	}
+}
+
+func Test_NamedLocksLockAll(t *testing.T) {
+	ctx := context.Background()
+	var nl namedLocks
+	unlock, err := nl.lockAll(ctx, "tun2", "tun1", "tun2")
+	if !assert.NoError(t, err) {
+		return
+	}
+	ctx1, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	_, err = nl.lockAll(ctx1, "tun1", "tun3")
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	unlock()
+	assert.Empty(t, nl.locks)
+
+	unlock, err = nl.lockAll(ctx, "tun1", "tun3")
+	assert.NoError(t, err)
+	unlock()
+}
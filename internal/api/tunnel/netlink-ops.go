@@ -0,0 +1,37 @@
+package tunnel
+
+import (
+	"github.com/vishvananda/netlink"
+)
+
+//netlinkOps netlink calls the service makes on links, addresses, routes, rules and qdiscs;
+//the real one is '*netlink.Handle' of the current network namespace, tests put in-memory fake in its place.
+//Link listing, link creation and route lookup have their own hooks in the service
+type netlinkOps interface {
+	LinkByName(name string) (netlink.Link, error)
+	LinkByIndex(index int) (netlink.Link, error)
+	LinkDel(link netlink.Link) error
+	LinkSetUp(link netlink.Link) error
+	LinkSetDown(link netlink.Link) error
+	LinkSetName(link netlink.Link, name string) error
+	LinkSetAlias(link netlink.Link, alias string) error
+	LinkSetARPOff(link netlink.Link) error
+	LinkSetARPOn(link netlink.Link) error
+
+	AddrList(link netlink.Link, family int) ([]netlink.Addr, error)
+
+	RouteAdd(route *netlink.Route) error
+	RouteDel(route *netlink.Route) error
+	RouteReplace(route *netlink.Route) error
+	RouteListFiltered(family int, filter *netlink.Route, filterMask uint64) ([]netlink.Route, error)
+
+	RuleAdd(rule *netlink.Rule) error
+	RuleDel(rule *netlink.Rule) error
+	RuleList(family int) ([]netlink.Rule, error)
+
+	QdiscAdd(qdisc netlink.Qdisc) error
+	QdiscDel(qdisc netlink.Qdisc) error
+	ClassAdd(class netlink.Class) error
+}
+
+var _ netlinkOps = (*netlink.Handle)(nil)
@@ -0,0 +1,70 @@
+package tunnel
+
+import (
+	"github.com/gradusp/go-platform/logger"
+	"github.com/vishvananda/netlink"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+)
+
+//AddTunnel phases are reported as 'ErrorInfo.Reason' of failed AddTunnel status
+const (
+	addPhaseParse           = "parse"
+	addPhaseExistsCheck     = "exists-check"
+	addPhaseOverlapCheck    = "overlap-check"
+	addPhaseCapacity        = "capacity-check"
+	addPhaseModuleLoad      = "module-load"
+	addPhaseLinkAdd         = "link-add"
+	addPhaseLinkFlags       = "link-flags"
+	addPhaseMssClamp        = "mss-clamp"
+	addPhaseFwMark          = "fwmark"
+	addPhaseShaping         = "shaping"
+	addPhaseLinkLabels      = "link-labels"
+	addPhaseLinkUp          = "link-up"
+	addPhaseMcastRoutes     = "multicast-routes"
+	addPhasePolicyRoute     = "policy-route"
+	addPhaseRpFilter        = "rp-filter"
+	addPhaseTemplateSysctls = "template-sysctls"
+	addPhaseReadBack        = "read-back"
+)
+
+const (
+	//errorInfoDomain 'ErrorInfo.Domain' of status details
+	errorInfoDomain = "crispy-tunnel"
+)
+
+//withFailedPhase attaches 'ErrorInfo' detail with failed phase to status error keeping its message intact
+func withFailedPhase(err error, phase string) error {
+	if err == nil {
+		return nil
+	}
+	st, e := status.Convert(err).WithDetails(&errdetails.ErrorInfo{
+		Reason:   phase,
+		Domain:   errorInfoDomain,
+		Metadata: map[string]string{"phase": phase},
+	})
+	if e != nil {
+		return err
+	}
+	return st.Err()
+}
+
+//rollbackAddTunnel removes tunnel, firewall rules and policy rule (known by its 'labels') AddTunnel has created before it failed;
+//rollback errors are only logged so the original error is reported
+func (srv *tunnelService) rollbackAddTunnel(link netlink.Link, labels linkLabels) {
+	ctx, cancel := withDefaultDeadline(srv.appCtx, srv.writeTimeout)
+	defer cancel()
+	name := link.Attrs().Name
+	log := logger.FromContext(ctx)
+	if err := srv.delTunnelFwRules(ctx, name, labels); err != nil {
+		log.Warnf("rollback of tunnel '%s': %v", name, err)
+	}
+	if pr, ok := policyRouteOf(labels); ok {
+		if _, err := delPolicyRule(srv.nl, pr); err != nil {
+			log.Warnf("rollback of tunnel '%s': %v", name, err)
+		}
+	}
+	if err := srv.nl.LinkDel(link); err != nil {
+		log.Warnf("rollback of tunnel '%s': netlink.LinkDel: %v", name, err)
+	}
+}
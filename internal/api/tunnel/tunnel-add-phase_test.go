@@ -0,0 +1,27 @@
+package tunnel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_AddTunnelFailedPhase(t *testing.T) {
+	ctx := context.Background()
+	srv := NewTunnelService(ctx).(*tunnelService)
+
+	_, err := srv.AddTunnel(ctx, &tunnel.AddTunnelRequest{TunDestIP: "1.1.1"})
+	st := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	if details := st.Details(); assert.Len(t, details, 1) {
+		info, ok := details[0].(*errdetails.ErrorInfo)
+		if assert.True(t, ok) {
+			assert.Equal(t, addPhaseParse, info.GetReason())
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package tunnel
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/vishvananda/netlink"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+//addSteps AddTunnel phases from link-add on in the order they are done; resumable AddTunnel journals
+//the last done step in tunnel labels so retry after crash continues right after it
+var addSteps = []string{
+	addPhaseLinkAdd,
+	addPhaseLinkFlags,
+	addPhaseMssClamp,
+	addPhaseFwMark,
+	addPhaseShaping,
+	addPhaseLinkLabels,
+	addPhaseLinkUp,
+	addPhaseMcastRoutes,
+	addPhasePolicyRoute,
+	addPhaseRpFilter,
+	addPhaseTemplateSysctls,
+}
+
+//addStepIndex position of 'phase' in addSteps; -1 if it is not a step
+func addStepIndex(phase string) int {
+	for i, s := range addSteps {
+		if s == phase {
+			return i
+		}
+	}
+	return -1
+}
+
+//addResume how far interrupted AddTunnel got; zero value means tunnel is added from scratch
+type addResume struct {
+	step string
+}
+
+//done tells if step 'phase' is done by interrupted AddTunnel
+func (r addResume) done(phase string) bool {
+	return len(r.step) > 0 && addStepIndex(phase) <= addStepIndex(r.step)
+}
+
+//addRequestHash fingerprint binding journal to AddTunnel request; deadline and idempotency key are not part of it
+func addRequestHash(req *tunnel.AddTunnelRequest) string {
+	r := proto.Clone(req).(*tunnel.AddTunnelRequest)
+	r.TimeoutMs, r.IdempotencyKey = 0, ""
+	data, _ := proto.MarshalOptions{Deterministic: true}.Marshal(r)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:8])
+}
+
+//resumeOf tells how far interrupted AddTunnel of existing 'link' got
+//  - only IPIP tunnel to 'remote' journaled by AddTunnel of the same request resumes after the journaled step
+//  - any other link is complete or is not ours so AddTunnel fails with AlreadyExists
+//  - link-add can not carry the journal so crash right between link-add and the first journal
+//    leaves tunnel AddTunnel does not resume; it has to be removed by RemoveTunnel
+func resumeOf(link netlink.Link, remote net.IP, reqHash string) (addResume, error) {
+	name := link.Attrs().Name
+	notResumable := status.Errorf(codes.AlreadyExists, "tunnel '%v'", name)
+	t, ok := link.(*netlink.Iptun)
+	if !ok || !t.Remote.Equal(remote) {
+		return addResume{}, notResumable
+	}
+	labels := labelsOf(link)
+	step, ok := labels[labelAddStep]
+	if !ok || addStepIndex(step) < 0 {
+		return addResume{}, notResumable
+	}
+	if labels[labelAddRequest] != reqHash {
+		return addResume{}, status.Errorf(codes.AlreadyExists, "tunnel '%v' is partially added by another request", name)
+	}
+	return addResume{step: step}, nil
+}
+
+//journalAddStep records 'phase' as the last done step of resumable AddTunnel; no-op unless AddTunnel is resumable
+func (srv *tunnelService) journalAddStep(link netlink.Link, labels linkLabels, reqHash, phase string) error {
+	if !srv.resumableAdd {
+		return nil
+	}
+	labels[labelAddStep], labels[labelAddRequest] = phase, reqHash
+	return setLinkLabels(srv.nl, link, labels)
+}
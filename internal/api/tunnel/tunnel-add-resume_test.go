@@ -0,0 +1,126 @@
+package tunnel
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/vishvananda/netlink"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_AddTunnelResume(t *testing.T) {
+	remote := net.ParseIP("1.1.1.1")
+	req := &tunnel.AddTunnelRequest{TunDestIP: "1.1.1.1", Mtu: 1400, FwMark: "0x10"}
+	hash := addRequestHash(req)
+	assert.Equal(t, hash, addRequestHash(&tunnel.AddTunnelRequest{TunDestIP: "1.1.1.1", Mtu: 1400, FwMark: "0x10", TimeoutMs: 5, IdempotencyKey: "k"}))
+	assert.NotEqual(t, hash, addRequestHash(&tunnel.AddTunnelRequest{TunDestIP: "1.1.1.1", Mtu: 1500, FwMark: "0x10"}))
+
+	linkWith := func(alias string, flags net.Flags) netlink.Link {
+		return &netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun16843009", Alias: alias, Flags: flags}, Remote: remote}
+	}
+	//interrupted after each step resumes right after it
+	for i, s := range addSteps {
+		labels := linkLabels{labelAddStep: s, labelAddRequest: hash, labelFwMark: "0x10"}
+		r, err := resumeOf(linkWith(labels.alias(), net.FlagUp), remote, hash)
+		if !assert.NoError(t, err, s) {
+			continue
+		}
+		assert.Equal(t, s, r.step)
+		for j, next := range addSteps {
+			assert.Equal(t, j <= i, r.done(next), "interrupted after '%s', step '%s'", s, next)
+		}
+	}
+	assert.False(t, addResume{}.done(addPhaseLinkAdd))
+
+	notResumable := []netlink.Link{
+		linkWith("", 0),
+		linkWith("", net.FlagUp),
+		linkWith(linkLabels{labelWeight: "1"}.alias(), 0),
+		linkWith(linkLabels{labelAddStep: "bogus", labelAddRequest: hash}.alias(), 0),
+		&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun16843009"}, Remote: net.ParseIP("2.2.2.2")},
+		&netlink.Dummy{LinkAttrs: netlink.LinkAttrs{Name: "tun16843009"}},
+	}
+	for _, l := range notResumable {
+		_, err := resumeOf(l, remote, hash)
+		assert.Equal(t, codes.AlreadyExists, status.Code(err), l.Attrs().Alias)
+	}
+	_, err := resumeOf(linkWith(linkLabels{labelAddStep: addPhaseLinkUp, labelAddRequest: "other"}.alias(), 0), remote, hash)
+	if assert.Equal(t, codes.AlreadyExists, status.Code(err)) {
+		assert.Contains(t, err.Error(), "partially added by another request")
+	}
+}
+
+func Test_AddTunnelResumeByAddTunnel(t *testing.T) {
+	ctx := context.Background()
+	req := &tunnel.AddTunnelRequest{TunDestIP: "1.1.1.1", ClampMss: true, FwMark: "0x10"}
+	hash := addRequestHash(req)
+	const name = "tun16843009"
+	linkAt := func(alias string) netlink.Link {
+		return &netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: name, Alias: alias}, Remote: net.ParseIP("1.1.1.1")}
+	}
+	env, calls := recordCommands(t, "iptables", "sysctl")
+	newService := func(links ...netlink.Link) (*tunnelService, *fakeNetlink) {
+		srv := NewTunnelService(ctx, WithResumableAdd(), WithExecEnv(env), WithFirewallBackend(FirewallBackendIptables), WithUnmanagedAcknowledged()).(*tunnelService)
+		return srv, useFakeNetlink(srv, links...)
+	}
+	iptables := func(op string) (ret []string) {
+		for _, c := range calls() {
+			if strings.HasPrefix(c, "iptables ") && strings.Contains(c, " "+op+" ") {
+				ret = append(ret, c)
+			}
+		}
+		return ret
+	}
+
+	//journaled by the same request: resumes without link-add, rules already there are checked and kept
+	srv, fake := newService(linkAt(linkLabels{labelAddStep: addPhaseLinkFlags, labelAddRequest: hash}.alias()))
+	_, err := srv.AddTunnel(ctx, req)
+	if assert.NoError(t, err) {
+		assert.NotContains(t, fake.calls, "LinkAdd "+name)
+		assert.Contains(t, fake.calls, "LinkSetUp "+name)
+		assert.Len(t, iptables("-C"), 2, "MSS and fwmark rules are checked")
+		assert.Empty(t, iptables("-A"), "existing rules are not added twice")
+		link, e := fake.LinkByName(name)
+		if assert.NoError(t, e) {
+			labels := labelsOf(link)
+			assert.NotContains(t, labels, labelAddStep)
+			assert.NotContains(t, labels, labelAddRequest)
+			assert.NotEmpty(t, labels[labelMss])
+			assert.Equal(t, "0x10/0xffffffff", labels[labelFwMark])
+		}
+	}
+
+	//not journaled or journaled by another request: not ours to resume nor to remove
+	for _, alias := range []string{"", linkLabels{labelAddStep: addPhaseFwMark, labelAddRequest: "other"}.alias()} {
+		srv, fake = newService(linkAt(alias))
+		_, err = srv.AddTunnel(ctx, req)
+		assert.Equal(t, codes.AlreadyExists, status.Code(err), alias)
+		assert.Equal(t, []string{name}, fake.names(), alias)
+		assert.NotContains(t, fake.calls, "LinkDel "+name, alias)
+	}
+
+	//failed resume keeps the tunnel with its journal for the next retry
+	srv, fake = newService(linkAt(linkLabels{labelAddStep: addPhaseFwMark, labelAddRequest: hash}.alias()))
+	fake.fail["LinkSetUp"] = errors.New("link-up failed")
+	_, err = srv.AddTunnel(ctx, req)
+	if assert.Error(t, err) {
+		assert.NotContains(t, fake.calls, "LinkDel "+name)
+		link, e := fake.LinkByName(name)
+		if assert.NoError(t, e) {
+			assert.Equal(t, hash, labelsOf(link)[labelAddRequest])
+		}
+	}
+
+	//failed fresh add is rolled back
+	srv, fake = newService()
+	fake.fail["LinkSetUp"] = errors.New("link-up failed")
+	_, err = srv.AddTunnel(ctx, req)
+	assert.Error(t, err)
+	assert.Empty(t, fake.names())
+}
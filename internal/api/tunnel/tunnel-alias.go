@@ -0,0 +1,133 @@
+package tunnel
+
+import (
+	"context"
+	"net/url"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/vishvananda/netlink"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+//Human readable alias of tunnel is kept escaped in 'alias' label because
+//interface alias itself holds all the tunnel labels
+const (
+	maxTunnelAliasLen = 64
+
+	//maxLinkAliasLen kernel limit of interface alias (IFALIASZ without trailing zero)
+	maxLinkAliasLen = 255
+)
+
+//validateTunnelAlias checks 'alias' request argument; empty alias is valid and means no alias
+func validateTunnelAlias(alias string) error {
+	if len(alias) > maxTunnelAliasLen {
+		return status.Errorf(codes.InvalidArgument, "'alias': length %v is above maximum %v", len(alias), maxTunnelAliasLen)
+	}
+	if !utf8.ValidString(alias) {
+		return status.Errorf(codes.InvalidArgument, "'alias': is not valid UTF-8")
+	}
+	for _, r := range alias {
+		if unicode.IsControl(r) {
+			return status.Errorf(codes.InvalidArgument, "'alias': has control characters")
+		}
+	}
+	return nil
+}
+
+//userAlias gets human readable alias of tunnel from labels
+func (ll linkLabels) userAlias() string {
+	a, err := url.QueryUnescape(ll[labelAlias])
+	if err != nil {
+		return ll[labelAlias]
+	}
+	return a
+}
+
+//setUserAlias sets or removes (if empty) human readable alias of tunnel in labels
+func (ll linkLabels) setUserAlias(alias string) {
+	if len(alias) == 0 {
+		delete(ll, labelAlias)
+		return
+	}
+	ll[labelAlias] = url.QueryEscape(alias)
+}
+
+//SetTunnelAlias impl tunnel service
+func (srv *tunnelService) SetTunnelAlias(ctx context.Context, req *tunnel.SetTunnelAliasRequest) (*tunnel.TunnelInfo, error) {
+	if err := srv.denyMutation(); err != nil {
+		return nil, err
+	}
+	resp, err := srv.idempotency.do(ctx, "SetTunnelAlias", req.GetIdempotencyKey(), req, func() (proto.Message, error) {
+		return srv.setTunnelAlias(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*tunnel.TunnelInfo), nil
+}
+
+//setTunnelAlias sets or clears alias of the tunnel
+func (srv *tunnelService) setTunnelAlias(ctx context.Context, req *tunnel.SetTunnelAliasRequest) (resp *tunnel.TunnelInfo, err error) {
+	span := srv.spanOf(ctx)
+	span.SetAttributes(attribute.String("tunDestIP", req.GetTunDestIP()), attribute.String("alias", req.GetAlias()))
+
+	ctx, cancel := withDefaultDeadline(ctx, srv.writeTimeout)
+	defer cancel()
+
+	var leave func()
+	if leave, err = srv.enter(ctx); err != nil {
+		return nil, err
+	}
+	defer func() {
+		leave()
+		err = srv.correctError(err)
+	}()
+
+	var tunnelName string
+	if tunnelName, err = tunnelNameOf(req.GetTunDestIP(), req.GetName()); err != nil {
+		return nil, err
+	}
+	span.SetAttributes(attribute.String("tunnel-name", tunnelName))
+	if err = validateTunnelAlias(req.GetAlias()); err != nil {
+		return nil, err
+	}
+
+	var unlock func()
+	if unlock, err = srv.lockForMutation(ctx, tunnelName); err != nil {
+		return
+	}
+	defer unlock()
+
+	var link netlink.Link
+	if link, err = lookupTunnel(srv.nl, tunnelName); err != nil {
+		return
+	}
+	if _, ok := link.(*netlink.Iptun); !ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "link '%s' is '%s' but not IPIP tunnel", tunnelName, link.Type())
+	}
+	labels := labelsOf(link)
+	labels.setUserAlias(req.GetAlias())
+	if n := len(labels.alias()); n > maxLinkAliasLen {
+		return nil, status.Errorf(codes.FailedPrecondition, "'alias': tunnel labels with the alias take %v bytes but interface alias is limited by %v", n, maxLinkAliasLen)
+	}
+	srv.addSpanDbgEvent(ctx, span, "setLinkLabels")
+	if err = setLinkLabels(srv.nl, link, labels); err != nil {
+		return
+	}
+	if link, err = lookupTunnel(srv.nl, tunnelName); err != nil {
+		return
+	}
+	if resp, err = tunnelInfoFromLink(link); err != nil {
+		return nil, err
+	}
+	if resp.UnderlayDev, err = underlayDevName(srv.nl, link); err != nil {
+		return nil, err
+	}
+	srv.notifyChange(ctx, webhookActionSetAlias, tunnelName, resp.GetRemote())
+	return resp, nil
+}
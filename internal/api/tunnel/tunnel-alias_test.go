@@ -0,0 +1,39 @@
+package tunnel
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/stretchr/testify/assert"
+	"github.com/vishvananda/netlink"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_TunnelAlias(t *testing.T) {
+	assert.NoError(t, validateTunnelAlias(""))
+	assert.NoError(t, validateTunnelAlias("edge, team=net"))
+	for _, a := range []string{strings.Repeat("a", maxTunnelAliasLen+1), "a\tb", "\xff"} {
+		assert.Equal(t, codes.InvalidArgument, status.Code(validateTunnelAlias(a)), a)
+	}
+
+	link := &netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun1"}}
+	ll := linkLabels{labelWeight: "10"}
+	ll.setUserAlias("edge, team=net")
+	link.Alias = ll.alias()
+	assert.Equal(t, "crispy-tunnel:alias=edge%2C+team%3Dnet,weight=10", link.Alias)
+	ll = labelsOf(link)
+	assert.Equal(t, "edge, team=net", ll.userAlias())
+	assert.Equal(t, uint32(10), ll.weight())
+	ll.setUserAlias("")
+	assert.Equal(t, "crispy-tunnel:weight=10", ll.alias())
+
+	ctx := context.Background()
+	srv := NewTunnelService(ctx).(*tunnelService)
+	_, err := srv.SetTunnelAlias(ctx, &tunnel.SetTunnelAliasRequest{TunDestIP: "1.1.1.1", Name: "tun16843009"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = srv.SetTunnelAlias(ctx, &tunnel.SetTunnelAliasRequest{Name: "eth0"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
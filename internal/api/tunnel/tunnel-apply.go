@@ -0,0 +1,374 @@
+package tunnel
+
+import (
+	"context"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/pkg/errors"
+	"github.com/vishvananda/netlink"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+//applyItem tunnel of Apply document prepared before anything is changed
+type applyItem struct {
+	spec    *tunnel.AddTunnelRequest //as given; AddTunnel applies its template itself
+	desired *tunnel.AddTunnelRequest //with template applied; it is compared against the tunnel
+	remote  net.IP
+	name    string
+}
+
+//specError refers error of document tunnel to its index keeping error code
+func specError(i int, err error) error {
+	st := status.Convert(err)
+	return status.Errorf(st.Code(), "'tunnels[%v]': %s", i, st.Message())
+}
+
+//prepareApply validates every tunnel of Apply document; one bad tunnel rejects the whole document
+func (srv *tunnelService) prepareApply(specs []*tunnel.AddTunnelRequest) ([]applyItem, error) {
+	ret := make([]applyItem, 0, len(specs))
+	seen := make(map[string]int, len(specs))
+	for i, spec := range specs {
+		ip, err := parseTunDestIP(spec.GetTunDestIP())
+		if err != nil {
+			return nil, specError(i, err)
+		}
+		var name string
+		if name, err = TunnelNameForIP(ip); err != nil {
+			return nil, specError(i, err)
+		}
+		if first, dup := seen[name]; dup {
+			return nil, status.Errorf(codes.InvalidArgument, "'tunnels[%v]': tunnel '%s' is already given by 'tunnels[%v]'", i, name, first)
+		}
+		seen[name] = i
+		tmpl, err := srv.templateOf(spec.GetTemplate())
+		if err == nil {
+			err = srv.validateAddTunnelFields(tunnelTypeIpip, tmpl.apply(spec))
+		}
+		if err != nil {
+			return nil, specError(i, err)
+		}
+		ret = append(ret, applyItem{spec: spec, desired: tmpl.apply(spec), remote: ip, name: name})
+	}
+	return ret, nil
+}
+
+//tunnelSpecDiff names parameters of 'desired' tunnel which 'actual' one does not have;
+//TTL is not reported by the kernel link so it is not compared, admin state is runtime state and is not compared either
+func tunnelSpecDiff(desired *tunnel.AddTunnelRequest, actual *tunnel.TunnelInfo) []string {
+	var ret []string
+	differs := func(param string, d bool) {
+		if d {
+			ret = append(ret, param)
+		}
+	}
+	flagDiffers := func(f tunnel.LinkFlag, actual bool) bool {
+		return (f == tunnel.LinkFlag_LINK_FLAG_ON && !actual) || (f == tunnel.LinkFlag_LINK_FLAG_OFF && actual)
+	}
+	differs("mtu", desired.GetMtu() > 0 && desired.GetMtu() != actual.GetMtu())
+	differs("noArp", flagDiffers(desired.GetNoArp(), actual.GetNoArp()))
+	differs("multicast", flagDiffers(desired.GetMulticast(), actual.GetMulticast()))
+	differs("underlayDev", desired.GetUnderlayDev() != actual.GetUnderlayDev())
+	differs("weight", desired.GetWeight() != actual.GetWeight())
+	mss, _ := mssOfRequest(desired)
+	differs("clampMss", mss != actual.GetClampMss())
+	var mark string
+	if m := desired.GetFwMark(); len(m) > 0 {
+		fm, _ := parseFwMark(m)
+		mark = fm.String()
+	}
+	differs("fwMark", mark != actual.GetFwMark())
+	differs("template", desired.GetTemplate() != actual.GetTemplate())
+	routes, _ := parseMulticastRoutes(desired.GetMulticastRoutes())
+	want := make([]string, 0, len(routes))
+	for _, r := range routes {
+		want = append(want, r.String())
+	}
+	have := append([]string(nil), actual.GetMulticastRoutes()...)
+	sort.Strings(want)
+	sort.Strings(have)
+	differs("multicastRoutes", strings.Join(want, ",") != strings.Join(have, ","))
+	var pr *tunnel.PolicyRoute
+	if p, _ := parsePolicyRoute(desired.GetPolicyRoute()); p != nil {
+		pr = p.info()
+	}
+	differs("policyRoute", !proto.Equal(pr, actual.GetPolicyRoute()))
+	var spi string
+	if r, e := parseSpiRange(desired.GetEncryption().GetSpiRange()); e == nil {
+		spi = r.String()
+	}
+	differs("encryption", desired.GetEncryption().GetProfile() != actual.GetEncryption().GetProfile() ||
+		spi != actual.GetEncryption().GetSpiRange())
+	var shaping egressShaping
+	if s, _ := parseEgressShaping(desired.GetEgressRateBps(), desired.GetQdisc()); s != nil {
+		shaping = *s
+	}
+	differs("egressShaping", shaping.rateBps != actual.GetEgressRateBps() || shaping.qdisc != actual.GetQdisc())
+	return ret
+}
+
+//Apply impl tunnel service
+//  - the whole document is validated before anything is changed; it is not a transaction: failure of one tunnel
+//    does not undo changes already made, each tunnel gets its own result
+//  - missing tunnels are created; tunnels with different parameters are updated by recreation since
+//    the service sets them at creation only, so such tunnel is briefly gone; if creation fails the previous
+//    tunnel is recreated as it was read and the error is reported
+//  - tunnel is locked from the read through recreation so no other RPC changes it in between
+//  - recreated tunnel keeps what the request can't carry: labels set by other RPCs (alias, quarantine,
+//    maintenance), admin state and TTL unless the document sets TTL
+//  - with 'prune' managed tunnels not in the document are removed; cordoned tunnels are never touched;
+//    prune with empty document removes every managed tunnel so it requires 'confirm'
+//  - tunnels are created, updated and removed by AddTunnel and RemoveTunnel so they behave the same
+func (srv *tunnelService) Apply(ctx context.Context, req *tunnel.ApplyRequest) (resp *tunnel.ApplyResponse, err error) {
+	if err = srv.denyMutation(); err != nil {
+		return nil, err
+	}
+	if req.GetPrune() {
+		if err = srv.denyBulkMutation(); err != nil {
+			return nil, err
+		}
+	}
+	span := srv.spanOf(ctx)
+	span.SetAttributes(attribute.Int("tunnels", len(req.GetTunnels())), attribute.Bool("prune", req.GetPrune()))
+	defer func() {
+		err = srv.correctError(err)
+	}()
+	if req.GetPrune() && len(req.GetTunnels()) == 0 && !req.GetConfirm() {
+		return nil, status.Errorf(codes.FailedPrecondition, "'confirm': is required to prune with empty document which removes all managed tunnels")
+	}
+
+	var items []applyItem
+	if items, err = srv.prepareApply(req.GetTunnels()); err != nil {
+		return nil, err
+	}
+	existing := make(map[string]netlink.Link)
+	err = srv.enumLinks(func(nl netlink.Link) error {
+		if isOwnedLink(nl) {
+			existing[nl.Attrs().Name] = nl
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp = new(tunnel.ApplyResponse)
+	inDoc := make(map[string]bool, len(items))
+	for _, it := range items {
+		inDoc[it.name] = true
+		r := &tunnel.ApplyItemResult{Name: it.name, TunDestIP: it.remote.String()}
+		resp.Results = append(resp.Results, r)
+		if existing[it.name] == nil {
+			r.Action = tunnel.PlanAction_PLAN_ACTION_CREATE
+			applyFailed(r, srv.addTunnelOf(ctx, it.spec))
+			continue
+		}
+		srv.applyExisting(ctx, it, r)
+	}
+	if req.GetPrune() {
+		var extra []string
+		for name := range existing {
+			if !inDoc[name] {
+				extra = append(extra, name)
+			}
+		}
+		sort.Strings(extra)
+		for _, name := range extra {
+			link := existing[name]
+			r := &tunnel.ApplyItemResult{Name: name, TunDestIP: linkRemote(link), Action: tunnel.PlanAction_PLAN_ACTION_REMOVE}
+			resp.Results = append(resp.Results, r)
+			if isCordoned(link) {
+				r.Action, r.Error = tunnel.PlanAction_PLAN_ACTION_SKIP, "tunnel is cordoned"
+				continue
+			}
+			_, err = srv.RemoveTunnel(ctx, &tunnel.RemoveTunnelRequest{TunDestIP: r.TunDestIP, VerifyRemote: true})
+			applyFailed(r, err)
+		}
+	}
+	return resp, nil
+}
+
+//applyExisting brings existing tunnel to its document spec holding the tunnel lock and concurrency slot
+//from the read through recreation
+func (srv *tunnelService) applyExisting(ctx context.Context, it applyItem, r *tunnel.ApplyItemResult) {
+	ctx, leave, err := srv.hold(ctx)
+	if err != nil {
+		r.Action = tunnel.PlanAction_PLAN_ACTION_SKIP
+		applyFailed(r, err)
+		return
+	}
+	defer leave()
+	var unlock func()
+	if ctx, unlock, err = srv.tunnelLocks.hold(ctx, it.name); err != nil {
+		r.Action = tunnel.PlanAction_PLAN_ACTION_SKIP
+		applyFailed(r, err)
+		return
+	}
+	defer unlock()
+	if err = srv.denyMutation(); err != nil {
+		r.Action = tunnel.PlanAction_PLAN_ACTION_SKIP
+		applyFailed(r, err)
+		return
+	}
+
+	var actual *tunnel.TunnelInfo
+	if actual, err = srv.GetTunnel(ctx, &tunnel.GetTunnelRequest{TunDestIP: r.TunDestIP}); status.Code(err) == codes.NotFound {
+		//removed since document was planned
+		r.Action = tunnel.PlanAction_PLAN_ACTION_CREATE
+		applyFailed(r, srv.addTunnelOf(ctx, it.spec))
+		return
+	} else if err != nil {
+		r.Action = tunnel.PlanAction_PLAN_ACTION_SKIP
+		applyFailed(r, err)
+		return
+	}
+	if actual.GetCordoned() {
+		r.Action, r.Error = tunnel.PlanAction_PLAN_ACTION_SKIP, "tunnel is cordoned"
+		return
+	}
+	if r.Changed = tunnelSpecDiff(it.desired, actual); len(r.Changed) == 0 {
+		r.Action = tunnel.PlanAction_PLAN_ACTION_KEEP
+		return
+	}
+	r.Action = tunnel.PlanAction_PLAN_ACTION_UPDATE
+	var link netlink.Link
+	if link, err = lookupTunnel(srv.nl, it.name); err != nil {
+		applyFailed(r, err)
+		return
+	}
+	//state the request can't carry is taken over by the new tunnel
+	carried, ttl := make(linkLabels), linkTtl(link)
+	for k, v := range labelsOf(link) {
+		if isRuntimeLabel(k) {
+			carried[k] = v
+		}
+	}
+	spec := it.spec
+	if it.desired.GetTtl() == 0 {
+		spec = proto.Clone(spec).(*tunnel.AddTunnelRequest)
+		spec.Ttl = ttl
+	}
+	if _, err = srv.RemoveTunnel(ctx, &tunnel.RemoveTunnelRequest{TunDestIP: r.TunDestIP, VerifyRemote: true}); err != nil {
+		applyFailed(r, err)
+		return
+	}
+	if err = srv.recreateTunnel(ctx, it.name, spec, carried, actual.GetAdminUp()); err != nil {
+		st := status.Convert(err)
+		if e := srv.recreateTunnel(ctx, it.name, srv.addRequestOf(actual, ttl), carried, actual.GetAdminUp()); e != nil {
+			err = status.Errorf(st.Code(), "%s; previous tunnel is not restored: %s", st.Message(), status.Convert(e).Message())
+		} else {
+			err = status.Errorf(st.Code(), "%s; previous tunnel is restored", st.Message())
+		}
+	}
+	applyFailed(r, err)
+}
+
+//runtimeLabels labels RPCs set on existing tunnel which AddTunnel request can't carry
+var runtimeLabels = []string{labelAlias, labelQuarantined, labelMaintenanceDown}
+
+func isRuntimeLabel(key string) bool {
+	for _, k := range runtimeLabels {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+//linkTtl TTL of IPIP tunnel link; 0 (inherit) for other links
+func linkTtl(link netlink.Link) uint32 {
+	if t, ok := link.(*netlink.Iptun); ok {
+		return uint32(t.Ttl)
+	}
+	return 0
+}
+
+//recreateTunnel adds tunnel 'name' by 'req' in place of removed one keeping its runtime state:
+//'carried' labels and admin state; tunnel which was down but has routes needing link up
+//is added up and is brought down right after so the request passes AddTunnel validation
+func (srv *tunnelService) recreateTunnel(ctx context.Context, name string, req *tunnel.AddTunnelRequest, carried linkLabels, adminUp bool) error {
+	req = proto.Clone(req).(*tunnel.AddTunnelRequest)
+	needsUp := len(req.GetMulticastRoutes()) > 0 || req.GetPolicyRoute() != nil
+	req.StartDown = !adminUp && !needsUp
+	if err := srv.addTunnelOf(ctx, req); err != nil {
+		return err
+	}
+	bringDown := !adminUp && !req.GetStartDown()
+	if len(carried) == 0 && !bringDown {
+		return nil
+	}
+	link, err := lookupTunnel(srv.nl, name)
+	if err != nil {
+		return err
+	}
+	if len(carried) > 0 {
+		labels := labelsOf(link)
+		for k, v := range carried {
+			labels[k] = v
+		}
+		if err = setLinkLabels(srv.nl, link, labels); err != nil {
+			return err
+		}
+	}
+	if bringDown {
+		if err = srv.nl.LinkSetDown(link); err != nil {
+			return errors.Wrapf(err, "netlink.LinkSetDown(%s)", name)
+		}
+	}
+	return nil
+}
+
+//addRequestOf AddTunnel request recreating tunnel as it is described by 'info' with TTL 'ttl' of its link;
+//admin state is left to recreateTunnel, template is kept only if it is still known
+func (srv *tunnelService) addRequestOf(info *tunnel.TunnelInfo, ttl uint32) *tunnel.AddTunnelRequest {
+	flag := func(on bool) tunnel.LinkFlag {
+		if on {
+			return tunnel.LinkFlag_LINK_FLAG_ON
+		}
+		return tunnel.LinkFlag_LINK_FLAG_OFF
+	}
+	req := &tunnel.AddTunnelRequest{
+		TunDestIP:       info.GetRemote(),
+		NoArp:           flag(info.GetNoArp()),
+		Multicast:       flag(info.GetMulticast()),
+		UnderlayDev:     info.GetUnderlayDev(),
+		Mtu:             info.GetMtu(),
+		MulticastRoutes: info.GetMulticastRoutes(),
+		Weight:          info.GetWeight(),
+		Ttl:             ttl,
+		FwMark:          info.GetFwMark(),
+		PolicyRoute:     info.GetPolicyRoute(),
+		Encryption:      info.GetEncryption(),
+		EgressRateBps:   info.GetEgressRateBps(),
+		Qdisc:           info.GetQdisc(),
+	}
+	if mss := info.GetClampMss(); len(mss) > 0 {
+		req.ClampMss = true
+		if v, e := strconv.ParseUint(mss, 10, 32); e == nil {
+			req.MssValue = uint32(v)
+		}
+	}
+	if _, e := srv.templateOf(info.GetTemplate()); e == nil {
+		req.Template = info.GetTemplate()
+	}
+	return req
+}
+
+//addTunnelOf creates tunnel the way AddTunnel does
+func (srv *tunnelService) addTunnelOf(ctx context.Context, req *tunnel.AddTunnelRequest) error {
+	_, err := srv.AddTunnel(ctx, req)
+	return err
+}
+
+//applyFailed records error of tunnel 'r' if there is one
+func applyFailed(r *tunnel.ApplyItemResult, err error) {
+	if err != nil {
+		st := status.Convert(err)
+		r.Code, r.Error = st.Code().String(), st.Message()
+	}
+}
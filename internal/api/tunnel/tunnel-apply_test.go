@@ -0,0 +1,191 @@
+package tunnel
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/stretchr/testify/assert"
+	"github.com/vishvananda/netlink"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func Test_ApplySpecDiff(t *testing.T) {
+	actual := &tunnel.TunnelInfo{
+		Name:            "tun16843009",
+		Remote:          "1.1.1.1",
+		Mtu:             1400,
+		MulticastRoutes: []string{"239.1.0.0/16", "224.0.0.0/24"},
+		ClampMss:        "pmtu",
+		FwMark:          "0x10/0xffffffff",
+		EgressRateBps:   1000000,
+		Qdisc:           "tbf",
+	}
+	desired := &tunnel.AddTunnelRequest{
+		TunDestIP:       "1.1.1.1",
+		MulticastRoutes: []string{"224.0.0.0/24", "239.1.0.0/16"},
+		ClampMss:        true,
+		FwMark:          "16",
+		EgressRateBps:   1000000,
+	}
+	assert.Empty(t, tunnelSpecDiff(desired, actual))
+
+	desired.Mtu, desired.NoArp, desired.FwMark = 1300, tunnel.LinkFlag_LINK_FLAG_ON, ""
+	desired.MulticastRoutes, desired.Qdisc = nil, "htb"
+	assert.Equal(t, []string{"mtu", "noArp", "fwMark", "multicastRoutes", "egressShaping"}, tunnelSpecDiff(desired, actual))
+}
+
+func Test_ApplyValidatesWholeDocument(t *testing.T) {
+	ctx := context.Background()
+	srv := NewTunnelService(ctx).(*tunnelService)
+	srv.linkList = func() ([]netlink.Link, error) {
+		t.Fatal("links are read before document is validated")
+		return nil, nil
+	}
+	cases := []struct {
+		tunnels []*tunnel.AddTunnelRequest
+		msg     string
+	}{
+		{[]*tunnel.AddTunnelRequest{{TunDestIP: "1.1.1.1"}, {TunDestIP: "bad"}}, "'tunnels[1]'"},
+		{[]*tunnel.AddTunnelRequest{{TunDestIP: "1.1.1.1"}, {TunDestIP: "1.1.1.1/32"}}, "is already given by 'tunnels[0]'"},
+		{[]*tunnel.AddTunnelRequest{{TunDestIP: "1.1.1.1", MssValue: 1000}}, "'mssValue'"},
+		{[]*tunnel.AddTunnelRequest{{TunDestIP: "1.1.1.1", Template: "none"}}, "'template'"},
+	}
+	for _, c := range cases {
+		_, err := srv.Apply(ctx, &tunnel.ApplyRequest{Tunnels: c.tunnels})
+		if assert.Equal(t, codes.InvalidArgument, status.Code(err), c.msg) {
+			assert.Contains(t, err.Error(), c.msg)
+		}
+	}
+}
+
+func Test_ApplyUpdateRestores(t *testing.T) {
+	ctx := context.Background()
+	//there is no firewall to install fwmark with so update fails after the tunnel is removed
+	srv := NewTunnelService(ctx, WithMaxConcurrency(1), WithExecEnv(fakeCommands(t, "sysctl"))).(*tunnelService)
+	fake := useFakeNetlink(srv)
+	_, err := srv.AddTunnel(ctx, &tunnel.AddTunnelRequest{TunDestIP: "1.1.1.1", Mtu: 1400, Weight: 7})
+	if !assert.NoError(t, err) {
+		return
+	}
+	resp, err := srv.Apply(ctx, &tunnel.ApplyRequest{Tunnels: []*tunnel.AddTunnelRequest{
+		{TunDestIP: "1.1.1.1", Mtu: 1300, FwMark: "0x10"},
+	}})
+	if !assert.NoError(t, err) || !assert.Len(t, resp.GetResults(), 1) {
+		return
+	}
+	r := resp.GetResults()[0]
+	assert.Equal(t, tunnel.PlanAction_PLAN_ACTION_UPDATE, r.GetAction())
+	assert.NotEmpty(t, r.GetCode())
+	assert.Contains(t, r.GetError(), "previous tunnel is restored")
+	assert.Contains(t, fake.calls, "LinkDel tun16843009")
+
+	info, err := srv.GetTunnel(ctx, &tunnel.GetTunnelRequest{TunDestIP: "1.1.1.1"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, uint32(1400), info.GetMtu())
+		assert.Equal(t, uint32(7), info.GetWeight())
+		assert.Empty(t, info.GetFwMark())
+		assert.True(t, info.GetAdminUp())
+	}
+}
+
+func Test_ApplyPruneEmptyDocument(t *testing.T) {
+	ctx := context.Background()
+	srv := NewTunnelService(ctx, WithExecEnv(fakeCommands(t, "sysctl"))).(*tunnelService)
+	fake := useFakeNetlink(srv)
+	_, err := srv.AddTunnel(ctx, &tunnel.AddTunnelRequest{TunDestIP: "1.1.1.1"})
+	if !assert.NoError(t, err) {
+		return
+	}
+	_, err = srv.Apply(ctx, &tunnel.ApplyRequest{Prune: true})
+	if assert.Equal(t, codes.FailedPrecondition, status.Code(err)) {
+		assert.Contains(t, err.Error(), "'confirm'")
+	}
+	assert.Equal(t, []string{"tun16843009"}, fake.names())
+
+	resp, err := srv.Apply(ctx, &tunnel.ApplyRequest{Prune: true, Confirm: true})
+	if assert.NoError(t, err) && assert.Len(t, resp.GetResults(), 1) {
+		assert.Equal(t, tunnel.PlanAction_PLAN_ACTION_REMOVE, resp.GetResults()[0].GetAction())
+	}
+	assert.Empty(t, fake.names())
+}
+
+func Test_ApplyUpdateKeepsRuntimeState(t *testing.T) {
+	ctx := context.Background()
+	srv := NewTunnelService(ctx, WithExecEnv(fakeCommands(t, "sysctl"))).(*tunnelService)
+	fake := useFakeNetlink(srv)
+	const name = "tun16843009"
+	_, err := srv.AddTunnel(ctx, &tunnel.AddTunnelRequest{TunDestIP: "1.1.1.1", Mtu: 1400, Ttl: 64})
+	if !assert.NoError(t, err) {
+		return
+	}
+	_, err = srv.SetTunnelAlias(ctx, &tunnel.SetTunnelAliasRequest{TunDestIP: "1.1.1.1", Alias: "edge-1"})
+	if !assert.NoError(t, err) {
+		return
+	}
+	link, _ := fake.LinkByName(name)
+	labels := labelsOf(link)
+	labels[labelMaintenanceDown] = ""
+	assert.NoError(t, setLinkLabels(fake, link, labels))
+	_, err = srv.SetTunnelState(ctx, &tunnel.SetTunnelStateRequest{TunDestIP: "1.1.1.1"})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	resp, err := srv.Apply(ctx, &tunnel.ApplyRequest{Tunnels: []*tunnel.AddTunnelRequest{{TunDestIP: "1.1.1.1", Mtu: 1300}}})
+	if !assert.NoError(t, err) || !assert.Len(t, resp.GetResults(), 1) {
+		return
+	}
+	assert.Equal(t, tunnel.PlanAction_PLAN_ACTION_UPDATE, resp.GetResults()[0].GetAction())
+	assert.Empty(t, resp.GetResults()[0].GetError())
+	link, err = fake.LinkByName(name)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, 1300, link.Attrs().MTU)
+	assert.Equal(t, uint32(64), linkTtl(link))
+	assert.Zero(t, link.Attrs().Flags&net.FlagUp, "admin-down tunnel stays down")
+	labels = labelsOf(link)
+	assert.Contains(t, labels, labelMaintenanceDown)
+	info, err := srv.GetTunnel(ctx, &tunnel.GetTunnelRequest{TunDestIP: "1.1.1.1"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "edge-1", info.GetAlias())
+	}
+}
+
+func Test_ApplyRestoresDownTunnelWithRoutes(t *testing.T) {
+	ctx := context.Background()
+	//there is no firewall to install fwmark with so update fails after the tunnel is removed
+	srv := NewTunnelService(ctx, WithExecEnv(fakeCommands(t, "sysctl"))).(*tunnelService)
+	fake := useFakeNetlink(srv)
+	const name = "tun16843009"
+	spec := &tunnel.AddTunnelRequest{TunDestIP: "1.1.1.1", Multicast: tunnel.LinkFlag_LINK_FLAG_ON, MulticastRoutes: []string{"239.1.0.0/16"}}
+	_, err := srv.AddTunnel(ctx, spec)
+	if !assert.NoError(t, err) {
+		return
+	}
+	_, err = srv.SetTunnelState(ctx, &tunnel.SetTunnelStateRequest{TunDestIP: "1.1.1.1"})
+	if !assert.NoError(t, err) {
+		return
+	}
+	update := proto.Clone(spec).(*tunnel.AddTunnelRequest)
+	update.FwMark = "0x10"
+	resp, err := srv.Apply(ctx, &tunnel.ApplyRequest{Tunnels: []*tunnel.AddTunnelRequest{update}})
+	if !assert.NoError(t, err) || !assert.Len(t, resp.GetResults(), 1) {
+		return
+	}
+	assert.Contains(t, resp.GetResults()[0].GetError(), "previous tunnel is restored")
+	link, err := fake.LinkByName(name)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Zero(t, link.Attrs().Flags&net.FlagUp)
+	info, err := srv.GetTunnel(ctx, &tunnel.GetTunnelRequest{TunDestIP: "1.1.1.1"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"239.1.0.0/16"}, info.GetMulticastRoutes())
+		assert.Empty(t, info.GetFwMark())
+	}
+}
@@ -0,0 +1,86 @@
+package tunnel
+
+import (
+	"context"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"google.golang.org/grpc/status"
+)
+
+//statuses of batch items
+const (
+	batchItemOK        = "ok"
+	batchItemFailed    = "failed"
+	batchItemDuplicate = "duplicate-in-request"
+)
+
+//batchKeyOf key items of a batch are deduplicated by: tunnel name for valid IP, the IP as is otherwise;
+//IPIP tunnel name is derived from remote so items overlap in the batch exactly when their names are the same
+func batchKeyOf(tunDestIP string) string {
+	if ip, err := parseTunDestIP(tunDestIP); err == nil {
+		if name, err := TunnelNameForIP(ip); err == nil {
+			return name
+		}
+	}
+	return tunDestIP
+}
+
+//runBatch runs 'do' once per unique tunnel of the batch in order; later occurrences of the same tunnel
+//are not run but reported as duplicates of the first one
+func runBatch(ips []string, do func(i int) (*tunnel.TunnelInfo, error)) []*tunnel.BatchItemResult {
+	ret := make([]*tunnel.BatchItemResult, 0, len(ips))
+	seen := make(map[string]int, len(ips))
+	for i, ip := range ips {
+		r := &tunnel.BatchItemResult{TunDestIP: ip}
+		ret = append(ret, r)
+		key := batchKeyOf(ip)
+		if first, dup := seen[key]; dup {
+			r.Status = batchItemDuplicate
+			r.DuplicateOf = uint32(first)
+			continue
+		}
+		seen[key] = i
+		info, err := do(i)
+		if err != nil {
+			st := status.Convert(err)
+			r.Status, r.Code, r.Error = batchItemFailed, st.Code().String(), st.Message()
+			continue
+		}
+		r.Status, r.Tunnel = batchItemOK, info
+	}
+	return ret
+}
+
+//AddTunnels impl tunnel service
+func (srv *tunnelService) AddTunnels(ctx context.Context, req *tunnel.AddTunnelsRequest) (*tunnel.BatchResponse, error) {
+	if err := srv.denyMutation(); err != nil {
+		return nil, err
+	}
+	items := req.GetTunnels()
+	ips := make([]string, 0, len(items))
+	for _, it := range items {
+		ips = append(ips, it.GetTunDestIP())
+	}
+	results := runBatch(ips, func(i int) (*tunnel.TunnelInfo, error) {
+		resp, err := srv.AddTunnel(ctx, items[i])
+		return resp.GetTunnel(), err
+	})
+	return &tunnel.BatchResponse{Results: results}, nil
+}
+
+//RemoveTunnels impl tunnel service
+func (srv *tunnelService) RemoveTunnels(ctx context.Context, req *tunnel.RemoveTunnelsRequest) (*tunnel.BatchResponse, error) {
+	if err := srv.denyMutation(); err != nil {
+		return nil, err
+	}
+	items := req.GetTunnels()
+	ips := make([]string, 0, len(items))
+	for _, it := range items {
+		ips = append(ips, it.GetTunDestIP())
+	}
+	results := runBatch(ips, func(i int) (*tunnel.TunnelInfo, error) {
+		_, err := srv.RemoveTunnel(ctx, items[i])
+		return nil, err
+	})
+	return &tunnel.BatchResponse{Results: results}, nil
+}
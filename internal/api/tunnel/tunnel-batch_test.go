@@ -0,0 +1,58 @@
+package tunnel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_BatchDuplicates(t *testing.T) {
+	ips := []string{"1.1.1.1", "2.2.2.2", "1.1.1.1", "1.1.1", "1.1.1", "1.1.1.1"}
+	var done []string
+	results := runBatch(ips, func(i int) (*tunnel.TunnelInfo, error) {
+		done = append(done, ips[i])
+		if ips[i] == "2.2.2.2" {
+			return nil, status.Error(codes.AlreadyExists, "tunnel 'tun33686018'")
+		}
+		return &tunnel.TunnelInfo{Remote: ips[i]}, nil
+	})
+	assert.Equal(t, []string{"1.1.1.1", "2.2.2.2", "1.1.1"}, done)
+	type item struct {
+		status      string
+		code        string
+		duplicateOf uint32
+	}
+	var got []item
+	for _, r := range results {
+		got = append(got, item{r.GetStatus(), r.GetCode(), r.GetDuplicateOf()})
+	}
+	assert.Equal(t, []item{
+		{batchItemOK, "", 0},
+		{batchItemFailed, codes.AlreadyExists.String(), 0},
+		{batchItemDuplicate, "", 0},
+		{batchItemOK, "", 0},
+		{batchItemDuplicate, "", 3},
+		{batchItemDuplicate, "", 0},
+	}, got)
+
+	ctx := context.Background()
+	srv := NewTunnelService(ctx).(*tunnelService)
+	resp, err := srv.AddTunnels(ctx, &tunnel.AddTunnelsRequest{Tunnels: []*tunnel.AddTunnelRequest{
+		{TunDestIP: "1.1.1"}, {TunDestIP: "1.1.1"},
+	}})
+	if assert.NoError(t, err) && assert.Len(t, resp.GetResults(), 2) {
+		assert.Equal(t, codes.InvalidArgument.String(), resp.GetResults()[0].GetCode())
+		assert.Equal(t, batchItemDuplicate, resp.GetResults()[1].GetStatus())
+	}
+	resp, err = srv.RemoveTunnels(ctx, &tunnel.RemoveTunnelsRequest{Tunnels: []*tunnel.RemoveTunnelRequest{
+		{TunDestIP: "0.0.0.0"}, {TunDestIP: "0.0.0.0"},
+	}})
+	if assert.NoError(t, err) && assert.Len(t, resp.GetResults(), 2) {
+		assert.Equal(t, batchItemFailed, resp.GetResults()[0].GetStatus())
+		assert.Equal(t, batchItemDuplicate, resp.GetResults()[1].GetStatus())
+	}
+}
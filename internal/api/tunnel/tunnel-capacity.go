@@ -0,0 +1,61 @@
+package tunnel
+
+import (
+	"context"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/pkg/errors"
+	"github.com/vishvananda/netlink"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+//countTunnels counts tunnels of the service and all IPIP links of the host
+func (srv *tunnelService) countTunnels() (managed, total int, err error) {
+	var links []netlink.Link
+	if links, err = srv.linkList(); err != nil {
+		return 0, 0, errors.Wrap(err, "netlink.LinkList")
+	}
+	for _, link := range links {
+		if link.Type() == (&netlink.Iptun{}).Type() {
+			total++
+		}
+		if a := link.Attrs(); a != nil && reDetectRule.MatchString(a.Name) {
+			managed++
+		}
+	}
+	return managed, total, nil
+}
+
+//checkCapacity refuses new tunnel when the service already has 'maxTunnels' ones;
+//'replaced' tunnels are removed by the same call once the new one is in place and are not counted
+func (srv *tunnelService) checkCapacity(replaced int) error {
+	if srv.maxTunnels == 0 {
+		return nil
+	}
+	managed, _, err := srv.countTunnels()
+	if err != nil {
+		return err
+	}
+	if managed-replaced >= int(srv.maxTunnels) {
+		return status.Errorf(codes.ResourceExhausted, "there are %v tunnels, the limit is %v", managed, srv.maxTunnels)
+	}
+	return nil
+}
+
+//GetCapacity impl tunnel service; it is a cheap read which does not wait for concurrency slot
+func (srv *tunnelService) GetCapacity(_ context.Context, _ *emptypb.Empty) (resp *tunnel.CapacityResponse, err error) {
+	defer func() {
+		err = srv.correctError(err)
+	}()
+	var managed, total int
+	if managed, total, err = srv.countTunnels(); err != nil {
+		return nil, err
+	}
+	return &tunnel.CapacityResponse{
+		MaxTunnels:           srv.maxTunnels,
+		CurrentManaged:       uint32(managed),
+		CurrentTotalTunLinks: uint32(total),
+	}, nil
+}
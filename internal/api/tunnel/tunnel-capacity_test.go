@@ -0,0 +1,34 @@
+package tunnel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vishvananda/netlink"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_GetCapacity(t *testing.T) {
+	ctx := context.Background()
+	srv := NewTunnelService(ctx, WithMaxTunnels(2)).(*tunnelService)
+	srv.linkList = func() ([]netlink.Link, error) {
+		return []netlink.Link{
+			&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun1"}},
+			&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tunl0"}},
+			&netlink.Dummy{LinkAttrs: netlink.LinkAttrs{Name: "eth0"}},
+		}, nil
+	}
+	resp, err := srv.GetCapacity(ctx, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, uint32(2), resp.GetMaxTunnels())
+	assert.Equal(t, uint32(1), resp.GetCurrentManaged())
+	assert.Equal(t, uint32(2), resp.GetCurrentTotalTunLinks())
+	assert.NoError(t, srv.checkCapacity(0))
+
+	srv.maxTunnels = 1
+	assert.Equal(t, codes.ResourceExhausted, status.Code(srv.checkCapacity(0)))
+}
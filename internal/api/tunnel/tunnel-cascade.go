@@ -0,0 +1,49 @@
+package tunnel
+
+import (
+	"fmt"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/pkg/errors"
+	"github.com/vishvananda/netlink"
+)
+
+const (
+	//rtTableUnspec lets route list filter match routes of all tables
+	rtTableUnspec = 0
+)
+
+//cascadeDelete removes routes and rules referencing the tunnel link
+func cascadeDelete(nl netlinkOps, link netlink.Link, resp *tunnel.RemoveTunnelResponse) error {
+	name := link.Attrs().Name
+	routes, err := nl.RouteListFiltered(netlink.FAMILY_V4,
+		&netlink.Route{LinkIndex: link.Attrs().Index, Table: rtTableUnspec},
+		netlink.RT_FILTER_OIF|netlink.RT_FILTER_TABLE,
+	)
+	if err != nil {
+		return errors.Wrapf(err, "netlink.RouteListFiltered(%s)", name)
+	}
+	for i := range routes {
+		r := &routes[i]
+		if err = nl.RouteDel(r); err != nil {
+			return errors.Wrapf(err, "netlink.RouteDel(%s)", r)
+		}
+		resp.RemovedRoutes = append(resp.RemovedRoutes, r.String())
+	}
+	var rules []netlink.Rule
+	if rules, err = nl.RuleList(netlink.FAMILY_V4); err != nil {
+		return errors.Wrap(err, "netlink.RuleList")
+	}
+	for i := range rules {
+		r := &rules[i]
+		if r.IifName != name && r.OifName != name {
+			continue
+		}
+		descr := fmt.Sprintf("priority %v iif '%s' oif '%s' table %v", r.Priority, r.IifName, r.OifName, r.Table)
+		if err = nl.RuleDel(r); err != nil {
+			return errors.Wrapf(err, "netlink.RuleDel(%s)", descr)
+		}
+		resp.RemovedRules = append(resp.RemovedRules, descr)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package tunnel
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/stretchr/testify/assert"
+	"github.com/vishvananda/netlink"
+)
+
+func Test_RemoveTunnelCascadeDelete(t *testing.T) {
+	ctx := context.Background()
+	srv := NewTunnelService(ctx).(*tunnelService)
+	tun1, tun2 := mustTunnelName(net.ParseIP("10.0.0.1")), mustTunnelName(net.ParseIP("10.0.0.2"))
+	fake := useFakeNetlink(srv,
+		&netlink.Dummy{LinkAttrs: netlink.LinkAttrs{Name: "eth0", Flags: net.FlagUp}},
+		&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: tun1, Flags: net.FlagUp}, Remote: net.ParseIP("10.0.0.1")},
+		&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: tun2, Flags: net.FlagUp}, Remote: net.ParseIP("10.0.0.2")},
+	)
+	route := func(dst string, linkIndex, table int) *netlink.Route {
+		_, n, _ := net.ParseCIDR(dst)
+		return &netlink.Route{Dst: n, LinkIndex: linkIndex, Table: table}
+	}
+	rule := func(iif, oif string, table int) *netlink.Rule {
+		r := netlink.NewRule()
+		r.IifName, r.OifName, r.Table = iif, oif, table
+		return r
+	}
+	for _, r := range []*netlink.Route{
+		route("192.168.1.0/24", 2, 254),
+		route("192.168.2.0/24", 2, 100),
+		route("192.168.3.0/24", 1, 254),
+		route("192.168.4.0/24", 3, 254),
+	} {
+		assert.NoError(t, fake.RouteAdd(r))
+	}
+	for _, r := range []*netlink.Rule{
+		rule(tun1, "", 100),
+		rule("", tun1, 101),
+		rule("eth0", "", 102),
+		rule(tun2, "", 103),
+	} {
+		assert.NoError(t, fake.RuleAdd(r))
+	}
+
+	resp, err := srv.RemoveTunnel(ctx, &tunnel.RemoveTunnelRequest{TunDestIP: "10.0.0.1", CascadeDelete: true})
+	if assert.NoError(t, err) {
+		assert.Len(t, resp.GetRemovedRoutes(), 2)
+		for _, r := range resp.GetRemovedRoutes() {
+			assert.True(t, strings.Contains(r, "192.168.1.0/24") || strings.Contains(r, "192.168.2.0/24"), r)
+		}
+		assert.Equal(t, []string{
+			fmt.Sprintf("priority -1 iif '%s' oif '' table 100", tun1),
+			fmt.Sprintf("priority -1 iif '' oif '%s' table 101", tun1),
+		}, resp.GetRemovedRules())
+	}
+	resp, err = srv.RemoveTunnel(ctx, &tunnel.RemoveTunnelRequest{TunDestIP: "10.0.0.2"})
+	if assert.NoError(t, err) {
+		assert.Empty(t, resp.GetRemovedRoutes())
+		assert.Empty(t, resp.GetRemovedRules())
+	}
+	routes, err := fake.RouteListFiltered(netlink.FAMILY_V4, &netlink.Route{}, 0)
+	if assert.NoError(t, err) && assert.Len(t, routes, 1) {
+		assert.Equal(t, "192.168.3.0/24", routes[0].Dst.String())
+	}
+	rules, err := fake.RuleList(netlink.FAMILY_V4)
+	if assert.NoError(t, err) {
+		var tables []int
+		for _, r := range rules {
+			tables = append(tables, r.Table)
+		}
+		assert.Equal(t, []int{102, 103}, tables, "rules are removed only by cascade")
+	}
+	assert.Equal(t, []string{"eth0"}, fake.names())
+}
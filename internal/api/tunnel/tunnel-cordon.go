@@ -0,0 +1,117 @@
+package tunnel
+
+import (
+	"context"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/vishvananda/netlink"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+//isCordoned checks if tunnel link is cordoned: bulk and reconciling RPCs leave it as is
+//  - DiffState plans to skip it and does not count it as to create, remove or in sync
+//  - PurgeTunnels, RemoveByRemoteCIDR, Apply, RepairSysctls, ApplySysctls and maintenance do not touch it
+//  - RPCs naming the tunnel explicitly still work on it
+func isCordoned(link netlink.Link) bool {
+	_, ok := labelsOf(link)[labelCordoned]
+	return ok
+}
+
+//CordonTunnel impl tunnel service
+func (srv *tunnelService) CordonTunnel(ctx context.Context, req *tunnel.CordonTunnelRequest) (*tunnel.TunnelInfo, error) {
+	if err := srv.denyMutation(); err != nil {
+		return nil, err
+	}
+	resp, err := srv.idempotency.do(ctx, "CordonTunnel", req.GetIdempotencyKey(), req, func() (proto.Message, error) {
+		return srv.setCordoned(ctx, req.GetTunDestIP(), req.GetName(), true)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*tunnel.TunnelInfo), nil
+}
+
+//UncordonTunnel impl tunnel service
+func (srv *tunnelService) UncordonTunnel(ctx context.Context, req *tunnel.UncordonTunnelRequest) (*tunnel.TunnelInfo, error) {
+	if err := srv.denyMutation(); err != nil {
+		return nil, err
+	}
+	resp, err := srv.idempotency.do(ctx, "UncordonTunnel", req.GetIdempotencyKey(), req, func() (proto.Message, error) {
+		return srv.setCordoned(ctx, req.GetTunDestIP(), req.GetName(), false)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*tunnel.TunnelInfo), nil
+}
+
+//setCordoned sets or drops cordon label of the tunnel; tunnel which is already so is left as is
+func (srv *tunnelService) setCordoned(ctx context.Context, tunDestIP, name string, cordoned bool) (resp *tunnel.TunnelInfo, err error) {
+	span := srv.spanOf(ctx)
+	span.SetAttributes(attribute.String("tunDestIP", tunDestIP), attribute.Bool("cordoned", cordoned))
+
+	ctx, cancel := withDefaultDeadline(ctx, srv.writeTimeout)
+	defer cancel()
+
+	var leave func()
+	if leave, err = srv.enter(ctx); err != nil {
+		return nil, err
+	}
+	defer func() {
+		leave()
+		err = srv.correctError(err)
+	}()
+
+	var tunnelName string
+	if tunnelName, err = tunnelNameOf(tunDestIP, name); err != nil {
+		return nil, err
+	}
+	span.SetAttributes(attribute.String("tunnel-name", tunnelName))
+
+	var unlock func()
+	if unlock, err = srv.lockForMutation(ctx, tunnelName); err != nil {
+		return
+	}
+	defer unlock()
+
+	var link netlink.Link
+	if link, err = lookupTunnel(srv.nl, tunnelName); err != nil {
+		return
+	}
+	if _, ok := link.(*netlink.Iptun); !ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "link '%s' is '%s' but not IPIP tunnel", tunnelName, link.Type())
+	}
+	var action string
+	if isCordoned(link) != cordoned {
+		labels := labelsOf(link)
+		if cordoned {
+			labels[labelCordoned], action = "", webhookActionCordon
+		} else {
+			delete(labels, labelCordoned)
+			action = webhookActionUncordon
+		}
+		if n := len(labels.alias()); n > maxLinkAliasLen {
+			return nil, status.Errorf(codes.FailedPrecondition, "tunnel labels take %v bytes but interface alias is limited by %v", n, maxLinkAliasLen)
+		}
+		srv.addSpanDbgEvent(ctx, span, "setLinkLabels")
+		if err = setLinkLabels(srv.nl, link, labels); err != nil {
+			return
+		}
+		if link, err = lookupTunnel(srv.nl, tunnelName); err != nil {
+			return
+		}
+	}
+	if resp, err = tunnelInfoFromLink(link); err != nil {
+		return nil, err
+	}
+	if resp.UnderlayDev, err = underlayDevName(srv.nl, link); err != nil {
+		return nil, err
+	}
+	if len(action) > 0 {
+		srv.notifyChange(ctx, action, tunnelName, resp.GetRemote())
+	}
+	return resp, nil
+}
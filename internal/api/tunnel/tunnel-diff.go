@@ -0,0 +1,110 @@
+package tunnel
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/vishvananda/netlink"
+	"google.golang.org/grpc/status"
+)
+
+//tunnelsDiff splits desired and actual tunnel names into 'toCreate', 'toRemove' and 'inSync' sorted sets
+func tunnelsDiff(desired, actual []string) (toCreate, toRemove, inSync []string) {
+	want := make(map[string]bool, len(desired))
+	for _, n := range desired {
+		want[n] = true
+	}
+	have := make(map[string]bool, len(actual))
+	for _, n := range actual {
+		have[n] = true
+	}
+	for n := range want {
+		if have[n] {
+			inSync = append(inSync, n)
+		} else {
+			toCreate = append(toCreate, n)
+		}
+	}
+	for n := range have {
+		if !want[n] {
+			toRemove = append(toRemove, n)
+		}
+	}
+	sort.Strings(toCreate)
+	sort.Strings(toRemove)
+	sort.Strings(inSync)
+	return //nolint:nakedret
+}
+
+//buildDiffPlan makes deterministic plan of reconciling managed tunnel links with desired tunnel IPs;
+//cordoned tunnels are skipped and are not in 'desired'
+func buildDiffPlan(desiredIPs []string, links []netlink.Link) (plan []*tunnel.PlanItem, desired []string) {
+	actual := make(map[string]netlink.Link, len(links))
+	for _, l := range links {
+		actual[l.Attrs().Name] = l
+	}
+	seen := make(map[string]*tunnel.PlanItem)
+	for _, tunnelIP := range desiredIPs {
+		item := &tunnel.PlanItem{TunDestIP: tunnelIP}
+		ip, err := parseTunDestIP(tunnelIP)
+		if err != nil {
+			item.Action = tunnel.PlanAction_PLAN_ACTION_SKIP
+			item.Warnings = append(item.Warnings, status.Convert(err).Message())
+			plan = append(plan, item)
+			continue
+		}
+		if item.Name, err = TunnelNameForIP(ip); err != nil {
+			item.Action = tunnel.PlanAction_PLAN_ACTION_SKIP
+			item.Warnings = append(item.Warnings, status.Convert(err).Message())
+			plan = append(plan, item)
+			continue
+		}
+		if prev := seen[item.Name]; prev != nil {
+			prev.Warnings = append(prev.Warnings, fmt.Sprintf("'%s' is duplicated", tunnelIP))
+			continue
+		}
+		seen[item.Name] = item
+		desired = append(desired, item.Name)
+		item.Action = tunnel.PlanAction_PLAN_ACTION_CREATE
+		if l := actual[item.Name]; l != nil && isCordoned(l) {
+			desired = desired[:len(desired)-1]
+			item.Action = tunnel.PlanAction_PLAN_ACTION_SKIP
+			item.Warnings = append(item.Warnings, fmt.Sprintf("tunnel '%s' is cordoned", item.Name))
+		} else if l != nil {
+			item.Action = tunnel.PlanAction_PLAN_ACTION_KEEP
+			if isQuarantined(l) {
+				item.Warnings = append(item.Warnings, fmt.Sprintf("tunnel '%s' is quarantined", item.Name))
+			}
+		}
+		plan = append(plan, item)
+	}
+	for name, l := range actual {
+		if seen[name] != nil {
+			continue
+		}
+		item := &tunnel.PlanItem{
+			Name:   name,
+			Action: tunnel.PlanAction_PLAN_ACTION_REMOVE,
+		}
+		if isCordoned(l) {
+			item.Action = tunnel.PlanAction_PLAN_ACTION_SKIP
+			item.Warnings = append(item.Warnings, fmt.Sprintf("tunnel '%s' is cordoned", name))
+		}
+		if t, ok := l.(*netlink.Iptun); ok && t.Remote != nil {
+			item.TunDestIP = t.Remote.String()
+		}
+		plan = append(plan, item)
+	}
+	sort.Slice(plan, func(i, j int) bool {
+		l, r := plan[i], plan[j]
+		if (len(l.Name) == 0) != (len(r.Name) == 0) {
+			return len(l.Name) > 0
+		}
+		if l.Name != r.Name {
+			return l.Name < r.Name
+		}
+		return l.TunDestIP < r.TunDestIP
+	})
+	return plan, desired
+}
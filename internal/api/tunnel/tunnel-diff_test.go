@@ -0,0 +1,71 @@
+package tunnel
+
+import (
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/stretchr/testify/assert"
+	"github.com/vishvananda/netlink"
+)
+
+func Test_TunnelsDiff(t *testing.T) {
+	toCreate, toRemove, inSync := tunnelsDiff(
+		[]string{"tun3", "tun1", "tun2", "tun1"},
+		[]string{"tun2", "tun4", "tun1"},
+	)
+	assert.Equal(t, []string{"tun3"}, toCreate)
+	assert.Equal(t, []string{"tun4"}, toRemove)
+	assert.Equal(t, []string{"tun1", "tun2"}, inSync)
+
+	toCreate, toRemove, inSync = tunnelsDiff(nil, nil)
+	assert.Empty(t, toCreate)
+	assert.Empty(t, toRemove)
+	assert.Empty(t, inSync)
+}
+
+func Test_BuildDiffPlan(t *testing.T) {
+	links := []netlink.Link{
+		&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun16843009"}, Remote: net.ParseIP("1.1.1.1")},
+		&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun33686018"}, Remote: net.ParseIP("2.2.2.2")},
+	}
+	plan, desired := buildDiffPlan([]string{"3.3.3.3", "1.1.1.1", "bad", "3.3.3.3"}, links)
+	assert.Equal(t, []string{"tun50529027", "tun16843009"}, desired)
+	type item struct {
+		ip, name string
+		action   tunnel.PlanAction
+		warnings int
+	}
+	var got []item
+	for _, p := range plan {
+		got = append(got, item{p.GetTunDestIP(), p.GetName(), p.GetAction(), len(p.GetWarnings())})
+	}
+	assert.Equal(t, []item{
+		{"1.1.1.1", "tun16843009", tunnel.PlanAction_PLAN_ACTION_KEEP, 0},
+		{"2.2.2.2", "tun33686018", tunnel.PlanAction_PLAN_ACTION_REMOVE, 0},
+		{"3.3.3.3", "tun50529027", tunnel.PlanAction_PLAN_ACTION_CREATE, 1},
+		{"bad", "", tunnel.PlanAction_PLAN_ACTION_SKIP, 1},
+	}, got)
+}
+
+func Test_BuildDiffPlanCordoned(t *testing.T) {
+	cordoned := aliasLabelsPrefix + labelCordoned
+	links := []netlink.Link{
+		&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun16843009", Alias: cordoned}, Remote: net.ParseIP("1.1.1.1")},
+		&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun33686018", Alias: cordoned}, Remote: net.ParseIP("2.2.2.2")},
+	}
+	assert.True(t, isCordoned(links[0]))
+	plan, desired := buildDiffPlan([]string{"1.1.1.1"}, links)
+	assert.Empty(t, desired)
+	if assert.Len(t, plan, 2) {
+		for _, p := range plan {
+			assert.Equal(t, tunnel.PlanAction_PLAN_ACTION_SKIP, p.GetAction(), p.GetName())
+			assert.Equal(t, []string{fmt.Sprintf("tunnel '%s' is cordoned", p.GetName())}, p.GetWarnings())
+		}
+	}
+	info, err := tunnelInfoFromLink(links[1])
+	if assert.NoError(t, err) {
+		assert.True(t, info.GetCordoned())
+	}
+}
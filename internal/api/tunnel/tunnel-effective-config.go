@@ -0,0 +1,144 @@
+package tunnel
+
+import (
+	"context"
+
+	"github.com/gradusp/go-platform/logger"
+)
+
+//sources of effective config values
+const (
+	configSourceDefault = "default"
+	configSourceSet     = "set"
+)
+
+//names of tunnel service options in effective config
+const (
+	cfgReadTimeout           = "read-timeout"
+	cfgWriteTimeout          = "write-timeout"
+	cfgMaxConcurrency        = "max-concurrency"
+	cfgProcPath              = "proc-path"
+	cfgPingPath              = "ping-path"
+	cfgHealthCacheTTL        = "health-cache-ttl"
+	cfgHealthCacheJitter     = "health-cache-jitter"
+	cfgMinMtu                = "min-mtu"
+	cfgExecMetrics           = "exec-metrics"
+	cfgSpans                 = "spans"
+	cfgFirewallBackend       = "firewall-backend"
+	cfgExecTracePropagation  = "exec-trace-propagation"
+	cfgTunnelNamePrefix      = "tunnel-name-prefix"
+	cfgIdempotencyTTL        = "idempotency-ttl"
+	cfgIdempotencyCacheSize  = "idempotency-cache-size"
+	cfgMaxTunnels            = "max-tunnels"
+	cfgBestEffortSysctl      = "best-effort-sysctl"
+	cfgReadOnly              = "read-only"
+	cfgOperUpWait            = "oper-up-wait"
+	cfgTemplates             = "templates"
+	cfgWebhookURL            = "webhook-url"
+	cfgWebhookTimeout        = "webhook-timeout"
+	cfgWebhookRetries        = "webhook-retries"
+	cfgWebhookMetrics        = "webhook-metrics"
+	cfgUnmanagedAck          = "unmanaged-acknowledged"
+	cfgModuleLoadAttempts    = "module-load-attempts"
+	cfgModuleLoadBackoff     = "module-load-backoff"
+	cfgConcurrencyMetrics    = "concurrency-metrics"
+	cfgExecEnv               = "exec-env"
+	cfgTombstoneTTL          = "tombstone-ttl"
+	cfgTombstoneSize         = "tombstone-size"
+	cfgHistoryPerTunnel      = "history-per-tunnel"
+	cfgHistoryTTL            = "history-ttl"
+	cfgTunnelIndexAnnotation = "tunnel-index-annotation"
+	cfgHealthDownGrace       = "health-down-grace"
+	cfgHealthUpStable        = "health-up-stable"
+	cfgMaintenanceFile       = "maintenance-state-file"
+	cfgTunnelMetrics         = "tunnel-metrics"
+	cfgResumableAdd          = "resumable-add"
+	cfgTunnelSysctls         = "tunnel-sysctls"
+)
+
+//configEntry resolved value of tunnel service option and its source
+type configEntry struct {
+	name   string
+	value  interface{}
+	source string
+}
+
+//markSet remembers options explicitly set by 'TunnelServiceOption'
+func (srv *tunnelService) markSet(names ...string) {
+	if srv.optionsSet == nil {
+		srv.optionsSet = make(map[string]bool)
+	}
+	for _, n := range names {
+		srv.optionsSet[n] = true
+	}
+}
+
+//effectiveConfig enumerates resolved options of the service in stable order
+func (srv *tunnelService) effectiveConfig() []configEntry {
+	fwBackend := srv.fwBackendName
+	if fwBackend == FirewallBackendAuto {
+		fwBackend = "auto"
+	}
+	ret := []configEntry{
+		{name: cfgReadTimeout, value: srv.readTimeout.String()},
+		{name: cfgWriteTimeout, value: srv.writeTimeout.String()},
+		{name: cfgMaxConcurrency, value: srv.maxConcurrency},
+		{name: cfgProcPath, value: srv.procPath},
+		{name: cfgPingPath, value: srv.pingPath},
+		{name: cfgHealthCacheTTL, value: srv.healthTTL.String()},
+		{name: cfgHealthCacheJitter, value: srv.healthJitter.String()},
+		{name: cfgMinMtu, value: srv.minMtu},
+		{name: cfgExecMetrics, value: srv.execMetrics != nil},
+		{name: cfgSpans, value: !srv.spansOff},
+		{name: cfgFirewallBackend, value: fwBackend},
+		{name: cfgExecTracePropagation, value: srv.execTracePropagation},
+		{name: cfgTunnelNamePrefix, value: tunnelNamePrefix},
+		{name: cfgIdempotencyTTL, value: srv.idemTTL.String()},
+		{name: cfgIdempotencyCacheSize, value: srv.idemSize},
+		{name: cfgMaxTunnels, value: srv.maxTunnels},
+		{name: cfgBestEffortSysctl, value: srv.bestEffortSysctl},
+		{name: cfgReadOnly, value: srv.readOnly},
+		{name: cfgOperUpWait, value: srv.operUpWait.String()},
+		{name: cfgTemplates, value: srv.templateNames()},
+		{name: cfgWebhookURL, value: srv.webhookURL},
+		{name: cfgWebhookTimeout, value: srv.webhookTimeout.String()},
+		{name: cfgWebhookRetries, value: srv.webhookRetries},
+		{name: cfgWebhookMetrics, value: srv.webhookMetrics != nil},
+		{name: cfgUnmanagedAck, value: srv.safe.acked},
+		{name: cfgModuleLoadAttempts, value: srv.moduleLoadAttempts},
+		{name: cfgModuleLoadBackoff, value: srv.moduleLoadBackoff.String()},
+		{name: cfgConcurrencyMetrics, value: srv.optionsSet[cfgConcurrencyMetrics]},
+		{name: cfgExecEnv, value: srv.execEnv},
+		{name: cfgTombstoneTTL, value: srv.tombstoneTTL},
+		{name: cfgTombstoneSize, value: srv.tombstoneSize},
+		{name: cfgHistoryPerTunnel, value: srv.historyPerTunnel},
+		{name: cfgHistoryTTL, value: srv.historyTTL},
+		{name: cfgTunnelIndexAnnotation, value: srv.tunnelIndexAnnotation},
+		{name: cfgHealthDownGrace, value: srv.healthDownGrace},
+		{name: cfgHealthUpStable, value: srv.healthUpStable},
+		{name: cfgMaintenanceFile, value: srv.maintenanceFile},
+		{name: cfgTunnelMetrics, value: srv.optionsSet[cfgTunnelMetrics]},
+		{name: cfgResumableAdd, value: srv.resumableAdd},
+		{name: cfgTunnelSysctls, value: srv.sysctls.get()},
+	}
+	for i := range ret {
+		ret[i].source = configSourceDefault
+		if srv.optionsSet[ret[i].name] {
+			ret[i].source = configSourceSet
+		}
+	}
+	return ret
+}
+
+//logEffectiveConfig logs effective config as single structured entry
+func (srv *tunnelService) logEffectiveConfig(ctx context.Context) {
+	entries := srv.effectiveConfig()
+	kv := make([]interface{}, 0, 2*len(entries))
+	for _, e := range entries {
+		kv = append(kv, e.name, map[string]interface{}{
+			"value":  e.value,
+			"source": e.source,
+		})
+	}
+	logger.FromContext(ctx).Infow("tunnel service effective config", kv...)
+}
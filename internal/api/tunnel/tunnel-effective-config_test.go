@@ -0,0 +1,26 @@
+package tunnel
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_EffectiveConfig(t *testing.T) {
+	srv := NewTunnelService(context.Background(),
+		WithMaxConcurrency(4),
+		WithReadTimeout(time.Second),
+		WithFirewallBackend(FirewallBackendNft),
+	).(*tunnelService)
+	entries := make(map[string]configEntry)
+	for _, e := range srv.effectiveConfig() {
+		entries[e.name] = e
+	}
+	assert.Equal(t, configEntry{cfgMaxConcurrency, 4, configSourceSet}, entries[cfgMaxConcurrency])
+	assert.Equal(t, configEntry{cfgReadTimeout, "1s", configSourceSet}, entries[cfgReadTimeout])
+	assert.Equal(t, configEntry{cfgFirewallBackend, FirewallBackendNft, configSourceSet}, entries[cfgFirewallBackend])
+	assert.Equal(t, configEntry{cfgWriteTimeout, DefaultWriteTimeout.String(), configSourceDefault}, entries[cfgWriteTimeout])
+	assert.Equal(t, configEntry{cfgSpans, true, configSourceDefault}, entries[cfgSpans])
+}
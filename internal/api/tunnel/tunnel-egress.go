@@ -0,0 +1,75 @@
+package tunnel
+
+import (
+	"net"
+	"sort"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/vishvananda/netlink"
+)
+
+//egressResolver finds underlay interface tunnel traffic leaves by; lookups are cached for one enumeration
+//  - tunnel bound to underlay device egresses by that device
+//  - otherwise egress is the device of route to tunnel remote
+type egressResolver struct {
+	routeGet    func(net.IP) ([]netlink.Route, error)
+	linkByIndex func(int) (netlink.Link, error)
+	devNames    map[int]string
+}
+
+func (srv *tunnelService) newEgressResolver() *egressResolver {
+	return &egressResolver{
+		routeGet:    srv.routeGet,
+		linkByIndex: srv.linkByIndex,
+		devNames:    make(map[int]string),
+	}
+}
+
+//egressOf gets egress device name of tunnel to 'remote' bound to device 'boundIndex' (zero if not bound);
+//empty if it can't be resolved
+func (r *egressResolver) egressOf(boundIndex int, remote net.IP) string {
+	index := boundIndex
+	if index == 0 {
+		if remote == nil {
+			return ""
+		}
+		routes, err := r.routeGet(remote)
+		if err != nil || len(routes) == 0 {
+			return ""
+		}
+		index = routes[0].LinkIndex
+	}
+	if name, ok := r.devNames[index]; ok {
+		return name
+	}
+	var name string
+	if dev, err := r.linkByIndex(index); err == nil {
+		name = dev.Attrs().Name
+	}
+	r.devNames[index] = name
+	return name
+}
+
+//groupByEgress groups tunnels by their egress devices; groups are ordered by device name,
+//tunnels keep order of 'details'; tunnels with egress not resolved go to group with empty device name
+func (srv *tunnelService) groupByEgress(details []*tunnel.TunnelInfo, bound map[string]int) []*tunnel.EgressGroup {
+	r := srv.newEgressResolver()
+	groups := make(map[string]*tunnel.EgressGroup)
+	for _, info := range details {
+		dev := r.egressOf(bound[info.GetName()], net.ParseIP(info.GetRemote()))
+		g := groups[dev]
+		if g == nil {
+			g = &tunnel.EgressGroup{EgressDev: dev}
+			groups[dev] = g
+		}
+		g.Tunnels = append(g.Tunnels, info.GetName())
+	}
+	ret := make([]*tunnel.EgressGroup, 0, len(groups))
+	for _, g := range groups {
+		ret = append(ret, g)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].GetEgressDev() < ret[j].GetEgressDev()
+	})
+	return ret
+}
@@ -0,0 +1,84 @@
+package tunnel
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//Encryption hints are metadata for external IPsec/XDP programs; the service keeps them in
+//tunnel labels and reports them in TunnelInfo but does not configure any kernel crypto
+const (
+	//minSpi SPIs 1..255 are reserved by IANA
+	minSpi = 256
+)
+
+var (
+	reEncryptionProfile = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]{0,31}$`)
+)
+
+//spiRange range of IPsec SPIs
+type spiRange struct {
+	min, max uint32
+}
+
+//String canonical form 'min-max' of the range; it is stored in link labels
+func (r spiRange) String() string {
+	return fmt.Sprintf("0x%x-0x%x", r.min, r.max)
+}
+
+//parseSpiRange parses range in form 'min-max' where numbers are decimal or '0x' hex
+func parseSpiRange(s string) (spiRange, error) {
+	var ret spiRange
+	i := strings.IndexByte(s, '-')
+	if i < 0 {
+		return ret, status.Errorf(codes.InvalidArgument, "'encryption.spiRange': '%s' is not 'min-max'", s)
+	}
+	lo, err1 := strconv.ParseUint(s[:i], 0, 32)
+	hi, err2 := strconv.ParseUint(s[i+1:], 0, 32)
+	if err1 != nil || err2 != nil {
+		return ret, status.Errorf(codes.InvalidArgument, "'encryption.spiRange': '%s' has bad numbers", s)
+	}
+	ret.min, ret.max = uint32(lo), uint32(hi)
+	if ret.min < minSpi || ret.min > ret.max {
+		return ret, status.Errorf(codes.InvalidArgument, "'encryption.spiRange': '%s' must be within [%v, 0xffffffff] and min not above max", s, minSpi)
+	}
+	return ret, nil
+}
+
+//validateEncryptionHints checks 'encryption' request argument; both fields are optional
+func validateEncryptionHints(h *tunnel.EncryptionHints) error {
+	if p := h.GetProfile(); len(p) > 0 && !reEncryptionProfile.MatchString(p) {
+		return status.Errorf(codes.InvalidArgument, "'encryption.profile': '%s' does not match '%s'", p, reEncryptionProfile)
+	}
+	if r := h.GetSpiRange(); len(r) > 0 {
+		if _, err := parseSpiRange(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//setEncryptionHints stores encryption hints in tunnel labels
+func (ll linkLabels) setEncryptionHints(h *tunnel.EncryptionHints) {
+	if p := h.GetProfile(); len(p) > 0 {
+		ll[labelEncProfile] = p
+	}
+	if r, err := parseSpiRange(h.GetSpiRange()); err == nil {
+		ll[labelEncSpiRange] = r.String()
+	}
+}
+
+//encryptionHints gets encryption hints from tunnel labels; nil if there are none
+func (ll linkLabels) encryptionHints() *tunnel.EncryptionHints {
+	p, r := ll[labelEncProfile], ll[labelEncSpiRange]
+	if len(p) == 0 && len(r) == 0 {
+		return nil
+	}
+	return &tunnel.EncryptionHints{Profile: p, SpiRange: r}
+}
@@ -0,0 +1,41 @@
+package tunnel
+
+import (
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/stretchr/testify/assert"
+	"github.com/vishvananda/netlink"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_EncryptionHints(t *testing.T) {
+	assert.NoError(t, validateEncryptionHints(nil))
+	assert.NoError(t, validateEncryptionHints(&tunnel.EncryptionHints{Profile: "aes-gcm.v2"}))
+	assert.NoError(t, validateEncryptionHints(&tunnel.EncryptionHints{SpiRange: "256-0x1ff"}))
+	for _, bad := range []*tunnel.EncryptionHints{
+		{Profile: "AES"},
+		{Profile: "a,b"},
+		{Profile: strings.Repeat("a", 33)},
+		{SpiRange: "256"},
+		{SpiRange: "1-100"},
+		{SpiRange: "0x200-0x100"},
+		{SpiRange: "x-y"},
+	} {
+		assert.Equal(t, codes.InvalidArgument, status.Code(validateEncryptionHints(bad)), bad.String())
+	}
+
+	ll := make(linkLabels)
+	ll.setEncryptionHints(nil)
+	assert.Nil(t, ll.encryptionHints())
+	ll.setEncryptionHints(&tunnel.EncryptionHints{Profile: "aes-gcm", SpiRange: "256-511"})
+	link := &netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun16843009", Alias: ll.alias()}, Remote: net.ParseIP("1.1.1.1")}
+	info, err := tunnelInfoFromLink(link)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "aes-gcm", info.GetEncryption().GetProfile())
+		assert.Equal(t, "0x100-0x1ff", info.GetEncryption().GetSpiRange())
+	}
+}
@@ -0,0 +1,127 @@
+package tunnel
+
+import (
+	"syscall"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/pkg/errors"
+	"github.com/vishvananda/netlink"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	//linkUpdatesBacklog link updates queued while the stream is busy sending
+	linkUpdatesBacklog = 64
+)
+
+//WatchTunnelEvents impl tunnel service
+func (srv *tunnelService) WatchTunnelEvents(_ *tunnel.WatchTunnelEventsRequest, stream tunnel.TunnelService_WatchTunnelEventsServer) (err error) {
+	ctx := stream.Context()
+	defer func() {
+		err = srv.correctError(err)
+	}()
+
+	done := make(chan struct{})
+	updates := make(chan netlink.LinkUpdate, linkUpdatesBacklog)
+	//subErr is set by subscription before it closes 'updates'
+	var subErr error
+	err = netlink.LinkSubscribeWithOptions(updates, done, netlink.LinkSubscribeOptions{
+		ErrorCallback: func(e error) {
+			subErr = e
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "netlink.LinkSubscribeWithOptions")
+	}
+	defer func() {
+		close(done)
+		//subscription may be blocked on sending update; it closes 'updates' once it sees socket closed
+		go func() {
+			for range updates {
+			}
+		}()
+	}()
+	known := make(map[string]bool)
+	err = srv.enumLinks(func(nl netlink.Link) error {
+		known[nl.Attrs().Name] = isOperUp(nl)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	debounce := srv.newEventDebounce(known)
+	defer debounce.stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-srv.appCtx.Done():
+			return srv.appCtx.Err()
+		case <-debounce.wake():
+			at := timestamppb.Now()
+			for _, ev := range debounce.due() {
+				ev.At = at
+				if err = stream.Send(ev); err != nil {
+					return err
+				}
+			}
+		case upd, ok := <-updates:
+			if !ok {
+				if subErr != nil {
+					return errors.Wrap(subErr, "netlink link subscription is broken")
+				}
+				return errors.New("netlink link subscription is closed")
+			}
+			at := timestamppb.Now()
+			for _, kind := range tunnelEventsOf(known, upd) {
+				if !debounce.pass(upd.Attrs().Name, kind) {
+					continue
+				}
+				ev := &tunnel.TunnelEvent{
+					Name: upd.Attrs().Name,
+					Kind: kind,
+					At:   at,
+				}
+				if err = stream.Send(ev); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+//tunnelEventsOf turns link update into managed tunnel events and tracks tunnels oper state in 'known';
+//tunnel goes up and down by oper state the way OperStateFilter sees it, so loss of carrier is reported too
+func tunnelEventsOf(known map[string]bool, upd netlink.LinkUpdate) []tunnel.TunnelEventKind {
+	if upd.Link == nil {
+		return nil
+	}
+	a := upd.Attrs()
+	if a == nil || !reDetectRule.MatchString(a.Name) {
+		return nil
+	}
+	wasUp, existed := known[a.Name]
+	if upd.Header.Type == syscall.RTM_DELLINK {
+		if !existed {
+			return nil
+		}
+		delete(known, a.Name)
+		return []tunnel.TunnelEventKind{tunnel.TunnelEventKind_TUNNEL_EVENT_REMOVED}
+	}
+	if upd.Header.Type != syscall.RTM_NEWLINK {
+		return nil
+	}
+	isUp := isOperUp(upd.Link)
+	known[a.Name] = isUp
+	var ret []tunnel.TunnelEventKind
+	if !existed {
+		ret = append(ret, tunnel.TunnelEventKind_TUNNEL_EVENT_ADDED)
+	}
+	switch {
+	case isUp && (!existed || !wasUp):
+		ret = append(ret, tunnel.TunnelEventKind_TUNNEL_EVENT_UP)
+	case !isUp && existed && wasUp:
+		ret = append(ret, tunnel.TunnelEventKind_TUNNEL_EVENT_DOWN)
+	}
+	return ret
+}
@@ -0,0 +1,84 @@
+package tunnel
+
+import (
+	"context"
+	"net"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/stretchr/testify/assert"
+	"github.com/vishvananda/netlink"
+)
+
+func Test_TunnelEventsOf(t *testing.T) {
+	update := func(name string, msgType uint16, up bool) netlink.LinkUpdate {
+		var upd netlink.LinkUpdate
+		upd.Link = &netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: name, OperState: netlink.OperDown}}
+		upd.Header.Type = msgType
+		if up {
+			upd.IfInfomsg.Flags = syscall.IFF_UP
+			upd.Attrs().Flags, upd.Attrs().OperState = net.FlagUp, netlink.OperUnknown
+		}
+		return upd
+	}
+	known := map[string]bool{"tun1": true}
+
+	assert.Empty(t, tunnelEventsOf(known, update("eth0", syscall.RTM_NEWLINK, true)))
+	assert.Empty(t, tunnelEventsOf(known, update("tun1", syscall.RTM_NEWLINK, true)))
+	assert.Equal(t,
+		[]tunnel.TunnelEventKind{tunnel.TunnelEventKind_TUNNEL_EVENT_DOWN},
+		tunnelEventsOf(known, update("tun1", syscall.RTM_NEWLINK, false)))
+	assert.Equal(t,
+		[]tunnel.TunnelEventKind{tunnel.TunnelEventKind_TUNNEL_EVENT_ADDED},
+		tunnelEventsOf(known, update("tun2", syscall.RTM_NEWLINK, false)))
+	assert.Equal(t,
+		[]tunnel.TunnelEventKind{tunnel.TunnelEventKind_TUNNEL_EVENT_UP},
+		tunnelEventsOf(known, update("tun2", syscall.RTM_NEWLINK, true)))
+	assert.Equal(t,
+		[]tunnel.TunnelEventKind{tunnel.TunnelEventKind_TUNNEL_EVENT_REMOVED},
+		tunnelEventsOf(known, update("tun2", syscall.RTM_DELLINK, false)))
+	assert.Empty(t, tunnelEventsOf(known, update("tun2", syscall.RTM_DELLINK, false)))
+	assert.Equal(t, map[string]bool{"tun1": false}, known)
+
+	//admin up link which loses its lower layer goes down
+	assert.Equal(t,
+		[]tunnel.TunnelEventKind{tunnel.TunnelEventKind_TUNNEL_EVENT_UP},
+		tunnelEventsOf(known, update("tun1", syscall.RTM_NEWLINK, true)))
+	lost := update("tun1", syscall.RTM_NEWLINK, true)
+	lost.Attrs().OperState = netlink.OperLowerLayerDown
+	assert.Equal(t,
+		[]tunnel.TunnelEventKind{tunnel.TunnelEventKind_TUNNEL_EVENT_DOWN},
+		tunnelEventsOf(known, lost))
+}
+
+func Test_EventDebounce(t *testing.T) {
+	ctx := context.Background()
+	srv := NewTunnelService(ctx).(*tunnelService)
+	assert.Nil(t, srv.newEventDebounce(nil))
+
+	srv = NewTunnelService(ctx, WithHealthDebounce(10*time.Second, 10*time.Second)).(*tunnelService)
+	e := srv.newEventDebounce(map[string]bool{"tun1": true})
+	defer e.stop()
+	now := time.Unix(1000, 0)
+	e.health.now = func() time.Time { return now }
+
+	assert.False(t, e.pass("tun1", tunnel.TunnelEventKind_TUNNEL_EVENT_DOWN))
+	assert.NotNil(t, e.wake())
+	assert.Empty(t, e.due())
+
+	now = now.Add(10 * time.Second)
+	evs := e.due()
+	if assert.Len(t, evs, 1) {
+		assert.Equal(t, "tun1", evs[0].GetName())
+		assert.Equal(t, tunnel.TunnelEventKind_TUNNEL_EVENT_DOWN, evs[0].GetKind())
+	}
+	assert.Nil(t, e.wake())
+
+	assert.False(t, e.pass("tun1", tunnel.TunnelEventKind_TUNNEL_EVENT_UP))
+	assert.True(t, e.pass("tun1", tunnel.TunnelEventKind_TUNNEL_EVENT_REMOVED))
+	assert.Nil(t, e.wake())
+	assert.True(t, e.pass("tun2", tunnel.TunnelEventKind_TUNNEL_EVENT_ADDED))
+	assert.True(t, e.pass("tun2", tunnel.TunnelEventKind_TUNNEL_EVENT_UP))
+}
@@ -0,0 +1,78 @@
+package tunnel
+
+import (
+	"context"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/pkg/errors"
+	"github.com/vishvananda/netlink"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//parseRemoteQuery parses 'remoteIP' argument of FindByRemote: IPv4 address or IPv4 CIDR to match a range of remotes
+func parseRemoteQuery(q string) (*net.IPNet, error) {
+	if !strings.Contains(q, "/") {
+		q += mask32
+	}
+	ip, ipNet, err := net.ParseCIDR(q)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "'remoteIP': %v",
+			errors.Wrap(err, "net.ParseCIDR"),
+		)
+	}
+	if ip.To4() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "'remoteIP': '%s' is not IPv4 address or network", q)
+	}
+	return ipNet, nil
+}
+
+//FindByRemote impl tunnel service
+func (srv *tunnelService) FindByRemote(ctx context.Context, req *tunnel.FindByRemoteRequest) (resp *tunnel.FindByRemoteResponse, err error) {
+	span := srv.spanOf(ctx)
+	span.SetAttributes(attribute.String("remoteIP", req.GetRemoteIP()))
+
+	ctx, cancel := withDefaultDeadline(ctx, srv.readTimeout)
+	defer cancel()
+
+	var leave func()
+	if leave, err = srv.enter(ctx); err != nil {
+		return nil, err
+	}
+	defer func() {
+		leave()
+		err = srv.correctError(err)
+	}()
+
+	var remotes *net.IPNet
+	if remotes, err = parseRemoteQuery(req.GetRemoteIP()); err != nil {
+		return nil, err
+	}
+	resp = new(tunnel.FindByRemoteResponse)
+	resp.Problems, err = srv.enumLinksTolerant(func(nl netlink.Link) error {
+		t, ok := nl.(*netlink.Iptun)
+		if !ok || t.Remote == nil || !remotes.Contains(t.Remote) {
+			return nil
+		}
+		info, e := tunnelInfoFromLink(nl)
+		if e != nil {
+			return e
+		}
+		if info.UnderlayDev, e = underlayDevName(srv.nl, nl); e != nil {
+			return e
+		}
+		resp.Tunnels = append(resp.Tunnels, info)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(resp.Tunnels, func(i, j int) bool {
+		return resp.Tunnels[i].GetName() < resp.Tunnels[j].GetName()
+	})
+	return resp, nil
+}
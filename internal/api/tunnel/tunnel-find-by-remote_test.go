@@ -0,0 +1,50 @@
+package tunnel
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/stretchr/testify/assert"
+	"github.com/vishvananda/netlink"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_FindByRemote(t *testing.T) {
+	ctx := context.Background()
+	srv := NewTunnelService(ctx).(*tunnelService)
+	srv.linkList = func() ([]netlink.Link, error) {
+		return []netlink.Link{
+			&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun3"}, Remote: net.ParseIP("10.0.0.3")},
+			&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun1"}, Remote: net.ParseIP("10.0.0.1")},
+			&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun2"}, Remote: net.ParseIP("10.0.1.2")},
+			&netlink.Dummy{LinkAttrs: netlink.LinkAttrs{Name: "tun4"}},
+		}, nil
+	}
+	names := func(resp *tunnel.FindByRemoteResponse) []string {
+		var ret []string
+		for _, ti := range resp.GetTunnels() {
+			ret = append(ret, ti.GetName())
+		}
+		return ret
+	}
+	resp, err := srv.FindByRemote(ctx, &tunnel.FindByRemoteRequest{RemoteIP: "10.0.0.1"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"tun1"}, names(resp))
+		assert.Equal(t, "10.0.0.1", resp.GetTunnels()[0].GetRemote())
+	}
+	resp, err = srv.FindByRemote(ctx, &tunnel.FindByRemoteRequest{RemoteIP: "10.0.0.0/24"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"tun1", "tun3"}, names(resp))
+	}
+	resp, err = srv.FindByRemote(ctx, &tunnel.FindByRemoteRequest{RemoteIP: "10.9.9.9"})
+	if assert.NoError(t, err) {
+		assert.Empty(t, resp.GetTunnels())
+	}
+	for _, q := range []string{"", "10.0.0", "::1", "fe80::/64"} {
+		_, err = srv.FindByRemote(ctx, &tunnel.FindByRemoteRequest{RemoteIP: q})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), q)
+	}
+}
@@ -0,0 +1,158 @@
+package tunnel
+
+import (
+	"bytes"
+	"context"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+//Firewall backends which install per tunnel rules (MSS clamping, egress mark)
+const (
+	//FirewallBackendAuto uses 'iptables' if it is found in PATH otherwise 'nft'
+	FirewallBackendAuto = ""
+
+	//FirewallBackendIptables installs rules by 'iptables -t mangle ...'
+	FirewallBackendIptables = "iptables"
+
+	//FirewallBackendNft installs rules by 'nft' into 'inet crispy_tunnel' table
+	FirewallBackendNft = "nft"
+)
+
+const (
+	nftTable = "crispy_tunnel"
+)
+
+var (
+	reNftRuleHandle = regexp.MustCompile(`# handle (\d+)`)
+)
+
+//firewallBackend resolves firewall backend
+func (srv *tunnelService) firewallBackend() (string, error) {
+	if srv.fwBackendName != FirewallBackendAuto {
+		return srv.fwBackendName, nil
+	}
+	for _, b := range []string{FirewallBackendIptables, FirewallBackendNft} {
+		if _, err := exec.LookPath(b); err == nil {
+			return b, nil
+		}
+	}
+	return "", errors.New("neither 'iptables' nor 'nft' is found")
+}
+
+//fwRuleComment comment of firewall rules of the tunnel
+func fwRuleComment(tunnelName string) string {
+	return aliasLabelsPrefix + tunnelName
+}
+
+//nftAddRule adds rule into base chain 'chain' of 'hook' creating table and chain if they are absent
+func (srv *tunnelService) nftAddRule(ctx context.Context, chain, hook string, rule ...string) error {
+	cmds := [][]string{
+		{"add", "table", "inet", nftTable},
+		{"add", "chain", "inet", nftTable, chain,
+			"{ type filter hook " + hook + " priority mangle; }"},
+		append([]string{"add", "rule", "inet", nftTable, chain}, rule...),
+	}
+	for _, args := range cmds {
+		if _, err := srv.execExternal(ctx, nil, nil, FirewallBackendNft, args...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//nftTunnelRuleHandles handles of rules of the tunnel in 'chain'; with 'mayBeAbsent' missing table or chain means no rules
+func (srv *tunnelService) nftTunnelRuleHandles(ctx context.Context, chain, tunnelName string, mayBeAbsent bool) ([]string, error) {
+	var accept exitCodes
+	if mayBeAbsent {
+		accept = exitCodes{0, 1}
+	}
+	var out bytes.Buffer
+	exitCode, err := srv.execExternal(ctx, &out, accept, FirewallBackendNft, "-a", "list", "chain", "inet", nftTable, chain)
+	if err != nil || exitCode != 0 {
+		return nil, err
+	}
+	var ret []string
+	comment := strconv.Quote(fwRuleComment(tunnelName))
+	for _, line := range strings.Split(out.String(), "\n") {
+		if m := reNftRuleHandle.FindStringSubmatch(line); m != nil && strings.Contains(line, "comment "+comment) {
+			ret = append(ret, m[1])
+		}
+	}
+	return ret, nil
+}
+
+//nftHasTunnelRule checks if 'chain' has rule of the tunnel
+func (srv *tunnelService) nftHasTunnelRule(ctx context.Context, chain, tunnelName string) (bool, error) {
+	handles, err := srv.nftTunnelRuleHandles(ctx, chain, tunnelName, true)
+	return len(handles) > 0, err
+}
+
+//nftDelTunnelRules removes rules of the tunnel from 'chain' by their handles
+func (srv *tunnelService) nftDelTunnelRules(ctx context.Context, chain, tunnelName string) error {
+	handles, err := srv.nftTunnelRuleHandles(ctx, chain, tunnelName, false)
+	if err != nil {
+		return err
+	}
+	for _, h := range handles {
+		_, err = srv.execExternal(ctx, nil, nil, FirewallBackendNft, "delete", "rule", "inet", nftTable, chain, "handle", h)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//iptablesHasRule checks rule by 'iptables -C'; exit code 1 means there is no such rule
+func (srv *tunnelService) iptablesHasRule(ctx context.Context, args []string) (bool, error) {
+	exitCode, err := srv.execExternal(ctx, nil, exitCodes{0, 1}, FirewallBackendIptables, args...)
+	return err == nil && exitCode == 0, err
+}
+
+//addTunnelFwRules installs firewall rules of the tunnel described by 'labels' (MSS clamping, egress mark)
+//with backends the labels name; it returns labels of rules which are installed even on error
+func (srv *tunnelService) addTunnelFwRules(ctx context.Context, tunnelName string, labels linkLabels) (linkLabels, error) {
+	installed := make(linkLabels)
+	if mss := labels[labelMss]; len(mss) > 0 {
+		backend := labels[labelMssBackend]
+		if err := srv.addMssClamp(ctx, backend, tunnelName, mss); err != nil {
+			return installed, err
+		}
+		installed[labelMss], installed[labelMssBackend] = mss, backend
+	}
+	if m := labels[labelFwMark]; len(m) > 0 {
+		mark, err := parseFwMark(m)
+		if err != nil {
+			return installed, err
+		}
+		backend := labels[labelFwBackend]
+		if err = srv.addFwMark(ctx, backend, tunnelName, mark); err != nil {
+			return installed, err
+		}
+		installed[labelFwMark], installed[labelFwBackend] = m, backend
+	}
+	return installed, nil
+}
+
+//delTunnelFwRules removes firewall rules of the tunnel described by 'labels';
+//it tries to remove every rule and returns the first error
+func (srv *tunnelService) delTunnelFwRules(ctx context.Context, tunnelName string, labels linkLabels) error {
+	var ret error
+	if mss := labels[labelMss]; len(mss) > 0 {
+		ret = srv.delMssClamp(ctx, labels[labelMssBackend], tunnelName, mss)
+	}
+	if m := labels[labelFwMark]; len(m) > 0 {
+		mark, err := parseFwMark(m)
+		if err == nil {
+			err = srv.delFwMark(ctx, labels[labelFwBackend], tunnelName, mark)
+		}
+		if ret == nil {
+			ret = err
+		}
+	}
+	return ret
+}
@@ -0,0 +1,107 @@
+package tunnel
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	nftChainPostrouting = "postrouting"
+)
+
+//fwMark netfilter mark set onto tunnel egress traffic
+type fwMark struct {
+	value, mask uint32
+}
+
+//String canonical form 'value/mask' of the mark; it is stored in link labels
+func (m fwMark) String() string {
+	return fmt.Sprintf("0x%x/0x%x", m.value, m.mask)
+}
+
+//parseFwMark parses mark in form 'value[/mask]' where numbers are decimal or '0x' hex;
+//mask is 0xffffffff if omitted, value must be nonzero and have no bits out of mask
+func parseFwMark(s string) (fwMark, error) {
+	ret := fwMark{mask: ^uint32(0)}
+	v, m, hasMask := s, "", false
+	if i := strings.IndexByte(s, '/'); i >= 0 {
+		v, m, hasMask = s[:i], s[i+1:], true
+	}
+	n, err := strconv.ParseUint(v, 0, 32)
+	if err != nil {
+		return ret, status.Errorf(codes.InvalidArgument, "'fwMark': bad value '%s'", v)
+	}
+	ret.value = uint32(n)
+	if hasMask {
+		if n, err = strconv.ParseUint(m, 0, 32); err != nil || n == 0 {
+			return ret, status.Errorf(codes.InvalidArgument, "'fwMark': bad mask '%s'", m)
+		}
+		ret.mask = uint32(n)
+	}
+	if ret.value == 0 {
+		return ret, status.Errorf(codes.InvalidArgument, "'fwMark': value must not be zero")
+	}
+	if ret.value&^ret.mask != 0 {
+		return ret, status.Errorf(codes.InvalidArgument, "'fwMark': value %#x has bits out of mask %#x", ret.value, ret.mask)
+	}
+	return ret, nil
+}
+
+func iptablesFwMarkArgs(op, tunnelName string, mark fwMark) []string {
+	return []string{
+		"-t", "mangle", op, "POSTROUTING", "-o", tunnelName,
+		"-m", "comment", "--comment", fwRuleComment(tunnelName),
+		"-j", "MARK", "--set-mark", mark.String(),
+	}
+}
+
+//nftFwMarkStatement nft statement which sets mark bits under mask keeping the rest
+func nftFwMarkStatement(mark fwMark) []string {
+	if mark.mask == ^uint32(0) {
+		return []string{"meta", "mark", "set", fmt.Sprintf("%#x", mark.value)}
+	}
+	return []string{"meta", "mark", "set", "meta", "mark", "and", fmt.Sprintf("%#x", ^mark.mask), "or", fmt.Sprintf("%#x", mark.value)}
+}
+
+//addFwMark installs rule which marks traffic leaving through the tunnel
+func (srv *tunnelService) addFwMark(ctx context.Context, backend, tunnelName string, mark fwMark) error {
+	switch backend {
+	case FirewallBackendIptables:
+		_, err := srv.execExternal(ctx, nil, nil, FirewallBackendIptables, iptablesFwMarkArgs("-A", tunnelName, mark)...)
+		return err
+	case FirewallBackendNft:
+		rule := append([]string{"oifname", strconv.Quote(tunnelName)}, nftFwMarkStatement(mark)...)
+		rule = append(rule, "comment", strconv.Quote(fwRuleComment(tunnelName)))
+		return srv.nftAddRule(ctx, nftChainPostrouting, "postrouting", rule...)
+	}
+	return errors.Errorf("unsupported firewall backend '%s'", backend)
+}
+
+//hasFwMark checks if mark rule of the tunnel is installed
+func (srv *tunnelService) hasFwMark(ctx context.Context, backend, tunnelName string, mark fwMark) (bool, error) {
+	switch backend {
+	case FirewallBackendIptables:
+		return srv.iptablesHasRule(ctx, iptablesFwMarkArgs("-C", tunnelName, mark))
+	case FirewallBackendNft:
+		return srv.nftHasTunnelRule(ctx, nftChainPostrouting, tunnelName)
+	}
+	return false, errors.Errorf("unsupported firewall backend '%s'", backend)
+}
+
+//delFwMark removes mark rule of the tunnel
+func (srv *tunnelService) delFwMark(ctx context.Context, backend, tunnelName string, mark fwMark) error {
+	switch backend {
+	case FirewallBackendIptables:
+		_, err := srv.execExternal(ctx, nil, nil, FirewallBackendIptables, iptablesFwMarkArgs("-D", tunnelName, mark)...)
+		return err
+	case FirewallBackendNft:
+		return srv.nftDelTunnelRules(ctx, nftChainPostrouting, tunnelName)
+	}
+	return errors.Errorf("unsupported firewall backend '%s'", backend)
+}
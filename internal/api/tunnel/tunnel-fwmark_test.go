@@ -0,0 +1,29 @@
+package tunnel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_ParseFwMark(t *testing.T) {
+	m, err := parseFwMark("0x10/0xff")
+	assert.NoError(t, err)
+	assert.Equal(t, fwMark{0x10, 0xff}, m)
+	assert.Equal(t, "0x10/0xff", m.String())
+	assert.Equal(t, []string{"meta", "mark", "set", "meta", "mark", "and", "0xffffff00", "or", "0x10"}, nftFwMarkStatement(m))
+
+	m, err = parseFwMark("16")
+	assert.NoError(t, err)
+	assert.Equal(t, "0x10/0xffffffff", m.String())
+	assert.Equal(t, []string{"meta", "mark", "set", "0x10"}, nftFwMarkStatement(m))
+	args := iptablesFwMarkArgs("-A", "tun1", m)
+	assert.Equal(t, []string{"--set-mark", "0x10/0xffffffff"}, args[len(args)-2:])
+
+	for _, bad := range []string{"", "0", "0x100/0xff", "1/0", "x", "0x1ffffffff", "1/"} {
+		_, err = parseFwMark(bad)
+		assert.Equalf(t, codes.InvalidArgument, status.Code(err), "mark '%s'", bad)
+	}
+}
@@ -0,0 +1,144 @@
+package tunnel
+
+import (
+	"compress/gzip"
+	"context"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	grpcRt "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+)
+
+const (
+	//GatewayPathPrefix REST routes of the service start with it
+	GatewayPathPrefix = "/v2"
+)
+
+//GzipGatewayHandler REST gateway of the service which calls gRPC server listening on 'bindEndpoint';
+//responses are gzip compressed with 'Content-Encoding: gzip' when client sends 'Accept-Encoding: gzip'.
+//The handler serves paths with and without 'GatewayPathPrefix' so it may be mounted under that prefix
+func GzipGatewayHandler(ctx context.Context, bindEndpoint string) (http.Handler, error) {
+	const api = "tunnel/GzipGatewayHandler"
+
+	target, err := dialTarget(bindEndpoint)
+	if err != nil {
+		return nil, errors.Wrap(err, api)
+	}
+	mux := grpcRt.NewServeMux()
+	err = tunnel.RegisterTunnelServiceHandlerFromEndpoint(ctx, mux, target, []grpc.DialOption{grpc.WithInsecure()})
+	if err != nil {
+		return nil, errors.Wrap(err, api)
+	}
+	return gzipHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, GatewayPathPrefix+"/") {
+			r.URL.Path = GatewayPathPrefix + r.URL.Path
+			r.URL.RawPath = ""
+		}
+		mux.ServeHTTP(w, r)
+	})), nil
+}
+
+//dialTarget makes gRPC dial target of server bind endpoint 'tcp://host:port' or 'unix:///path';
+//server bound to unspecified address is dialed on loopback
+func dialTarget(bindEndpoint string) (string, error) {
+	u, err := url.Parse(bindEndpoint)
+	if err != nil {
+		return "", errors.Wrapf(err, "bind endpoint '%s'", bindEndpoint)
+	}
+	switch u.Scheme {
+	case "tcp":
+		h, p, err := net.SplitHostPort(u.Host)
+		if err != nil {
+			return "", errors.Wrapf(err, "bind endpoint '%s'", bindEndpoint)
+		}
+		if ip := net.ParseIP(h); len(h) == 0 || (ip != nil && ip.IsUnspecified()) {
+			h = "127.0.0.1"
+		}
+		return net.JoinHostPort(h, p), nil
+	case "unix":
+		return "unix://" + u.Path, nil
+	}
+	return "", errors.Errorf("bind endpoint '%s' has unsupported scheme", bindEndpoint)
+}
+
+//gzipHandler compresses responses of 'next' for clients which accept gzip
+func gzipHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", "Accept-Encoding")
+		if !acceptsGzip(r) || r.Method == http.MethodHead {
+			next.ServeHTTP(w, r)
+			return
+		}
+		gw := &gzipResponseWriter{ResponseWriter: w}
+		defer gw.close()
+		next.ServeHTTP(gw, r)
+	})
+}
+
+//acceptsGzip checks if request 'Accept-Encoding' allows gzip
+func acceptsGzip(r *http.Request) bool {
+	for _, v := range r.Header.Values("Accept-Encoding") {
+		for _, enc := range strings.Split(v, ",") {
+			enc = strings.TrimSpace(enc)
+			name, q := enc, ""
+			if i := strings.IndexByte(enc, ';'); i >= 0 {
+				name, q = strings.TrimSpace(enc[:i]), strings.ReplaceAll(enc[i+1:], " ", "")
+			}
+			if strings.EqualFold(name, "gzip") && q != "q=0" && q != "q=0.0" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+//gzipResponseWriter compresses response body; streamed responses are flushed through
+type gzipResponseWriter struct {
+	http.ResponseWriter
+	gz          *gzip.Writer
+	wroteHeader bool
+}
+
+func (w *gzipResponseWriter) WriteHeader(code int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		h := w.Header()
+		if len(h.Get("Content-Encoding")) == 0 && code != http.StatusNoContent && code != http.StatusNotModified {
+			h.Set("Content-Encoding", "gzip")
+			h.Del("Content-Length")
+			w.gz = gzip.NewWriter(w.ResponseWriter)
+		}
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *gzipResponseWriter) Write(p []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.gz == nil {
+		return w.ResponseWriter.Write(p)
+	}
+	return w.gz.Write(p)
+}
+
+//Flush sends compressed data written so far; the gateway flushes after each message of server stream
+func (w *gzipResponseWriter) Flush() {
+	if w.gz != nil {
+		_ = w.gz.Flush()
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (w *gzipResponseWriter) close() {
+	if w.gz != nil {
+		_ = w.gz.Close()
+	}
+}
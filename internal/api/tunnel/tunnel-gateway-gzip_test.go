@@ -0,0 +1,124 @@
+package tunnel
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/stretchr/testify/assert"
+	"github.com/vishvananda/netlink"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/protobuf/proto"
+)
+
+func Test_GetStateGzipCompression(t *testing.T) {
+	c := encoding.GetCompressor("gzip")
+	if !assert.NotNil(t, c) {
+		return
+	}
+	resp := new(tunnel.GetStateResponse)
+	for i := 1; i <= 5000; i++ {
+		ip := net.IPv4(10, byte(i>>16), byte(i>>8), byte(i))
+		name := mustTunnelName(ip)
+		resp.Tunnels = append(resp.Tunnels, name)
+		resp.Details = append(resp.Details, &tunnel.TunnelInfo{
+			Name: name, Remote: ip.String(), NoArp: true, Mtu: 1480, AdminUp: true,
+		})
+	}
+	raw, err := proto.Marshal(resp)
+	if !assert.NoError(t, err) {
+		return
+	}
+	var buf bytes.Buffer
+	w, err := c.Compress(&buf)
+	if !assert.NoError(t, err) {
+		return
+	}
+	_, err = w.Write(raw)
+	assert.NoError(t, err)
+	assert.NoError(t, w.Close())
+	t.Logf("GetState of %v tunnels: %v bytes, gzip %v bytes", len(resp.Tunnels), len(raw), buf.Len())
+	assert.Less(t, buf.Len(), len(raw)/2)
+}
+
+func Test_GzipGateway(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	srv := NewTunnelService(ctx).(*tunnelService)
+	var links []netlink.Link
+	for i := 1; i <= 500; i++ {
+		ip := net.IPv4(10, 0, byte(i>>8), byte(i))
+		links = append(links, &netlink.Iptun{
+			LinkAttrs: netlink.LinkAttrs{Name: mustTunnelName(ip), MTU: 1480, Flags: net.FlagUp},
+			Remote:    ip,
+		})
+	}
+	useFakeNetlink(srv, links...)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if !assert.NoError(t, err) {
+		return
+	}
+	gs := grpc.NewServer()
+	tunnel.RegisterTunnelServiceServer(gs, srv)
+	go func() {
+		_ = gs.Serve(lis)
+	}()
+	defer gs.Stop()
+
+	gw, err := GzipGatewayHandler(ctx, "tcp://"+lis.Addr().String())
+	if !assert.NoError(t, err) {
+		return
+	}
+	get := func(path, acceptEncoding string) *http.Response {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		if len(acceptEncoding) > 0 {
+			req.Header.Set("Accept-Encoding", acceptEncoding)
+		}
+		rec := httptest.NewRecorder()
+		gw.ServeHTTP(rec, req)
+		return rec.Result()
+	}
+	plain := get("/v2/tunnel/state?detailed=true", "")
+	raw, _ := io.ReadAll(plain.Body)
+	assert.Equal(t, http.StatusOK, plain.StatusCode, string(raw))
+	assert.Empty(t, plain.Header.Get("Content-Encoding"))
+
+	//mounted under prefix the handler gets path without it
+	for _, path := range []string{"/v2/tunnel/state?detailed=true", "/tunnel/state?detailed=true"} {
+		resp := get(path, "br, gzip;q=0.8")
+		if !assert.Equal(t, http.StatusOK, resp.StatusCode, path) {
+			continue
+		}
+		assert.Equal(t, "gzip", resp.Header.Get("Content-Encoding"), path)
+		compressed, _ := io.ReadAll(resp.Body)
+		zr, err := gzip.NewReader(bytes.NewReader(compressed))
+		if assert.NoError(t, err, path) {
+			body, err := io.ReadAll(zr)
+			assert.NoError(t, err, path)
+			assert.Equal(t, raw, body, path)
+		}
+		t.Logf("REST GetState of %v tunnels: %v bytes, gzip %v bytes", len(links), len(raw), len(compressed))
+		assert.Less(t, len(compressed), len(raw)/2, path)
+	}
+	assert.Empty(t, get("/v2/tunnel/state", "gzip;q=0").Header.Get("Content-Encoding"))
+
+	for endpoint, target := range map[string]string{
+		"tcp://0.0.0.0:9003":          "127.0.0.1:9003",
+		"tcp://10.1.1.1:9003":         "10.1.1.1:9003",
+		"unix:///run/tunnel/tun.sock": "unix:///run/tunnel/tun.sock",
+	} {
+		got, err := dialTarget(endpoint)
+		if assert.NoError(t, err, endpoint) {
+			assert.Equal(t, target, got, endpoint)
+		}
+	}
+	_, err = dialTarget("udp://1.1.1.1:53")
+	assert.Error(t, err)
+}
@@ -0,0 +1,65 @@
+package tunnel
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/vishvananda/netlink"
+	"google.golang.org/grpc/status"
+)
+
+//GetTunnels impl tunnel service
+func (srv *tunnelService) GetTunnels(ctx context.Context, req *tunnel.GetTunnelsRequest) (resp *tunnel.GetTunnelsResponse, err error) {
+	ctx, cancel := withDefaultDeadline(ctx, srv.readTimeout)
+	defer cancel()
+
+	var leave func()
+	if leave, err = srv.enter(ctx); err != nil {
+		return nil, err
+	}
+	defer func() {
+		leave()
+		err = srv.correctError(err)
+	}()
+
+	links := make(map[string]netlink.Link)
+	err = srv.enumLinks(func(nl netlink.Link) error {
+		links[nl.Attrs().Name] = nl
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp = &tunnel.GetTunnelsResponse{
+		Results: make([]*tunnel.TunnelLookup, 0, len(req.GetNamesOrIPs())),
+	}
+	for _, q := range req.GetNamesOrIPs() {
+		r := &tunnel.TunnelLookup{Query: q}
+		resp.Results = append(resp.Results, r)
+		name := q
+		if net.ParseIP(q) != nil {
+			ip, e := parseTunDestIP(q)
+			if e == nil {
+				name, e = TunnelNameForIP(ip)
+			}
+			if e != nil {
+				r.Error = status.Convert(e).Message()
+				continue
+			}
+		}
+		link := links[name]
+		if link == nil {
+			r.Error = fmt.Sprintf("tunnel '%v' is not found", name)
+			continue
+		}
+		var e error
+		if r.Tunnel, e = tunnelInfoFromLink(link); e != nil {
+			r.Error = e.Error()
+			continue
+		}
+		r.Found = true
+	}
+	return resp, nil
+}
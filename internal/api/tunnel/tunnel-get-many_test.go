@@ -0,0 +1,37 @@
+package tunnel
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/stretchr/testify/assert"
+	"github.com/vishvananda/netlink"
+)
+
+func Test_GetTunnels(t *testing.T) {
+	ctx := context.Background()
+	srv := NewTunnelService(ctx).(*tunnelService)
+	srv.linkList = func() ([]netlink.Link, error) {
+		return []netlink.Link{
+			&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun16843009"}, Remote: net.ParseIP("1.1.1.1")},
+			&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun2"}},
+		}, nil
+	}
+	queries := []string{"tun2", "1.1.1.1", "tun3", "127.0.0.1"}
+	resp, err := srv.GetTunnels(ctx, &tunnel.GetTunnelsRequest{NamesOrIPs: queries})
+	if !assert.NoError(t, err) || !assert.Len(t, resp.GetResults(), len(queries)) {
+		return
+	}
+	for i, r := range resp.GetResults() {
+		assert.Equal(t, queries[i], r.GetQuery())
+	}
+	assert.True(t, resp.GetResults()[0].GetFound())
+	assert.True(t, resp.GetResults()[1].GetFound())
+	assert.Equal(t, "1.1.1.1", resp.GetResults()[1].GetTunnel().GetRemote())
+	assert.False(t, resp.GetResults()[2].GetFound())
+	assert.NotEmpty(t, resp.GetResults()[2].GetError())
+	assert.False(t, resp.GetResults()[3].GetFound())
+	assert.NotEmpty(t, resp.GetResults()[3].GetError())
+}
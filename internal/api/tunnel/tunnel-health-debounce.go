@@ -0,0 +1,204 @@
+package tunnel
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+)
+
+//healthDebounce hides brief flaps of tunnel health
+//  - tunnel reported healthy turns unhealthy once it is observed unhealthy for 'downGrace'
+//  - tunnel reported unhealthy recovers once it is observed healthy for 'upStable'
+//  - observation back to the reported health cancels pending change
+//  - the first observation of tunnel is reported as is and counts as change
+type healthDebounce struct {
+	mu        sync.Mutex
+	downGrace time.Duration
+	upStable  time.Duration
+	now       func() time.Time
+	states    map[string]*debounceState
+}
+
+type debounceState struct {
+	reported bool
+	observed bool
+	since    time.Time //when observed health became other than reported one
+}
+
+func newHealthDebounce(downGrace, upStable time.Duration) *healthDebounce {
+	return &healthDebounce{
+		downGrace: downGrace,
+		upStable:  upStable,
+		now:       time.Now,
+		states:    make(map[string]*debounceState),
+	}
+}
+
+//enabled tells if any window is set; otherwise health is reported as observed
+func (d *healthDebounce) enabled() bool {
+	return d != nil && (d.downGrace > 0 || d.upStable > 0)
+}
+
+//observe takes observed health of tunnel 'name' and gets health to report, if it has changed since
+//the previous report and when pending change settles if health is not observed anew (zero if nothing is pending)
+func (d *healthDebounce) observe(name string, healthy bool) (reported, changed bool, settleAt time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	st := d.states[name]
+	if st == nil {
+		d.states[name] = &debounceState{reported: healthy, observed: healthy}
+		return healthy, true, time.Time{}
+	}
+	if st.observed != healthy {
+		st.observed, st.since = healthy, d.now()
+	}
+	return d.evalLocked(st)
+}
+
+//settle re-evaluates tunnel 'name' by its last observed health; it is for pending changes which are due
+func (d *healthDebounce) settle(name string) (reported, changed bool, settleAt time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	st := d.states[name]
+	if st == nil {
+		return false, false, time.Time{}
+	}
+	return d.evalLocked(st)
+}
+
+func (d *healthDebounce) evalLocked(st *debounceState) (reported, changed bool, settleAt time.Time) {
+	if st.observed == st.reported {
+		st.since = time.Time{}
+		return st.reported, false, time.Time{}
+	}
+	window := d.upStable
+	if st.reported {
+		window = d.downGrace
+	}
+	if at := st.since.Add(window); d.now().Before(at) {
+		return st.reported, false, at
+	}
+	st.reported, st.since = st.observed, time.Time{}
+	return st.reported, true, time.Time{}
+}
+
+//forget drops state of removed tunnel
+func (d *healthDebounce) forget(name string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.states, name)
+}
+
+//retain drops states of tunnels other than 'names'
+func (d *healthDebounce) retain(names map[string]bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for name := range d.states {
+		if !names[name] {
+			delete(d.states, name)
+		}
+	}
+}
+
+//eventDebounce holds back UP/DOWN events of one WatchTunnelEvents stream until tunnel health settles;
+//held back change is sent when its window passes unless tunnel flaps back meanwhile
+type eventDebounce struct {
+	health  *healthDebounce
+	pending map[string]time.Time
+	timer   *time.Timer
+}
+
+//newEventDebounce makes event debounce of tunnels 'known' up or down; nil if service has no debounce windows
+func (srv *tunnelService) newEventDebounce(known map[string]bool) *eventDebounce {
+	if !srv.healthDebounce.enabled() {
+		return nil
+	}
+	ret := &eventDebounce{
+		health:  newHealthDebounce(srv.healthDownGrace, srv.healthUpStable),
+		pending: make(map[string]time.Time),
+	}
+	for name, up := range known {
+		ret.health.observe(name, up)
+	}
+	return ret
+}
+
+//pass tells if event of tunnel 'name' is to be sent now
+func (e *eventDebounce) pass(name string, kind tunnel.TunnelEventKind) bool {
+	if e == nil {
+		return true
+	}
+	switch kind {
+	case tunnel.TunnelEventKind_TUNNEL_EVENT_REMOVED:
+		e.health.forget(name)
+		delete(e.pending, name)
+		e.rearm()
+		return true
+	case tunnel.TunnelEventKind_TUNNEL_EVENT_UP, tunnel.TunnelEventKind_TUNNEL_EVENT_DOWN:
+		_, changed, settleAt := e.health.observe(name, kind == tunnel.TunnelEventKind_TUNNEL_EVENT_UP)
+		e.setPending(name, settleAt)
+		e.rearm()
+		return changed
+	}
+	return true
+}
+
+//due gets UP/DOWN events of held back changes which have settled by now
+func (e *eventDebounce) due() []*tunnel.TunnelEvent {
+	var ret []*tunnel.TunnelEvent
+	now := e.health.now()
+	for name, at := range e.pending {
+		if now.Before(at) {
+			continue
+		}
+		up, changed, settleAt := e.health.settle(name)
+		e.setPending(name, settleAt)
+		if changed {
+			kind := tunnel.TunnelEventKind_TUNNEL_EVENT_DOWN
+			if up {
+				kind = tunnel.TunnelEventKind_TUNNEL_EVENT_UP
+			}
+			ret = append(ret, &tunnel.TunnelEvent{Name: name, Kind: kind})
+		}
+	}
+	e.rearm()
+	return ret
+}
+
+//wake fires when the earliest held back change is due; nil if nothing is held back
+func (e *eventDebounce) wake() <-chan time.Time {
+	if e == nil || e.timer == nil {
+		return nil
+	}
+	return e.timer.C
+}
+
+//stop releases timer
+func (e *eventDebounce) stop() {
+	if e != nil && e.timer != nil {
+		e.timer.Stop()
+	}
+}
+
+func (e *eventDebounce) setPending(name string, settleAt time.Time) {
+	if settleAt.IsZero() {
+		delete(e.pending, name)
+	} else {
+		e.pending[name] = settleAt
+	}
+}
+
+func (e *eventDebounce) rearm() {
+	e.stop()
+	e.timer = nil
+	var earliest time.Time
+	for _, at := range e.pending {
+		if earliest.IsZero() || at.Before(earliest) {
+			earliest = at
+		}
+	}
+	if !earliest.IsZero() {
+		e.timer = time.NewTimer(earliest.Sub(e.health.now()))
+	}
+}
@@ -0,0 +1,49 @@
+package tunnel
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_HealthDebounce(t *testing.T) {
+	now := time.Unix(1000, 0)
+	d := newHealthDebounce(10*time.Second, 30*time.Second)
+	d.now = func() time.Time { return now }
+
+	up, changed, _ := d.observe("tun1", true)
+	assert.True(t, up)
+	assert.True(t, changed, "the first observation is reported as is")
+
+	up, changed, settleAt := d.observe("tun1", false)
+	assert.True(t, up)
+	assert.False(t, changed)
+	assert.Equal(t, now.Add(10*time.Second), settleAt)
+
+	now = now.Add(5 * time.Second)
+	up, _, settleAt = d.observe("tun1", true)
+	assert.True(t, up, "flap shorter than grace window is hidden")
+	assert.True(t, settleAt.IsZero())
+
+	d.observe("tun1", false)
+	now = now.Add(10 * time.Second)
+	up, changed, _ = d.settle("tun1")
+	assert.False(t, up)
+	assert.True(t, changed)
+
+	d.observe("tun1", true)
+	now = now.Add(29 * time.Second)
+	up, _, _ = d.observe("tun1", true)
+	assert.False(t, up, "recovery is reported after stabilization window")
+	now = now.Add(time.Second)
+	up, changed, _ = d.observe("tun1", true)
+	assert.True(t, up)
+	assert.True(t, changed)
+
+	d.retain(map[string]bool{"tun2": true})
+	_, changed, _ = d.observe("tun1", false)
+	assert.True(t, changed, "state of not retained tunnel is dropped")
+
+	assert.False(t, newHealthDebounce(0, 0).enabled())
+}
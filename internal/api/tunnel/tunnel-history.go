@@ -0,0 +1,123 @@
+package tunnel
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+//tunnelHistory keeps per tunnel timeline of changes made by the service
+//  - every successful mutation the webhook is notified of is recorded, whether webhook is set or not
+//  - tunnel keeps at most 'perTunnel' latest records, each no longer than 'ttl'
+//  - history is kept in memory and is lost on restart; it is off by default
+type tunnelHistory struct {
+	mu        sync.Mutex
+	perTunnel int
+	ttl       time.Duration
+	now       func() time.Time
+	byName    map[string][]historyRecord
+}
+
+type historyRecord struct {
+	action string
+	remote string
+	actor  string
+	at     time.Time
+}
+
+func newTunnelHistory(perTunnel int, ttl time.Duration) *tunnelHistory {
+	return &tunnelHistory{
+		perTunnel: perTunnel,
+		ttl:       ttl,
+		now:       time.Now,
+		byName:    make(map[string][]historyRecord),
+	}
+}
+
+//enabled tells if history is kept at all
+func (h *tunnelHistory) enabled() bool {
+	return h.perTunnel > 0 && h.ttl > 0
+}
+
+//record appends change of tunnel 'name'
+func (h *tunnelHistory) record(name, action, remote, actor string) {
+	if !h.enabled() {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.evictLocked()
+	recs := append(h.byName[name], historyRecord{action: action, remote: remote, actor: actor, at: h.now().UTC()})
+	if n := len(recs) - h.perTunnel; n > 0 {
+		recs = append(recs[:0:0], recs[n:]...)
+	}
+	h.byName[name] = recs
+}
+
+//of gets history of tunnel 'name' from older to newer changes
+func (h *tunnelHistory) of(name string) []historyRecord {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.evictLocked()
+	return append([]historyRecord(nil), h.byName[name]...)
+}
+
+//evictLocked drops expired records and tunnels left without records
+func (h *tunnelHistory) evictLocked() {
+	now := h.now()
+	for name, recs := range h.byName {
+		i := 0
+		for i < len(recs) && now.Sub(recs[i].at) >= h.ttl {
+			i++
+		}
+		switch {
+		case i == len(recs):
+			delete(h.byName, name)
+		case i > 0:
+			h.byName[name] = recs[i:]
+		}
+	}
+}
+
+//actorOf gets address of the client which called RPC; empty if it is unknown
+func actorOf(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+	return ""
+}
+
+//GetTunnelHistory impl tunnel service
+func (srv *tunnelService) GetTunnelHistory(ctx context.Context, req *tunnel.GetTunnelHistoryRequest) (resp *tunnel.GetTunnelHistoryResponse, err error) {
+	span := srv.spanOf(ctx)
+	defer func() {
+		err = srv.correctError(err)
+	}()
+
+	span.SetAttributes(attribute.String("tunDestIP", req.GetTunDestIP()))
+	if !srv.history.enabled() {
+		return nil, status.Errorf(codes.Unimplemented, "tunnel history is not enabled")
+	}
+	var tunnelName string
+	if tunnelName, err = tunnelNameOf(req.GetTunDestIP(), req.GetName()); err != nil {
+		return nil, err
+	}
+	span.SetAttributes(attribute.String("tunnel-name", tunnelName))
+	resp = &tunnel.GetTunnelHistoryResponse{Name: tunnelName}
+	for _, r := range srv.history.of(tunnelName) {
+		resp.Events = append(resp.Events, &tunnel.TunnelHistoryEvent{
+			Action: r.action,
+			Remote: r.remote,
+			At:     timestamppb.New(r.at),
+			Actor:  r.actor,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,45 @@
+package tunnel
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_TunnelHistory(t *testing.T) {
+	ctx := context.Background()
+	srv := NewTunnelService(ctx).(*tunnelService)
+	_, err := srv.GetTunnelHistory(ctx, &tunnel.GetTunnelHistoryRequest{Name: "tun1"})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	srv = NewTunnelService(ctx, WithTunnelHistory(2, time.Hour)).(*tunnelService)
+	now := time.Unix(1000, 0)
+	srv.history.now = func() time.Time { return now }
+	name := mustTunnelName(net.ParseIP("1.1.1.1"))
+	for _, action := range []string{webhookActionAdd, webhookActionSetDown, webhookActionSetUp} {
+		srv.notifyChange(ctx, action, name, "1.1.1.1")
+		now = now.Add(time.Minute)
+	}
+	resp, err := srv.GetTunnelHistory(ctx, &tunnel.GetTunnelHistoryRequest{TunDestIP: "1.1.1.1"})
+	if assert.NoError(t, err) && assert.Len(t, resp.GetEvents(), 2) {
+		assert.Equal(t, name, resp.GetName())
+		assert.Equal(t, webhookActionSetDown, resp.GetEvents()[0].GetAction())
+		assert.Equal(t, webhookActionSetUp, resp.GetEvents()[1].GetAction())
+		assert.Equal(t, "1.1.1.1", resp.GetEvents()[1].GetRemote())
+		assert.True(t, resp.GetEvents()[0].GetAt().AsTime().Before(resp.GetEvents()[1].GetAt().AsTime()))
+	}
+
+	now = now.Add(time.Hour)
+	resp, err = srv.GetTunnelHistory(ctx, &tunnel.GetTunnelHistoryRequest{Name: name})
+	if assert.NoError(t, err) {
+		assert.Empty(t, resp.GetEvents())
+	}
+	_, err = srv.GetTunnelHistory(ctx, &tunnel.GetTunnelHistoryRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
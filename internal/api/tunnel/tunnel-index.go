@@ -0,0 +1,35 @@
+package tunnel
+
+import (
+	"net"
+
+	netPrivate "github.com/gradusp/crispy-tunnel/internal/pkg/net"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	attrTunnelIndex = "tunnel-index"
+)
+
+//tunnelIndexOf derived integer index of tunnel to 'remote'; it is the number in the tunnel name
+func tunnelIndexOf(remote net.IP) int64 {
+	return netPrivate.IPType(remote).Int()
+}
+
+//annotateTunnelIndex adds derived index of tunnel to 'remote' as numeric span attribute
+//so traces can be queried by index ranges which match subnets of remotes; needs 'WithTunnelIndexAnnotation'
+func (srv *tunnelService) annotateTunnelIndex(span trace.Span, remote net.IP) {
+	if srv.tunnelIndexAnnotation {
+		span.SetAttributes(attribute.Int64(attrTunnelIndex, tunnelIndexOf(remote)))
+	}
+}
+
+//tunnelLogFields structured log fields of tunnel; derived index is there with 'WithTunnelIndexAnnotation'
+func (srv *tunnelService) tunnelLogFields(tunnelName string, remote net.IP) []interface{} {
+	ret := []interface{}{"tunnel-name", tunnelName, "remote", remote.String()}
+	if srv.tunnelIndexAnnotation {
+		ret = append(ret, attrTunnelIndex, tunnelIndexOf(remote))
+	}
+	return ret
+}
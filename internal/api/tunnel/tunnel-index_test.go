@@ -0,0 +1,25 @@
+package tunnel
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_TunnelIndexAnnotation(t *testing.T) {
+	ctx := context.Background()
+	remote := net.ParseIP("10.0.0.1")
+	assert.Equal(t, fmt.Sprintf("tun%d", tunnelIndexOf(remote)), mustTunnelName(remote))
+	assert.Equal(t, int64(167772161), tunnelIndexOf(remote))
+
+	srv := NewTunnelService(ctx).(*tunnelService)
+	assert.Equal(t, []interface{}{"tunnel-name", "tun167772161", "remote", "10.0.0.1"},
+		srv.tunnelLogFields("tun167772161", remote))
+
+	srv = NewTunnelService(ctx, WithTunnelIndexAnnotation(true)).(*tunnelService)
+	assert.Equal(t, []interface{}{"tunnel-name", "tun167772161", "remote", "10.0.0.1", attrTunnelIndex, int64(167772161)},
+		srv.tunnelLogFields("tun167772161", remote))
+}
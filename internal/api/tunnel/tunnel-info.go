@@ -0,0 +1,46 @@
+package tunnel
+
+import (
+	"net"
+	"syscall"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/pkg/errors"
+	"github.com/vishvananda/netlink"
+)
+
+// tunnelInfoFromLink makes tunnel info from netlink link
+func tunnelInfoFromLink(link netlink.Link) (*tunnel.TunnelInfo, error) {
+	a := link.Attrs()
+	t, ok := link.(*netlink.Iptun)
+	if !ok {
+		return nil, errors.Errorf("link '%v' has unexpected type '%s'", a.Name, link.Type())
+	}
+	ret := &tunnel.TunnelInfo{
+		Name:       a.Name,
+		NoArp:      a.RawFlags&syscall.IFF_NOARP != 0,
+		Multicast:  a.Flags&net.FlagMulticast != 0,
+		Mtu:        uint32(a.MTU),
+		PayloadMtu: payloadMtuOf(tunnelTypeIpip, uint32(a.MTU)),
+		AdminUp:    a.Flags&net.FlagUp != 0,
+		OperState:  a.OperState.String(),
+	}
+	labels := labelsOf(link)
+	ret.Weight = labels.weight()
+	ret.ClampMss = labels[labelMss]
+	ret.FwMark = labels[labelFwMark]
+	ret.Template = labels[labelTemplate]
+	ret.Alias = labels.userAlias()
+	if pr, ok := policyRouteOf(labels); ok {
+		ret.PolicyRoute = pr.info()
+	}
+	ret.Encryption = labels.encryptionHints()
+	_, ret.Cordoned = labels[labelCordoned]
+	if s, ok := egressShapingOf(labels); ok {
+		ret.EgressRateBps, ret.Qdisc = s.rateBps, s.qdisc
+	}
+	if t.Remote != nil {
+		ret.Remote = t.Remote.String()
+	}
+	return ret, nil
+}
@@ -0,0 +1,156 @@
+package tunnel
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/pkg/errors"
+	"github.com/vishvananda/netlink"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//Tunnel labels are metadata the service keeps in interface alias as
+//'crispy-tunnel:key1=val1,key2,...'; key without value is a flag
+const (
+	aliasLabelsPrefix = "crispy-tunnel:"
+
+	labelQuarantined = "quarantined"
+	labelWeight      = "weight"
+	labelMss         = "mss"
+	labelMssBackend  = "mss-backend"
+	labelFwMark      = "fwmark"
+	labelFwBackend   = "fwmark-backend"
+	labelTemplate    = "template"
+	labelAlias       = "alias"
+
+	labelPolicyTable    = "policy-table"
+	labelPolicyMark     = "policy-fwmark"
+	labelPolicyDst      = "policy-dst"
+	labelPolicyPriority = "policy-priority"
+
+	labelEncProfile  = "enc-profile"
+	labelEncSpiRange = "enc-spi"
+
+	labelMaintenanceDown = "maintenance-down"
+
+	labelShaping  = "shaping"
+	labelCordoned = "cordoned"
+
+	labelAddStep    = "add-step"
+	labelAddRequest = "add-req"
+)
+
+const (
+	maxTunnelWeight = 65535
+)
+
+type linkLabels map[string]string
+
+//labelsOf gets tunnel labels from link alias
+func labelsOf(link netlink.Link) linkLabels {
+	ret := make(linkLabels)
+	alias := link.Attrs().Alias
+	if !strings.HasPrefix(alias, aliasLabelsPrefix) {
+		return ret
+	}
+	for _, kv := range strings.Split(strings.TrimPrefix(alias, aliasLabelsPrefix), ",") {
+		if kv = strings.TrimSpace(kv); len(kv) == 0 {
+			continue
+		}
+		k, v := kv, ""
+		if i := strings.IndexByte(kv, '='); i >= 0 {
+			k, v = kv[:i], kv[i+1:]
+		}
+		ret[k] = v
+	}
+	return ret
+}
+
+//alias makes link alias from labels; empty labels make empty alias
+func (ll linkLabels) alias() string {
+	if len(ll) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(ll))
+	for k := range ll {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var b strings.Builder
+	b.WriteString(aliasLabelsPrefix)
+	for i, k := range keys {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(k)
+		if v := ll[k]; len(v) > 0 {
+			b.WriteByte('=')
+			b.WriteString(v)
+		}
+	}
+	return b.String()
+}
+
+//setLinkLabels stores labels onto the link
+func setLinkLabels(nl netlinkOps, link netlink.Link, ll linkLabels) error {
+	if err := nl.LinkSetAlias(link, ll.alias()); err != nil {
+		return errors.Wrapf(err, "netlink.LinkSetAlias(%s)", link.Attrs().Name)
+	}
+	return nil
+}
+
+//setRequestLabels sets labels AddTunnel keeps of request arguments
+func (ll linkLabels) setRequestLabels(req *tunnel.AddTunnelRequest, tmpl *TunnelTemplate, policy *policyRoute) {
+	if w := req.GetWeight(); w > 0 {
+		ll[labelWeight] = strconv.FormatUint(uint64(w), 10)
+	}
+	if tmpl != nil {
+		ll[labelTemplate] = req.GetTemplate()
+	}
+	if policy != nil {
+		policy.setLabels(ll)
+	}
+	ll.setEncryptionHints(req.GetEncryption())
+}
+
+//checkAddLabelsLen fails AddTunnel request before the link is created if labels the tunnel would get
+//do not fit into interface alias; firewall backend and journal labels are counted by their longest values
+func (srv *tunnelService) checkAddLabelsLen(req *tunnel.AddTunnelRequest, tmpl *TunnelTemplate, mss string, policy *policyRoute, shaping *egressShaping) error {
+	const backend = FirewallBackendIptables
+	ll := make(linkLabels)
+	if len(mss) > 0 {
+		ll[labelMss], ll[labelMssBackend] = mss, backend
+	}
+	if m, err := parseFwMark(req.GetFwMark()); err == nil && len(req.GetFwMark()) > 0 {
+		ll[labelFwMark], ll[labelFwBackend] = m.String(), backend
+	}
+	if shaping != nil {
+		shaping.setLabels(ll)
+	}
+	ll.setRequestLabels(req, tmpl, policy)
+	if srv.resumableAdd {
+		ll[labelAddStep], ll[labelAddRequest] = addPhaseTemplateSysctls, addRequestHash(req)
+	}
+	if n := len(ll.alias()); n > maxLinkAliasLen {
+		return status.Errorf(codes.InvalidArgument,
+			"tunnel labels of the request take %v bytes but interface alias is limited by %v", n, maxLinkAliasLen)
+	}
+	return nil
+}
+
+//validateWeight checks 'weight' request argument; zero means it is not set
+func validateWeight(weight uint32) error {
+	if weight > maxTunnelWeight {
+		return status.Errorf(codes.InvalidArgument, "'weight': %v is above maximum %v", weight, maxTunnelWeight)
+	}
+	return nil
+}
+
+//weight gets tunnel weight label; zero if it is not set
+func (ll linkLabels) weight() uint32 {
+	w, _ := strconv.ParseUint(ll[labelWeight], 10, 32)
+	return uint32(w)
+}
@@ -0,0 +1,61 @@
+package tunnel
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/stretchr/testify/assert"
+	"github.com/vishvananda/netlink"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_LinkLabels(t *testing.T) {
+	link := &netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun1"}}
+	assert.Empty(t, labelsOf(link))
+	assert.False(t, isQuarantined(link))
+
+	link.Alias = "crispy-tunnel:quarantined"
+	assert.True(t, isQuarantined(link))
+
+	ll := linkLabels{labelWeight: "10", labelQuarantined: ""}
+	assert.Equal(t, "crispy-tunnel:quarantined,weight=10", ll.alias())
+	link.Alias = ll.alias()
+	assert.Equal(t, ll, labelsOf(link))
+	assert.Equal(t, uint32(10), labelsOf(link).weight())
+
+	link.Alias = "some alias"
+	assert.Empty(t, labelsOf(link))
+	assert.Equal(t, "", linkLabels{}.alias())
+
+	assert.NoError(t, validateWeight(0))
+	assert.NoError(t, validateWeight(maxTunnelWeight))
+	assert.Equal(t, codes.InvalidArgument, status.Code(validateWeight(maxTunnelWeight+1)))
+}
+
+func Test_AddTunnelLabelsLen(t *testing.T) {
+	ctx := context.Background()
+	long, short := strings.Repeat("t", 180), "edge"
+	srv := NewTunnelService(ctx,
+		WithExecEnv(fakeCommands(t, "sysctl")),
+		WithTemplate(long, TunnelTemplate{}), WithTemplate(short, TunnelTemplate{}),
+	).(*tunnelService)
+	fake := useFakeNetlink(srv)
+	req := &tunnel.AddTunnelRequest{
+		TunDestIP:  "10.0.0.1",
+		Template:   long,
+		Weight:     100,
+		Encryption: &tunnel.EncryptionHints{Profile: strings.Repeat("p", 32), SpiRange: "0x100-0xffffffff"},
+	}
+	_, err := srv.AddTunnel(ctx, req)
+	if assert.Equal(t, codes.InvalidArgument, status.Code(err)) {
+		assert.Contains(t, err.Error(), "interface alias is limited")
+	}
+	assert.Empty(t, fake.calls, "link is not created")
+
+	req.Template = short
+	_, err = srv.AddTunnel(ctx, req)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,53 @@
+package tunnel
+
+import (
+	"context"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/pkg/errors"
+	"github.com/vishvananda/netlink"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//validateLinkFlag checks value of link flag request field 'name'
+func validateLinkFlag(name string, val tunnel.LinkFlag) error {
+	if _, ok := tunnel.LinkFlag_name[int32(val)]; !ok {
+		return status.Errorf(codes.InvalidArgument, "'%s': unsupported value (%v)", name, val)
+	}
+	return nil
+}
+
+//validateMulticastFlag checks 'multicast' request field and its combination with other fields:
+//multicast routes are useless on tunnel which has MULTICAST turned off explicitly
+func validateMulticastFlag(req *tunnel.AddTunnelRequest) error {
+	if err := validateLinkFlag("multicast", req.GetMulticast()); err != nil {
+		return err
+	}
+	if req.GetMulticast() == tunnel.LinkFlag_LINK_FLAG_OFF && len(req.GetMulticastRoutes()) > 0 {
+		return status.Errorf(codes.InvalidArgument, "'multicast': is OFF but 'multicastRoutes' are given")
+	}
+	return nil
+}
+
+//applyLinkFlags sets up requested flags onto just created tunnel link
+//  - MULTICAST is turned on by 'netlink.LinkAdd' through LinkAttrs.Flags;
+//    IPIP links are created without MULTICAST so 'LINK_FLAG_OFF' needs nothing to do
+//  - NOARP is turned by 'netlink.LinkSetARPOff'/'netlink.LinkSetARPOn'
+func (srv *tunnelService) applyLinkFlags(ctx context.Context, span trace.Span, link netlink.Link, req *tunnel.AddTunnelRequest) error {
+	name := link.Attrs().Name
+	switch req.GetNoArp() {
+	case tunnel.LinkFlag_LINK_FLAG_ON:
+		srv.addSpanDbgEvent(ctx, span, "netlink.LinkSetARPOff")
+		if err := srv.nl.LinkSetARPOff(link); err != nil {
+			return errors.Wrapf(err, "netlink.LinkSetARPOff('%v')", name)
+		}
+	case tunnel.LinkFlag_LINK_FLAG_OFF:
+		srv.addSpanDbgEvent(ctx, span, "netlink.LinkSetARPOn")
+		if err := srv.nl.LinkSetARPOn(link); err != nil {
+			return errors.Wrapf(err, "netlink.LinkSetARPOn('%v')", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package tunnel
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_AddTunnelLinkFlags(t *testing.T) {
+	ctx := context.Background()
+	srv := NewTunnelService(ctx, WithExecEnv(fakeCommands(t, "sysctl"))).(*tunnelService)
+	fake := useFakeNetlink(srv)
+	const (
+		unset = tunnel.LinkFlag_LINK_FLAG_DEFAULT
+		on    = tunnel.LinkFlag_LINK_FLAG_ON
+		off   = tunnel.LinkFlag_LINK_FLAG_OFF
+	)
+	cases := []struct {
+		ip                   string
+		noArp, multicast     tunnel.LinkFlag
+		wantNoArp, wantMcast bool
+		calls                []string
+	}{
+		//unset flags are left as kernel makes IPIP links: NOARP on, MULTICAST off
+		{"10.0.0.1", unset, unset, true, false, nil},
+		{"10.0.0.2", on, on, true, true, []string{"LinkSetARPOff"}},
+		{"10.0.0.3", off, off, false, false, []string{"LinkSetARPOn"}},
+	}
+	for _, c := range cases {
+		fake.calls = nil
+		_, err := srv.AddTunnel(ctx, &tunnel.AddTunnelRequest{TunDestIP: c.ip, NoArp: c.noArp, Multicast: c.multicast})
+		if !assert.NoError(t, err, c.ip) {
+			continue
+		}
+		var flagCalls []string
+		for _, call := range fake.calls {
+			if op := strings.Fields(call)[0]; strings.HasPrefix(op, "LinkSetARP") {
+				flagCalls = append(flagCalls, op)
+			}
+		}
+		assert.Equal(t, c.calls, flagCalls, c.ip)
+		info, err := srv.GetTunnel(ctx, &tunnel.GetTunnelRequest{TunDestIP: c.ip})
+		if assert.NoError(t, err, c.ip) {
+			assert.Equal(t, c.wantNoArp, info.GetNoArp(), c.ip)
+			assert.Equal(t, c.wantMcast, info.GetMulticast(), c.ip)
+			assert.True(t, info.GetAdminUp(), c.ip)
+		}
+	}
+	bad := []*tunnel.AddTunnelRequest{
+		{TunDestIP: "10.0.0.4", NoArp: tunnel.LinkFlag(7)},
+		{TunDestIP: "10.0.0.4", Multicast: tunnel.LinkFlag(-1)},
+		{TunDestIP: "10.0.0.4", Multicast: off, MulticastRoutes: []string{"224.0.0.5"}},
+	}
+	for _, req := range bad {
+		_, err := srv.AddTunnel(ctx, req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), req.String())
+	}
+	assert.NotContains(t, fake.names(), mustTunnelName(net.ParseIP("10.0.0.4")))
+}
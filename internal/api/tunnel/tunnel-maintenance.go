@@ -0,0 +1,230 @@
+package tunnel
+
+import (
+	"context"
+	"net"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/gradusp/go-platform/logger"
+	"github.com/pkg/errors"
+	"github.com/vishvananda/netlink"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//maintenance quiesces the service for node maintenance without restart
+//  - while it is on every mutating RPC fails with FailedPrecondition as in read-only mode; the ones already
+//    waiting for a tunnel lock fail as they get it, and entering the mode waits for those holding a lock
+//  - tunnels SetMaintenanceMode brings down are labeled so leaving the mode brings up only them, even after restart
+//  - with 'WithMaintenanceStateFile' the mode itself survives restart: the file exists while the mode is on
+type maintenance struct {
+	op sync.Mutex //serializes SetMaintenanceMode calls
+	mu sync.Mutex //guards 'on'
+	on bool
+}
+
+//inMaintenance tells if maintenance mode is on
+func (srv *tunnelService) inMaintenance() bool {
+	srv.maint.mu.Lock()
+	defer srv.maint.mu.Unlock()
+	return srv.maint.on
+}
+
+//setMaintenance turns maintenance mode on or off
+func (srv *tunnelService) setMaintenance(on bool) {
+	srv.maint.mu.Lock()
+	defer srv.maint.mu.Unlock()
+	srv.maint.on = on
+}
+
+//loadMaintenance restores maintenance mode from state file at startup
+func (srv *tunnelService) loadMaintenance(ctx context.Context) {
+	if len(srv.maintenanceFile) == 0 {
+		return
+	}
+	_, err := os.Stat(srv.maintenanceFile)
+	switch {
+	case err == nil:
+		srv.maint.on = true
+		logger.FromContext(ctx).Warnf("maintenance mode is restored from '%s'", srv.maintenanceFile)
+	case !os.IsNotExist(err):
+		logger.FromContext(ctx).Warnf("maintenance mode state is not read: %v", err)
+	}
+}
+
+//saveMaintenance keeps maintenance mode in state file: the file exists while the mode is on
+func (srv *tunnelService) saveMaintenance(on bool) error {
+	if len(srv.maintenanceFile) == 0 {
+		return nil
+	}
+	if !on {
+		if err := os.Remove(srv.maintenanceFile); err != nil && !os.IsNotExist(err) {
+			return errors.Wrap(err, "os.Remove")
+		}
+		return nil
+	}
+	tmp := srv.maintenanceFile + ".tmp"
+	if err := os.WriteFile(tmp, []byte(time.Now().UTC().Format(time.RFC3339)+"\n"), 0644); err != nil {
+		return errors.Wrap(err, "os.WriteFile")
+	}
+	return errors.Wrap(os.Rename(tmp, srv.maintenanceFile), "os.Rename")
+}
+
+//SetMaintenanceMode impl tunnel service
+func (srv *tunnelService) SetMaintenanceMode(ctx context.Context, req *tunnel.SetMaintenanceModeRequest) (resp *tunnel.SetMaintenanceModeResponse, err error) {
+	if srv.readOnly {
+		return nil, status.Error(codes.FailedPrecondition, "service is read-only")
+	}
+	span := srv.spanOf(ctx)
+	span.SetAttributes(attribute.Bool("enabled", req.GetEnabled()))
+
+	ctx, cancel := withDefaultDeadline(ctx, srv.writeTimeout)
+	defer cancel()
+
+	var leave func()
+	if leave, err = srv.enter(ctx); err != nil {
+		return nil, err
+	}
+	defer func() {
+		leave()
+		err = srv.correctError(err)
+	}()
+
+	switch {
+	case req.GetEnabled() && req.GetBringUp():
+		return nil, status.Errorf(codes.InvalidArgument, "'bringUp': is for leaving maintenance mode")
+	case !req.GetEnabled() && req.GetBringDown():
+		return nil, status.Errorf(codes.InvalidArgument, "'bringDown': is for entering maintenance mode")
+	}
+	srv.maint.op.Lock()
+	defer srv.maint.op.Unlock()
+	resp = &tunnel.SetMaintenanceModeResponse{Enabled: srv.inMaintenance()}
+	if req.GetEnabled() {
+		if err = srv.saveMaintenance(true); err != nil {
+			return nil, err
+		}
+		srv.setMaintenance(true)
+		resp.Enabled = true
+		//mutations which passed 'denyMutation' before the mode is on finish first
+		if err = srv.tunnelLocks.waitIdle(ctx); err != nil {
+			return nil, err
+		}
+		if req.GetBringDown() {
+			resp.Changed, err = srv.maintenanceBringDown(ctx)
+		}
+	} else {
+		if resp.Changed, err = srv.maintenanceRelease(ctx, req.GetBringUp()); err != nil {
+			return nil, err
+		}
+		if err = srv.saveMaintenance(false); err != nil {
+			return nil, err
+		}
+		srv.setMaintenance(false)
+		resp.Enabled = false
+	}
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(resp.Changed)
+	return resp, nil
+}
+
+//maintenanceBringDown sets admin-down managed tunnels which are up, not quarantined and not cordoned and labels them
+func (srv *tunnelService) maintenanceBringDown(ctx context.Context) ([]string, error) {
+	var names []string
+	err := srv.enumLinks(func(nl netlink.Link) error {
+		if isOwnedLink(nl) && nl.Attrs().Flags&net.FlagUp != 0 && !isQuarantined(nl) && !isCordoned(nl) {
+			names = append(names, nl.Attrs().Name)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	var changed []string
+	for _, name := range names {
+		err = srv.withTunnelLocked(ctx, name, func(link netlink.Link) error {
+			if e := srv.nl.LinkSetDown(link); e != nil {
+				return errors.Wrapf(e, "netlink.LinkSetDown(%s)", name)
+			}
+			labels := labelsOf(link)
+			labels[labelMaintenanceDown] = ""
+			if e := setLinkLabels(srv.nl, link, labels); e != nil {
+				return e
+			}
+			srv.notifyChange(ctx, webhookActionSetDown, name, linkRemote(link))
+			return nil
+		})
+		if err != nil {
+			return changed, err
+		}
+		changed = append(changed, name)
+	}
+	return changed, nil
+}
+
+//maintenanceRelease drops maintenance labels from tunnels brought down for maintenance and brings them up if 'up'
+func (srv *tunnelService) maintenanceRelease(ctx context.Context, up bool) ([]string, error) {
+	var names []string
+	err := srv.enumLinks(func(nl netlink.Link) error {
+		if _, ok := labelsOf(nl)[labelMaintenanceDown]; ok && isOwnedLink(nl) {
+			names = append(names, nl.Attrs().Name)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	var changed []string
+	for _, name := range names {
+		err = srv.withTunnelLocked(ctx, name, func(link netlink.Link) error {
+			labels := labelsOf(link)
+			delete(labels, labelMaintenanceDown)
+			if e := setLinkLabels(srv.nl, link, labels); e != nil {
+				return e
+			}
+			if !up {
+				return nil
+			}
+			if e := srv.nl.LinkSetUp(link); e != nil {
+				return errors.Wrapf(e, "netlink.LinkSetUp(%s)", name)
+			}
+			srv.notifyChange(ctx, webhookActionSetUp, name, linkRemote(link))
+			changed = append(changed, name)
+			return nil
+		})
+		if err != nil {
+			return changed, err
+		}
+	}
+	return changed, nil
+}
+
+//withTunnelLocked runs 'f' on fresh link of tunnel 'name' under its lock; tunnel removed meanwhile is skipped
+func (srv *tunnelService) withTunnelLocked(ctx context.Context, name string, f func(netlink.Link) error) error {
+	unlock, err := srv.tunnelLocks.lock(ctx, name)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	link, err := lookupTunnel(srv.nl, name)
+	if status.Code(err) == codes.NotFound {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return f(link)
+}
+
+//linkRemote gets remote address of tunnel link; empty if link is not IPIP tunnel
+func linkRemote(link netlink.Link) string {
+	if t, ok := link.(*netlink.Iptun); ok && t.Remote != nil {
+		return t.Remote.String()
+	}
+	return ""
+}
@@ -0,0 +1,99 @@
+package tunnel
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/stretchr/testify/assert"
+	"github.com/vishvananda/netlink"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_MaintenanceMode(t *testing.T) {
+	ctx := context.Background()
+	stateFile := filepath.Join(t.TempDir(), "maintenance")
+	noLinks := func() ([]netlink.Link, error) { return nil, nil }
+	srv := NewTunnelService(ctx, WithMaintenanceStateFile(stateFile)).(*tunnelService)
+	srv.linkList = noLinks
+
+	_, err := srv.SetMaintenanceMode(ctx, &tunnel.SetMaintenanceModeRequest{Enabled: true, BringUp: true})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	resp, err := srv.SetMaintenanceMode(ctx, &tunnel.SetMaintenanceModeRequest{Enabled: true, BringDown: true})
+	if assert.NoError(t, err) {
+		assert.True(t, resp.GetEnabled())
+		assert.Empty(t, resp.GetChanged())
+	}
+	assert.FileExists(t, stateFile)
+	_, err = srv.AddTunnel(ctx, &tunnel.AddTunnelRequest{TunDestIP: "1.1.1.1"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	info, err := srv.GetServiceInfo(ctx, nil)
+	if assert.NoError(t, err) {
+		assert.True(t, info.GetMaintenance())
+	}
+
+	srv = NewTunnelService(ctx, WithMaintenanceStateFile(stateFile)).(*tunnelService)
+	srv.linkList = noLinks
+	assert.True(t, srv.inMaintenance(), "maintenance mode survives restart")
+	resp, err = srv.SetMaintenanceMode(ctx, &tunnel.SetMaintenanceModeRequest{BringUp: true})
+	if assert.NoError(t, err) {
+		assert.False(t, resp.GetEnabled())
+	}
+	assert.NoFileExists(t, stateFile)
+	assert.NoError(t, srv.denyMutation())
+
+	srv = NewTunnelService(ctx, WithReadOnly()).(*tunnelService)
+	_, err = srv.SetMaintenanceMode(ctx, &tunnel.SetMaintenanceModeRequest{Enabled: true})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func Test_MaintenanceModeStopsWaitingMutations(t *testing.T) {
+	ctx := context.Background()
+	name := mustTunnelName(net.ParseIP("1.1.1.1"))
+	srv := NewTunnelService(ctx, WithMaxConcurrency(4)).(*tunnelService)
+	fake := useFakeNetlink(srv, &netlink.Iptun{LinkAttrs: netlink.LinkAttrs{
+		Name: name, Flags: net.FlagUp, Alias: aliasLabelsPrefix,
+	}})
+	waiters := func() int {
+		srv.tunnelLocks.mu.Lock()
+		defer srv.tunnelLocks.mu.Unlock()
+		if l := srv.tunnelLocks.locks[name]; l != nil {
+			return l.refs
+		}
+		return 0
+	}
+
+	unlock, err := srv.tunnelLocks.lock(ctx, name)
+	if !assert.NoError(t, err) {
+		return
+	}
+	mutated := make(chan error, 1)
+	go func() {
+		_, e := srv.SetTunnelState(ctx, &tunnel.SetTunnelStateRequest{TunDestIP: "1.1.1.1"})
+		mutated <- e
+	}()
+	assert.Eventually(t, func() bool { return waiters() == 2 }, time.Second, time.Millisecond,
+		"SetTunnelState passed 'denyMutation' and waits for the lock")
+
+	entered := make(chan error, 1)
+	go func() {
+		_, e := srv.SetMaintenanceMode(ctx, &tunnel.SetMaintenanceModeRequest{Enabled: true})
+		entered <- e
+	}()
+	assert.Eventually(t, srv.inMaintenance, time.Second, time.Millisecond)
+	select {
+	case <-entered:
+		t.Fatal("entering maintenance mode does not wait for the lock holder")
+	case <-time.After(10 * time.Millisecond):
+	}
+	unlock()
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(<-mutated))
+	assert.NoError(t, <-entered)
+	assert.NotContains(t, fake.calls, "LinkSetDown "+name)
+}
@@ -0,0 +1,123 @@
+package tunnel
+
+import (
+	"sort"
+	"sync/atomic"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/vishvananda/netlink"
+)
+
+const (
+	//DefaultTunnelMetricsMaxSeries default count of tunnels exported with their own series
+	DefaultTunnelMetricsMaxSeries = 500
+
+	//tunnelMetricsOther name label of series which aggregates tunnels above the cap
+	tunnelMetricsOther = "_other"
+)
+
+//TunnelMetrics per tunnel metrics labeled by tunnel name and remote; they are read on scrape from managed tunnel links.
+//Each tunnel costs a series per metric so dense hosts may overload Prometheus; the count of tunnels
+//with their own series is capped by 'maxSeries': tunnels above the cap (by name order) are summed into
+//one series with name '_other' and empty remote, and their count is exported as 'tunnel_link_over_cap'.
+//Counters of '_other' may go backwards when tunnels come or go below the cap
+type TunnelMetrics struct {
+	up        *prometheus.Desc
+	rxBytes   *prometheus.Desc
+	txBytes   *prometheus.Desc
+	overCap   *prometheus.Desc
+	maxSeries int
+	src       atomic.Value
+}
+
+var _ prometheus.Collector = (*TunnelMetrics)(nil)
+
+//NewTunnelMetrics creates per tunnel metrics capped by 'maxSeries' tunnels (zero or negative means
+//'DefaultTunnelMetricsMaxSeries'); register them and pass to 'WithTunnelMetrics'
+func NewTunnelMetrics(maxSeries int) *TunnelMetrics {
+	const (
+		namespace = "tunnel"
+		subsystem = "link"
+	)
+	if maxSeries <= 0 {
+		maxSeries = DefaultTunnelMetricsMaxSeries
+	}
+	labels := []string{"name", "remote"}
+	return &TunnelMetrics{
+		up: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "up"),
+			"tunnel is up (1) or down (0); for '_other' count of tunnels up", labels, nil),
+		rxBytes: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "receive_bytes_total"),
+			"bytes received by tunnel", labels, nil),
+		txBytes: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "transmit_bytes_total"),
+			"bytes transmitted by tunnel", labels, nil),
+		overCap: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "over_cap"),
+			"tunnels above series cap aggregated into '_other'", nil, nil),
+		maxSeries: maxSeries,
+	}
+}
+
+//Describe impl prometheus.Collector
+func (m *TunnelMetrics) Describe(ch chan<- *prometheus.Desc) {
+	ch <- m.up
+	ch <- m.rxBytes
+	ch <- m.txBytes
+	ch <- m.overCap
+}
+
+//Collect impl prometheus.Collector
+func (m *TunnelMetrics) Collect(ch chan<- prometheus.Metric) {
+	srv, _ := m.src.Load().(*tunnelService)
+	if srv == nil {
+		return
+	}
+	var links []netlink.Link
+	err := srv.enumLinks(func(nl netlink.Link) error {
+		if isOwnedLink(nl) {
+			links = append(links, nl)
+		}
+		return nil
+	})
+	if err != nil {
+		return
+	}
+	sort.Slice(links, func(i, j int) bool {
+		return links[i].Attrs().Name < links[j].Attrs().Name
+	})
+	var other struct {
+		count, up int
+		rx, tx    uint64
+	}
+	for i, link := range links {
+		var up int
+		if operStateMatches(link, tunnel.OperStateFilter_OPER_STATE_UP) {
+			up = 1
+		}
+		var rx, tx uint64
+		if st := link.Attrs().Statistics; st != nil {
+			rx, tx = st.RxBytes, st.TxBytes
+		}
+		if i >= m.maxSeries {
+			other.count++
+			other.up += up
+			other.rx += rx
+			other.tx += tx
+			continue
+		}
+		m.collectTunnel(ch, link.Attrs().Name, linkRemote(link), float64(up), rx, tx)
+	}
+	if other.count > 0 {
+		m.collectTunnel(ch, tunnelMetricsOther, "", float64(other.up), other.rx, other.tx)
+	}
+	ch <- prometheus.MustNewConstMetric(m.overCap, prometheus.GaugeValue, float64(other.count))
+}
+
+func (m *TunnelMetrics) collectTunnel(ch chan<- prometheus.Metric, name, remote string, up float64, rx, tx uint64) {
+	ch <- prometheus.MustNewConstMetric(m.up, prometheus.GaugeValue, up, name, remote)
+	ch <- prometheus.MustNewConstMetric(m.rxBytes, prometheus.CounterValue, float64(rx), name, remote)
+	ch <- prometheus.MustNewConstMetric(m.txBytes, prometheus.CounterValue, float64(tx), name, remote)
+}
@@ -0,0 +1,61 @@
+package tunnel
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/vishvananda/netlink"
+)
+
+func Test_TunnelMetrics(t *testing.T) {
+	ctx := context.Background()
+	tm := NewTunnelMetrics(2)
+	srv := NewTunnelService(ctx, WithTunnelMetrics(tm)).(*tunnelService)
+	ip := func(s string) net.IP { return net.ParseIP(s).To4() }
+	tun := func(name, remote string, up bool, rx, tx uint64) netlink.Link {
+		a := netlink.LinkAttrs{
+			Name:       name,
+			Alias:      aliasLabelsPrefix,
+			OperState:  netlink.OperDown,
+			Statistics: &netlink.LinkStatistics{RxBytes: rx, TxBytes: tx},
+		}
+		if up {
+			a.OperState = netlink.OperUp
+		}
+		return &netlink.Iptun{LinkAttrs: a, Remote: ip(remote)}
+	}
+	srv.linkList = func() ([]netlink.Link, error) {
+		return []netlink.Link{
+			tun("tun4", "4.4.4.4", true, 1, 1),
+			tun("tun1", "1.1.1.1", true, 10, 20),
+			tun("tun2", "2.2.2.2", false, 30, 40),
+			tun("tun3", "3.3.3.3", true, 50, 60),
+			tun("tun99", "9.9.9.9", true, 1, 1),
+		}, nil
+	}
+	err := testutil.CollectAndCompare(tm, strings.NewReader(`
+# HELP tunnel_link_over_cap tunnels above series cap aggregated into '_other'
+# TYPE tunnel_link_over_cap gauge
+tunnel_link_over_cap 3
+# HELP tunnel_link_receive_bytes_total bytes received by tunnel
+# TYPE tunnel_link_receive_bytes_total counter
+tunnel_link_receive_bytes_total{name="_other",remote=""} 52
+tunnel_link_receive_bytes_total{name="tun1",remote="1.1.1.1"} 10
+tunnel_link_receive_bytes_total{name="tun2",remote="2.2.2.2"} 30
+# HELP tunnel_link_transmit_bytes_total bytes transmitted by tunnel
+# TYPE tunnel_link_transmit_bytes_total counter
+tunnel_link_transmit_bytes_total{name="_other",remote=""} 62
+tunnel_link_transmit_bytes_total{name="tun1",remote="1.1.1.1"} 20
+tunnel_link_transmit_bytes_total{name="tun2",remote="2.2.2.2"} 40
+# HELP tunnel_link_up tunnel is up (1) or down (0); for '_other' count of tunnels up
+# TYPE tunnel_link_up gauge
+tunnel_link_up{name="_other",remote=""} 3
+tunnel_link_up{name="tun1",remote="1.1.1.1"} 1
+tunnel_link_up{name="tun2",remote="2.2.2.2"} 0
+`))
+	assert.NoError(t, err)
+}
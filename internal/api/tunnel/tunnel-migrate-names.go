@@ -0,0 +1,143 @@
+package tunnel
+
+import (
+	"context"
+	"net"
+	"sort"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/pkg/errors"
+	"github.com/vishvananda/netlink"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/status"
+)
+
+//MigrateNames impl tunnel service
+//  - only managed IPIP tunnels are renamed; unmanaged interfaces are never touched, even acknowledged ones
+//  - cordoned tunnels are left under their old names and reported as skipped
+//  - each tunnel is renamed under locks of its old and new names
+//  - firewall rules (MSS clamping, egress mark) and policy rules referencing old name are moved onto new name
+func (srv *tunnelService) MigrateNames(ctx context.Context, _ *tunnel.MigrateNamesRequest) (resp *tunnel.MigrateNamesResponse, err error) {
+	if err = srv.denyMutation(); err != nil {
+		return nil, err
+	}
+	if err = srv.denyBulkMutation(); err != nil {
+		return nil, err
+	}
+	span := srv.spanOf(ctx)
+
+	ctx, cancel := withDefaultDeadline(ctx, srv.writeTimeout)
+	defer cancel()
+
+	var leave func()
+	if leave, err = srv.enter(ctx); err != nil {
+		return nil, err
+	}
+	defer func() {
+		leave()
+		err = srv.correctError(err)
+	}()
+
+	var migrations []*tunnel.NameMigration
+	err = srv.enumLinks(func(nl netlink.Link) error {
+		if t, ok := nl.(*netlink.Iptun); ok && t.Remote.To4() != nil && isOwnedLink(nl) {
+			m := &tunnel.NameMigration{From: nl.Attrs().Name}
+			var e error
+			if m.To, e = TunnelNameForIP(t.Remote); e != nil {
+				m.Error = status.Convert(e).Message()
+			} else if m.From != m.To && isCordoned(nl) {
+				m.Skipped = true
+			}
+			migrations = append(migrations, m)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].From < migrations[j].From
+	})
+	resp = new(tunnel.MigrateNamesResponse)
+	for _, m := range migrations {
+		if len(m.Error) == 0 && !m.Skipped && m.From != m.To {
+			srv.addSpanDbgEvent(ctx, span, "migrateName",
+				trace.WithAttributes(
+					attribute.String("from", m.From),
+					attribute.String("to", m.To),
+				))
+			if m.Renamed, err = srv.migrateName(ctx, m.From, m.To); err != nil {
+				if ctx.Err() != nil {
+					return nil, err
+				}
+				m.Error, err = err.Error(), nil
+			}
+		}
+		resp.Results = append(resp.Results, m)
+	}
+	return resp, nil
+}
+
+//migrateName renames tunnel 'from' to 'to' under their locks moving firewall and policy rules along;
+//'renamed' is set once the link is renamed even if rules are not completely moved
+func (srv *tunnelService) migrateName(ctx context.Context, from, to string) (renamed bool, err error) {
+	var unlock func()
+	if unlock, err = srv.lockForMutation(ctx, from, to); err != nil {
+		return false, err
+	}
+	defer unlock()
+
+	var link netlink.Link
+	if link, err = lookupTunnel(srv.nl, from); err != nil {
+		return false, err
+	}
+	labels := labelsOf(link)
+	var installed linkLabels
+	if installed, err = srv.addTunnelFwRules(ctx, to, labels); err != nil {
+		_ = srv.delTunnelFwRules(ctx, to, installed)
+		return false, err
+	}
+	if err = renameLink(srv.nl, link, to); err != nil {
+		_ = srv.delTunnelFwRules(ctx, to, installed)
+		return false, err
+	}
+	leftover := func(e error) error {
+		return errors.Wrapf(e, "tunnel is renamed to '%s' but rules of '%s' are not completely moved", to, from)
+	}
+	if _, err = migrateRules(srv.nl, from, to); err != nil {
+		return true, leftover(err)
+	}
+	if err = srv.delTunnelFwRules(ctx, from, labels); err != nil {
+		return true, leftover(err)
+	}
+	return true, nil
+}
+
+//renameLink renames link; the link is brought down for renaming and then up if it was up
+func renameLink(nl netlinkOps, link netlink.Link, newName string) error {
+	oldName := link.Attrs().Name
+	if _, err := nl.LinkByName(newName); err == nil {
+		return errors.Errorf("link '%s' already exists", newName)
+	} else if !errors.As(err, new(netlink.LinkNotFoundError)) {
+		return errors.Wrapf(err, "netlink.LinkByName(%s)", newName)
+	}
+	wasUp := link.Attrs().Flags&net.FlagUp != 0
+	if wasUp {
+		if err := nl.LinkSetDown(link); err != nil {
+			return errors.Wrapf(err, "netlink.LinkSetDown(%s)", oldName)
+		}
+	}
+	if err := nl.LinkSetName(link, newName); err != nil {
+		if wasUp {
+			_ = nl.LinkSetUp(link)
+		}
+		return errors.Wrapf(err, "netlink.LinkSetName(%s, %s)", oldName, newName)
+	}
+	if wasUp {
+		if err := nl.LinkSetUp(link); err != nil {
+			return errors.Wrapf(err, "netlink.LinkSetUp(%s)", newName)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package tunnel
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/stretchr/testify/assert"
+	"github.com/vishvananda/netlink"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func Test_MigrateNames(t *testing.T) {
+	ctx := context.Background()
+	env, calls := recordCommands(t, "iptables")
+	srv := NewTunnelService(ctx, WithExecEnv(env)).(*tunnelService)
+	labels := linkLabels{labelMss: "1400", labelMssBackend: FirewallBackendIptables}
+	fake := useFakeNetlink(srv,
+		&netlink.Iptun{
+			LinkAttrs: netlink.LinkAttrs{Name: "tun1", Alias: labels.alias(), Flags: net.FlagUp},
+			Remote:    net.ParseIP("10.0.0.1"),
+		},
+		&netlink.Iptun{
+			LinkAttrs: netlink.LinkAttrs{Name: "tun167772162", Alias: labels.alias()},
+			Remote:    net.ParseIP("10.0.0.2"),
+		},
+		&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun7"}, Remote: net.ParseIP("3.3.3.3")},
+	)
+	rule := netlink.NewRule()
+	rule.IifName, rule.Table, rule.Priority = "tun1", 100, 1000
+	assert.NoError(t, fake.RuleAdd(rule))
+
+	_, err := srv.MigrateNames(ctx, &tunnel.MigrateNamesRequest{})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = srv.AcknowledgeUnmanaged(ctx, nil)
+	assert.NoError(t, err)
+
+	newName := mustTunnelName(net.ParseIP("10.0.0.1"))
+	for i := 0; i < 2; i++ {
+		resp, err := srv.MigrateNames(ctx, &tunnel.MigrateNamesRequest{})
+		if !assert.NoError(t, err) {
+			return
+		}
+		//unmanaged 'tun7' is neither renamed nor reported even after it is acknowledged
+		want := []*tunnel.NameMigration{
+			{From: "tun1", To: newName, Renamed: true},
+			{From: "tun167772162", To: "tun167772162"},
+		}
+		if i > 0 {
+			want = []*tunnel.NameMigration{
+				{From: "tun167772161", To: "tun167772161"},
+				{From: "tun167772162", To: "tun167772162"},
+			}
+		}
+		assert.Len(t, resp.GetResults(), len(want))
+		for j := range want {
+			if j < len(resp.GetResults()) {
+				assert.True(t, proto.Equal(want[j], resp.GetResults()[j]), resp.GetResults()[j].String())
+			}
+		}
+	}
+	assert.ElementsMatch(t, []string{newName, "tun167772162", "tun7"}, fake.names())
+	link, err := fake.LinkByName(newName)
+	if assert.NoError(t, err) {
+		assert.NotZero(t, link.Attrs().Flags&net.FlagUp, "renamed link is brought up again")
+	}
+	assert.Equal(t, []string{
+		"iptables " + strings.Join(iptablesMssArgs("-A", newName, "1400"), " "),
+		"iptables " + strings.Join(iptablesMssArgs("-D", "tun1", "1400"), " "),
+	}, calls())
+	rules, err := fake.RuleList(netlink.FAMILY_V4)
+	if assert.NoError(t, err) && assert.Len(t, rules, 1) {
+		assert.Equal(t, newName, rules[0].IifName)
+	}
+}
+
+func Test_MigrateNamesSkipsCordoned(t *testing.T) {
+	ctx := context.Background()
+	srv := NewTunnelService(ctx).(*tunnelService)
+	fake := useFakeNetlink(srv, &netlink.Iptun{
+		LinkAttrs: netlink.LinkAttrs{Name: "tun1", Alias: aliasLabelsPrefix + labelCordoned},
+		Remote:    net.ParseIP("10.0.0.1"),
+	})
+	resp, err := srv.MigrateNames(ctx, &tunnel.MigrateNamesRequest{})
+	if !assert.NoError(t, err) || !assert.Len(t, resp.GetResults(), 1) {
+		return
+	}
+	want := &tunnel.NameMigration{From: "tun1", To: mustTunnelName(net.ParseIP("10.0.0.1")), Skipped: true}
+	assert.True(t, proto.Equal(want, resp.GetResults()[0]), resp.GetResults()[0].String())
+	assert.Equal(t, []string{"tun1"}, fake.names())
+	assert.NotContains(t, fake.calls, "LinkSetName tun1")
+}
@@ -0,0 +1,74 @@
+package tunnel
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	//DefaultModprobePath default modprobe binary
+	DefaultModprobePath = "modprobe"
+
+	//DefaultSysModulePath default sysfs directory of loaded and built-in kernel modules
+	DefaultSysModulePath = "/sys/module"
+
+	//ipipModule kernel module of IPIP tunnels
+	ipipModule = "ipip"
+)
+
+//moduleLoaded checks if kernel module is loaded or built-in
+func (srv *tunnelService) moduleLoaded(module string) (bool, error) {
+	p := filepath.Join(srv.sysModulePath, module)
+	if _, err := os.Stat(p); os.IsNotExist(err) {
+		return false, nil
+	} else if err != nil {
+		return false, errors.Wrapf(err, "os.Stat('%s')", p)
+	}
+	return true, nil
+}
+
+//ensureModule loads kernel module unless it is already there; it is off unless 'WithModuleLoad' is given
+//  - modprobe is retried up to 'moduleLoadAttempts' times with linear backoff, e.g. because of udev races on boot
+//  - every attempt is a span event and an exec metric of 'modprobe'
+//  - waiting between attempts respects the operation context
+//  - failure of all attempts is FailedPrecondition with module name and the last error
+func (srv *tunnelService) ensureModule(ctx context.Context, span trace.Span, module string) error {
+	if srv.moduleLoadAttempts <= 0 {
+		return nil
+	}
+	if ok, err := srv.moduleLoaded(module); ok || err != nil {
+		return err
+	}
+	var lastErr error
+	for attempt := 1; ; attempt++ {
+		srv.addSpanDbgEvent(ctx, span, "modprobe",
+			trace.WithAttributes(
+				attribute.String("module", module),
+				attribute.String("attempt", strconv.Itoa(attempt)),
+			))
+		if _, lastErr = srv.execExternal(ctx, nil, nil, srv.modprobePath, module); lastErr == nil {
+			return nil
+		}
+		if ctx.Err() != nil || attempt >= srv.moduleLoadAttempts {
+			break
+		}
+		select {
+		case <-ctx.Done():
+		case <-time.After(srv.moduleLoadBackoff * time.Duration(attempt)):
+		}
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return status.Errorf(codes.FailedPrecondition, "kernel module '%s' is not loaded after %v attempt(s): %v",
+		module, srv.moduleLoadAttempts, lastErr)
+}
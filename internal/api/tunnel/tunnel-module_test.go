@@ -0,0 +1,47 @@
+package tunnel
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_EnsureModule(t *testing.T) {
+	ctx := context.Background()
+	em := NewExecMetrics()
+	srv := NewTunnelService(ctx, WithExecMetrics(em)).(*tunnelService)
+	srv.sysModulePath = t.TempDir()
+	srv.modprobePath = "false"
+	span := srv.spanOf(ctx)
+
+	assert.NoError(t, srv.ensureModule(ctx, span, ipipModule))
+	assert.Equal(t, 0, testutil.CollectAndCount(em.runs))
+
+	srv.moduleLoadAttempts, srv.moduleLoadBackoff = 3, time.Millisecond
+	err := srv.ensureModule(ctx, span, ipipModule)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "'ipip'")
+	assert.Equal(t, float64(3), testutil.ToFloat64(em.runs.WithLabelValues("false", "1")))
+
+	srv.modprobePath = "true"
+	assert.NoError(t, srv.ensureModule(ctx, span, ipipModule))
+	assert.Equal(t, float64(1), testutil.ToFloat64(em.runs.WithLabelValues("true", "0")))
+
+	assert.NoError(t, os.Mkdir(filepath.Join(srv.sysModulePath, ipipModule), 0755))
+	srv.modprobePath = "false"
+	assert.NoError(t, srv.ensureModule(ctx, span, ipipModule))
+	assert.Equal(t, float64(3), testutil.ToFloat64(em.runs.WithLabelValues("false", "1")))
+
+	ctx1, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.NoError(t, os.Remove(filepath.Join(srv.sysModulePath, ipipModule)))
+	srv.moduleLoadBackoff = time.Hour
+	assert.Equal(t, context.Canceled, srv.ensureModule(ctx1, span, ipipModule))
+}
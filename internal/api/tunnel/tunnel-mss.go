@@ -0,0 +1,92 @@
+package tunnel
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	mssPmtu     = "pmtu"
+	minMssValue = 536
+	maxMssValue = 65495
+
+	nftChainForward = "forward"
+)
+
+//mssOfRequest validates MSS clamping request arguments and gets MSS label value; empty if no clamping
+func mssOfRequest(req *tunnel.AddTunnelRequest) (string, error) {
+	v := req.GetMssValue()
+	if !req.GetClampMss() {
+		if v != 0 {
+			return "", status.Errorf(codes.InvalidArgument, "'mssValue': is set without 'clampMss'")
+		}
+		return "", nil
+	}
+	if v == 0 {
+		return mssPmtu, nil
+	}
+	if v < minMssValue || v > maxMssValue {
+		return "", status.Errorf(codes.InvalidArgument, "'mssValue': %v is out of range [%v, %v]", v, minMssValue, maxMssValue)
+	}
+	return strconv.FormatUint(uint64(v), 10), nil
+}
+
+func iptablesMssArgs(op, tunnelName, mss string) []string {
+	ret := []string{
+		"-t", "mangle", op, "FORWARD", "-o", tunnelName,
+		"-p", "tcp", "--tcp-flags", "SYN,RST", "SYN",
+		"-m", "comment", "--comment", fwRuleComment(tunnelName),
+		"-j", "TCPMSS",
+	}
+	if mss == mssPmtu {
+		return append(ret, "--clamp-mss-to-pmtu")
+	}
+	return append(ret, "--set-mss", mss)
+}
+
+//addMssClamp installs MSS clamping rule for traffic leaving through the tunnel
+func (srv *tunnelService) addMssClamp(ctx context.Context, backend, tunnelName, mss string) error {
+	switch backend {
+	case FirewallBackendIptables:
+		_, err := srv.execExternal(ctx, nil, nil, FirewallBackendIptables, iptablesMssArgs("-A", tunnelName, mss)...)
+		return err
+	case FirewallBackendNft:
+		setTo := mss
+		if mss == mssPmtu {
+			setTo = "rt mtu"
+		}
+		return srv.nftAddRule(ctx, nftChainForward, "forward",
+			"oifname", strconv.Quote(tunnelName), "tcp", "flags", "syn",
+			"tcp", "option", "maxseg", "size", "set", setTo,
+			"comment", strconv.Quote(fwRuleComment(tunnelName)))
+	}
+	return errors.Errorf("unsupported firewall backend '%s'", backend)
+}
+
+//hasMssClamp checks if MSS clamping rule of the tunnel is installed
+func (srv *tunnelService) hasMssClamp(ctx context.Context, backend, tunnelName, mss string) (bool, error) {
+	switch backend {
+	case FirewallBackendIptables:
+		return srv.iptablesHasRule(ctx, iptablesMssArgs("-C", tunnelName, mss))
+	case FirewallBackendNft:
+		return srv.nftHasTunnelRule(ctx, nftChainForward, tunnelName)
+	}
+	return false, errors.Errorf("unsupported firewall backend '%s'", backend)
+}
+
+//delMssClamp removes MSS clamping rule of the tunnel
+func (srv *tunnelService) delMssClamp(ctx context.Context, backend, tunnelName, mss string) error {
+	switch backend {
+	case FirewallBackendIptables:
+		_, err := srv.execExternal(ctx, nil, nil, FirewallBackendIptables, iptablesMssArgs("-D", tunnelName, mss)...)
+		return err
+	case FirewallBackendNft:
+		return srv.nftDelTunnelRules(ctx, nftChainForward, tunnelName)
+	}
+	return errors.Errorf("unsupported firewall backend '%s'", backend)
+}
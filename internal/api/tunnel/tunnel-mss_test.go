@@ -0,0 +1,37 @@
+package tunnel
+
+import (
+	"testing"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_MssOfRequest(t *testing.T) {
+	cases := []struct {
+		clamp  bool
+		value  uint32
+		expect string
+		code   codes.Code
+	}{
+		{false, 0, "", codes.OK},
+		{false, 1400, "", codes.InvalidArgument},
+		{true, 0, mssPmtu, codes.OK},
+		{true, 1400, "1400", codes.OK},
+		{true, minMssValue - 1, "", codes.InvalidArgument},
+		{true, maxMssValue + 1, "", codes.InvalidArgument},
+	}
+	for i := range cases {
+		c := cases[i]
+		mss, err := mssOfRequest(&tunnel.AddTunnelRequest{ClampMss: c.clamp, MssValue: c.value})
+		assert.Equalf(t, c.code, status.Code(err), "case #%v", i)
+		assert.Equalf(t, c.expect, mss, "case #%v", i)
+	}
+	args := iptablesMssArgs("-A", "tun1", mssPmtu)
+	assert.Equal(t, "--clamp-mss-to-pmtu", args[len(args)-1])
+	args = iptablesMssArgs("-D", "tun1", "1400")
+	assert.Equal(t, []string{"--set-mss", "1400"}, args[len(args)-2:])
+	assert.Contains(t, args, "-D")
+}
@@ -0,0 +1,42 @@
+package tunnel
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	//minIPv4Mtu the least MTU IPv4 link may have (RFC 791)
+	minIPv4Mtu = 68
+)
+
+//tunnelTypeOverhead outer header size in bytes each tunnel type adds to a packet;
+//header options and underlay link-layer framing are not accounted
+//  - ipip: outer IPv4 header without options, 20 bytes
+var tunnelTypeOverhead = map[string]uint32{
+	tunnelTypeIpip: 20,
+}
+
+//payloadMtuOf gets effective payload MTU of tunnel: interface MTU less outer header of tunnel type;
+//MSS clamps should be derived from interface MTU or from payload MTU but not subtract overhead twice
+func payloadMtuOf(tunnelType string, mtu uint32) uint32 {
+	overhead := tunnelTypeOverhead[tunnelType]
+	if mtu <= overhead {
+		return 0
+	}
+	return mtu - overhead
+}
+
+//validateMtu checks 'mtu' request argument; zero means kernel default
+func (srv *tunnelService) validateMtu(mtu uint32) error {
+	if mtu == 0 {
+		return nil
+	}
+	if mtu < minIPv4Mtu {
+		return status.Errorf(codes.InvalidArgument, "'mtu': %v is below IPv4 minimum %v", mtu, minIPv4Mtu)
+	}
+	if srv.minMtu > 0 && mtu < srv.minMtu {
+		return status.Errorf(codes.InvalidArgument, "'mtu': %v is below configured minimum %v", mtu, srv.minMtu)
+	}
+	return nil
+}
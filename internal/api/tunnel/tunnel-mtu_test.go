@@ -0,0 +1,44 @@
+package tunnel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vishvananda/netlink"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_ValidateMtu(t *testing.T) {
+	ctx := context.Background()
+	srv := NewTunnelService(ctx, WithMinMtu(1400)).(*tunnelService)
+
+	assert.NoError(t, srv.validateMtu(0))
+	assert.NoError(t, srv.validateMtu(1400))
+	assert.Equal(t, codes.InvalidArgument, status.Code(srv.validateMtu(1399)))
+	assert.Equal(t, codes.InvalidArgument, status.Code(srv.validateMtu(60)))
+
+	info, err := srv.GetServiceInfo(ctx, nil)
+	if assert.NoError(t, err) {
+		assert.Equal(t, uint32(1400), info.GetMinMtu())
+		assert.Equal(t, "tun", info.GetNamePrefix())
+		assert.Equal(t, reDetectRule.String(), info.GetDetectRule())
+	}
+}
+
+func Test_PayloadMtu(t *testing.T) {
+	assert.Equal(t, uint32(1480), payloadMtuOf(tunnelTypeIpip, 1500))
+	assert.Equal(t, uint32(0), payloadMtuOf(tunnelTypeIpip, 20))
+	assert.Equal(t, uint32(0), payloadMtuOf(tunnelTypeIpip, 0))
+
+	info, err := tunnelInfoFromLink(&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun1", MTU: 1480}})
+	if assert.NoError(t, err) {
+		assert.Equal(t, uint32(1480), info.GetMtu())
+		assert.Equal(t, uint32(1460), info.GetPayloadMtu())
+	}
+	types := tunnelTypesInfo()
+	if assert.Len(t, types, 1) {
+		assert.Equal(t, uint32(20), types[0].GetOverhead())
+	}
+}
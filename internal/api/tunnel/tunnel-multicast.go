@@ -0,0 +1,81 @@
+package tunnel
+
+import (
+	"net"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/vishvananda/netlink"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//parseMulticastRoutes validates 'multicastRoutes' request argument
+func parseMulticastRoutes(routes []string) ([]*net.IPNet, error) {
+	ret := make([]*net.IPNet, 0, len(routes))
+	for _, r := range routes {
+		s := r
+		if !strings.Contains(s, "/") {
+			s += mask32
+		}
+		ip, dst, err := net.ParseCIDR(s)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "'multicastRoutes': %v",
+				errors.Wrap(err, "net.ParseCIDR"),
+			)
+		}
+		ones, _ := dst.Mask.Size()
+		if ip.To4() == nil || !dst.IP.IsMulticast() || ones < 4 {
+			return nil, status.Errorf(codes.InvalidArgument, "'multicastRoutes': '%s' is not IPv4 multicast", r)
+		}
+		ret = append(ret, dst)
+	}
+	return ret, nil
+}
+
+//addMulticastRoutes binds multicast routes to the tunnel link
+func addMulticastRoutes(nl netlinkOps, link netlink.Link, dsts []*net.IPNet) error {
+	for _, dst := range dsts {
+		r := &netlink.Route{
+			LinkIndex: link.Attrs().Index,
+			Dst:       dst,
+			Scope:     netlink.SCOPE_LINK,
+		}
+		if err := nl.RouteAdd(r); err != nil {
+			return errors.Wrapf(err, "netlink.RouteAdd(%s dev %s)", dst, link.Attrs().Name)
+		}
+	}
+	return nil
+}
+
+//listMulticastRoutes gets multicast routes bound to the tunnel link
+func listMulticastRoutes(nl netlinkOps, link netlink.Link) ([]netlink.Route, error) {
+	routes, err := nl.RouteListFiltered(netlink.FAMILY_V4,
+		&netlink.Route{LinkIndex: link.Attrs().Index, Table: rtTableUnspec},
+		netlink.RT_FILTER_OIF|netlink.RT_FILTER_TABLE,
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "netlink.RouteListFiltered(%s)", link.Attrs().Name)
+	}
+	ret := routes[:0]
+	for _, r := range routes {
+		if r.Dst != nil && r.Dst.IP.IsMulticast() {
+			ret = append(ret, r)
+		}
+	}
+	return ret, nil
+}
+
+//delMulticastRoutes removes multicast routes bound to the tunnel link
+func delMulticastRoutes(nl netlinkOps, link netlink.Link) error {
+	routes, err := listMulticastRoutes(nl, link)
+	if err != nil {
+		return err
+	}
+	for i := range routes {
+		if err = nl.RouteDel(&routes[i]); err != nil {
+			return errors.Wrapf(err, "netlink.RouteDel(%s)", routes[i].Dst)
+		}
+	}
+	return nil
+}
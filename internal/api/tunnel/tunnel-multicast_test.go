@@ -0,0 +1,33 @@
+package tunnel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_ParseMulticastRoutes(t *testing.T) {
+	dsts, err := parseMulticastRoutes([]string{"224.0.0.5", "239.1.0.0/16"})
+	if assert.NoError(t, err) && assert.Len(t, dsts, 2) {
+		assert.Equal(t, "224.0.0.5/32", dsts[0].String())
+		assert.Equal(t, "239.1.0.0/16", dsts[1].String())
+	}
+	for _, bad := range []string{"10.0.0.1", "224.0.0.0/3", "ff02::5", "224.0.0"} {
+		_, err = parseMulticastRoutes([]string{bad})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), bad)
+	}
+}
+
+func Test_AddTunnelStartDownWithMulticastRoutes(t *testing.T) {
+	srv := NewTunnelService(context.Background()).(*tunnelService)
+	_, err := srv.AddTunnel(context.Background(), &tunnel.AddTunnelRequest{
+		TunDestIP:       "1.1.1.1",
+		StartDown:       true,
+		MulticastRoutes: []string{"224.0.0.5"},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
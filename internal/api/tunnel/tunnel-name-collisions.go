@@ -0,0 +1,59 @@
+package tunnel
+
+import (
+	"context"
+	"sort"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//nameCollisions derives tunnel names of 'ips' and gets names derived from more than one distinct IP;
+//the same IP repeated, with or without host prefix, is not a collision
+func nameCollisions(ips []string) ([]*tunnel.NameCollision, error) {
+	byName := make(map[string][]string)
+	seen := make(map[string]bool, len(ips))
+	for i, s := range ips {
+		ip, err := parseTunDestIP(s)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "'tunDestIPs[%v]': %s", i, status.Convert(err).Message())
+		}
+		if seen[ip.String()] {
+			continue
+		}
+		seen[ip.String()] = true
+		var name string
+		if name, err = TunnelNameForIP(ip); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "'tunDestIPs[%v]': %s", i, status.Convert(err).Message())
+		}
+		byName[name] = append(byName[name], s)
+	}
+	var ret []*tunnel.NameCollision
+	for name, sources := range byName {
+		if len(sources) > 1 {
+			ret = append(ret, &tunnel.NameCollision{Name: name, TunDestIPs: sources})
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].GetName() < ret[j].GetName()
+	})
+	return ret, nil
+}
+
+//CheckNameCollisions impl tunnel service
+func (srv *tunnelService) CheckNameCollisions(ctx context.Context, req *tunnel.CheckNameCollisionsRequest) (resp *tunnel.CheckNameCollisionsResponse, err error) {
+	span := srv.spanOf(ctx)
+	span.SetAttributes(attribute.Int("tunDestIPs", len(req.GetTunDestIPs())))
+	defer func() {
+		err = srv.correctError(err)
+	}()
+
+	resp = new(tunnel.CheckNameCollisionsResponse)
+	if resp.Collisions, err = nameCollisions(req.GetTunDestIPs()); err != nil {
+		return nil, err
+	}
+	span.SetAttributes(attribute.Int("collisions", len(resp.Collisions)))
+	return resp, nil
+}
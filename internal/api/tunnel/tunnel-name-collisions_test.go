@@ -0,0 +1,30 @@
+package tunnel
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_CheckNameCollisions(t *testing.T) {
+	ctx := context.Background()
+	srv := NewTunnelService(ctx).(*tunnelService)
+	var ips []string
+	for i := 0; i < 256; i++ {
+		ips = append(ips, fmt.Sprintf("203.0.113.%d", i))
+	}
+	ips = append(ips, "203.0.113.1")
+	resp, err := srv.CheckNameCollisions(ctx, &tunnel.CheckNameCollisionsRequest{TunDestIPs: ips})
+	if assert.NoError(t, err) {
+		assert.Empty(t, resp.GetCollisions(), "IPv4 names are injective; repeated IP is not a collision")
+	}
+	_, err = srv.CheckNameCollisions(ctx, &tunnel.CheckNameCollisionsRequest{TunDestIPs: []string{"1.1.1.1", "1.1.1"}})
+	if assert.Equal(t, codes.InvalidArgument, status.Code(err)) {
+		assert.Contains(t, err.Error(), "tunDestIPs[1]")
+	}
+}
@@ -0,0 +1,186 @@
+package tunnel
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	netPrivate "github.com/gradusp/crispy-tunnel/internal/pkg/net"
+	"github.com/pkg/errors"
+	"github.com/vishvananda/netlink"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	tunnelNamePrefix = "tun"
+
+	//maxLinkNameLen Linux caps interface names at IFNAMSIZ-1 bytes
+	maxLinkNameLen = 15
+)
+
+//validateLinkName checks that 'name' is acceptable as Linux interface name before netlink is called:
+//it must fit in 'maxLinkNameLen' bytes, must not be '.' or '..' and must not have '/', ':' or whitespace;
+//'what' tells where the name comes from in error message
+func validateLinkName(what, name string) error {
+	var reason string
+	switch {
+	case len(name) == 0:
+		reason = "is empty"
+	case len(name) > maxLinkNameLen:
+		reason = fmt.Sprintf("exceeds %v characters", maxLinkNameLen)
+	case name == "." || name == "..":
+		reason = "is reserved"
+	case strings.IndexFunc(name, func(r rune) bool {
+		return r == '/' || r == ':' || r <= ' ' || r >= 0x7f
+	}) >= 0:
+		reason = "has invalid characters"
+	}
+	if len(reason) > 0 {
+		return status.Errorf(codes.InvalidArgument, "%s interface name '%s' %s", what, name, reason)
+	}
+	return nil
+}
+
+//deriveTunnelName derives interface name from 'prefix' and tunnel destination IP and validates it
+func deriveTunnelName(prefix string, tunDestIP net.IP) (string, error) {
+	ret := fmt.Sprintf("%s%v", prefix, netPrivate.IPType(tunDestIP).Int())
+	if err := validateLinkName("derived", ret); err != nil {
+		return "", err
+	}
+	return ret, nil
+}
+
+//TunnelNameForIP derives tunnel interface name from tunnel destination IP; every tunnel name is derived here
+//so the name is always checked by 'validateLinkName' before it reaches netlink
+func TunnelNameForIP(tunDestIP net.IP) (string, error) {
+	return deriveTunnelName(tunnelNamePrefix, tunDestIP)
+}
+
+//namedAfterRemote tells if link is IPIP tunnel to IPv4 remote and its name is derived from the remote
+func namedAfterRemote(link netlink.Link) bool {
+	t, ok := link.(*netlink.Iptun)
+	if !ok || t.Remote.To4() == nil {
+		return false
+	}
+	name, err := TunnelNameForIP(t.Remote)
+	return err == nil && name == link.Attrs().Name
+}
+
+//parseTunDestIP validates 'tunDestIP' request argument
+//  - it must be canonical textual IPv4 address
+//  - it may have host prefix ('/32', or '/128' which then fails as not IPv4 address); other prefixes are rejected
+//  - it must be global or private unicast address: not loopback, link-local, multicast, broadcast or unspecified
+func parseTunDestIP(tunDestIP string) (net.IP, error) {
+	addr := tunDestIP
+	if i := strings.IndexByte(tunDestIP, '/'); i >= 0 {
+		addr = tunDestIP[:i]
+		hostPrefix := "32"
+		if strings.Contains(addr, ":") {
+			hostPrefix = "128"
+		}
+		if prefix := tunDestIP[i+1:]; prefix != hostPrefix {
+			return nil, status.Errorf(codes.InvalidArgument, "'tunDestIP': '%s' has prefix '/%s' but only host prefix '/%s' is accepted",
+				tunDestIP, prefix, hostPrefix)
+		}
+	}
+	ret, _, err := net.ParseCIDR(addr + mask32)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "'tunDestIP': %v",
+			errors.Wrap(err, "net.ParseCIDR"),
+		)
+	}
+	var reason string
+	switch {
+	case ret.To4() == nil:
+		reason = "is not IPv4 address"
+	case ret.String() != addr:
+		reason = fmt.Sprintf("is not canonical, expected '%s'", ret)
+	case ret.IsUnspecified():
+		reason = "is unspecified address"
+	case ret.IsLoopback():
+		reason = "is loopback address"
+	case ret.IsLinkLocalUnicast():
+		reason = "is link-local address"
+	case ret.Equal(net.IPv4bcast):
+		reason = "is broadcast address"
+	case !ret.IsGlobalUnicast():
+		reason = "is not unicast address"
+	}
+	if len(reason) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "'tunDestIP': '%s' %s", tunDestIP, reason)
+	}
+	return ret, nil
+}
+
+//hostAddrs IPv4 addresses assigned to interfaces of this host
+func hostAddrs(nl netlinkOps) ([]net.IP, error) {
+	addrs, err := nl.AddrList(nil, netlink.FAMILY_V4)
+	if err != nil {
+		return nil, errors.Wrap(err, "netlink.AddrList")
+	}
+	ret := make([]net.IP, 0, len(addrs))
+	for _, a := range addrs {
+		if a.IPNet != nil {
+			ret = append(ret, a.IP)
+		}
+	}
+	return ret, nil
+}
+
+//checkNotLocal rejects 'tunDestIP' which is one of 'local' addresses; tunnel to this host itself never carries traffic
+func checkNotLocal(tunDestIP string, ip net.IP, local []net.IP) error {
+	for _, a := range local {
+		if a.Equal(ip) {
+			return status.Errorf(codes.InvalidArgument, "'tunDestIP': '%s' is local address of this host", tunDestIP)
+		}
+	}
+	return nil
+}
+
+//tunnelNameOf resolves tunnel name from either 'tunDestIP' or 'name' request argument
+func tunnelNameOf(tunDestIP, name string) (string, error) {
+	switch {
+	case len(tunDestIP) > 0 && len(name) > 0:
+		return "", status.Errorf(codes.InvalidArgument, "either 'tunDestIP' or 'name' is expected")
+	case len(tunDestIP) > 0:
+		ip, err := parseTunDestIP(tunDestIP)
+		if err != nil {
+			return "", err
+		}
+		return TunnelNameForIP(ip)
+	case len(name) > 0:
+		if strings.ContainsAny(name, `/\`) || !reDetectRule.MatchString(name) {
+			return "", status.Errorf(codes.InvalidArgument, "'name': '%s' is not a tunnel name", name)
+		}
+		if err := validateLinkName("'name':", name); err != nil {
+			return "", err
+		}
+		return name, nil
+	}
+	return "", status.Errorf(codes.InvalidArgument, "'tunDestIP' or 'name' is expected")
+}
+
+//verifyTunnelRemote checks that link is IPIP tunnel to 'remote'; link with derived name may be altered manually
+func verifyTunnelRemote(link netlink.Link, remote net.IP) error {
+	name := link.Attrs().Name
+	t, ok := link.(*netlink.Iptun)
+	if !ok {
+		return status.Errorf(codes.FailedPrecondition, "link '%s' is '%s' but not IPIP tunnel", name, link.Type())
+	}
+	if !t.Remote.Equal(remote) {
+		return status.Errorf(codes.FailedPrecondition, "tunnel '%s' has remote '%v' but not '%v'", name, t.Remote, remote)
+	}
+	return nil
+}
+
+//lookupTunnel finds tunnel link by its name
+func lookupTunnel(nl netlinkOps, tunnelName string) (netlink.Link, error) {
+	link, err := nl.LinkByName(tunnelName)
+	if errors.As(err, new(netlink.LinkNotFoundError)) {
+		return nil, status.Errorf(codes.NotFound, "tunnel '%v' is not found", tunnelName)
+	} else if err != nil {
+		return nil, errors.Wrapf(err, "netlink.LinkByName(%s)", tunnelName)
+	}
+	return link, nil
+}
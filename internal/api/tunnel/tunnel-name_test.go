@@ -0,0 +1,125 @@
+package tunnel
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/stretchr/testify/assert"
+	"github.com/vishvananda/netlink"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_ResolveName(t *testing.T) {
+	ctx := context.Background()
+	srv := NewTunnelService(ctx).(*tunnelService)
+
+	resp, err := srv.ResolveName(ctx, &tunnel.ResolveNameRequest{TunDestIP: "1.1.1.1"})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "tun16843009", resp.GetName())
+
+	_, err = srv.ResolveName(ctx, &tunnel.ResolveNameRequest{TunDestIP: "1.1.1"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func Test_VerifyTunnelRemote(t *testing.T) {
+	remote := net.ParseIP("1.1.1.1")
+	link := &netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun16843009"}, Remote: remote}
+	assert.NoError(t, verifyTunnelRemote(link, remote))
+	link.Remote = net.ParseIP("2.2.2.2")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(verifyTunnelRemote(link, remote)))
+	dummy := &netlink.Dummy{LinkAttrs: netlink.LinkAttrs{Name: "tun16843009"}}
+	assert.Equal(t, codes.FailedPrecondition, status.Code(verifyTunnelRemote(dummy, remote)))
+}
+
+func Test_ValidateLinkName(t *testing.T) {
+	cases := []struct {
+		name string
+		ok   bool
+	}{
+		{"tun4294967295", true},
+		{"hc-tunnel-4294", true},
+		{"hc-tunnel-42949", true},
+		{"hc-tunnel-429496", false},
+		{"hc-tunnel-4294967295", false},
+		{"", false},
+		{".", false},
+		{"..", false},
+		{"tun/1", false},
+		{"tun:1", false},
+		{"tun 1", false},
+	}
+	for _, c := range cases {
+		err := validateLinkName("derived", c.name)
+		if c.ok {
+			assert.NoError(t, err, c.name)
+			continue
+		}
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), c.name)
+	}
+	err := validateLinkName("derived", "hc-tunnel-4294967295")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "derived interface name 'hc-tunnel-4294967295' exceeds 15 characters")
+	}
+	name, err := TunnelNameForIP(net.ParseIP("255.255.255.254"))
+	if assert.NoError(t, err) {
+		assert.Equal(t, "tun4294967294", name)
+	}
+
+	//the guard of derived names at the length boundary; "tun" prefix never reaches it
+	name, err = deriveTunnelName("hc-tunnel-", net.ParseIP("0.0.167.197"))
+	if assert.NoError(t, err) {
+		assert.Equal(t, "hc-tunnel-42949", name)
+	}
+	_, err = deriveTunnelName("hc-tunnel-", net.ParseIP("0.6.141.184"))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = deriveTunnelName("hc-tunnel-", net.ParseIP("255.255.255.255"))
+	if assert.Equal(t, codes.InvalidArgument, status.Code(err)) {
+		assert.Contains(t, err.Error(), "derived interface name 'hc-tunnel-4294967295' exceeds 15 characters")
+	}
+	_, err = deriveTunnelName("tun/", net.ParseIP("1.1.1.1"))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+//mustTunnelName derives tunnel name of IP which is known to give valid one
+func mustTunnelName(ip net.IP) string {
+	name, err := TunnelNameForIP(ip)
+	if err != nil {
+		panic(err)
+	}
+	return name
+}
+
+func Test_ParseTunDestIPPrefix(t *testing.T) {
+	for _, s := range []string{"10.0.0.5", "10.0.0.5/32"} {
+		ip, err := parseTunDestIP(s)
+		if assert.NoError(t, err, s) {
+			assert.Equal(t, "10.0.0.5", ip.String())
+			assert.Equal(t, "tun167772165", mustTunnelName(ip))
+		}
+	}
+	cases := []struct {
+		in, msg string
+	}{
+		{"10.0.0.5/24", "has prefix '/24' but only host prefix '/32' is accepted"},
+		{"10.0.0.5/128", "has prefix '/128' but only host prefix '/32' is accepted"},
+		{"10.0.0.5/32/32", "has prefix '/32/32'"},
+		{"2001:db8::1/128", "is not IPv4 address"},
+		{"2001:db8::1/64", "only host prefix '/128' is accepted"},
+		{"::ffff:10.0.0.5", "is not canonical"},
+	}
+	for _, c := range cases {
+		_, err := parseTunDestIP(c.in)
+		if assert.Equal(t, codes.InvalidArgument, status.Code(err), c.in) {
+			assert.Contains(t, err.Error(), c.msg, c.in)
+		}
+	}
+	assert.Equal(t, batchKeyOf("10.0.0.5"), batchKeyOf("10.0.0.5/32"))
+	collisions, err := nameCollisions([]string{"10.0.0.5", "10.0.0.5/32"})
+	assert.NoError(t, err)
+	assert.Empty(t, collisions)
+}
@@ -0,0 +1,59 @@
+package tunnel
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/vishvananda/netlink"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//validateOperStateFilter checks 'operState' request argument
+func validateOperStateFilter(f tunnel.OperStateFilter) error {
+	if _, ok := tunnel.OperStateFilter_name[int32(f)]; !ok {
+		return status.Errorf(codes.InvalidArgument, "'operState': unsupported value (%v)", f)
+	}
+	return nil
+}
+
+//isOperUp checks if link is operational;
+//IPIP links report UNKNOWN oper state when they are up so admin up UNKNOWN link is considered up
+func isOperUp(link netlink.Link) bool {
+	a := link.Attrs()
+	return a.OperState == netlink.OperUp ||
+		(a.OperState == netlink.OperUnknown && a.Flags&net.FlagUp != 0)
+}
+
+//operStateMatches checks if link oper state passes the filter
+func operStateMatches(link netlink.Link, f tunnel.OperStateFilter) bool {
+	if f == tunnel.OperStateFilter_OPER_STATE_ANY {
+		return true
+	}
+	return isOperUp(link) == (f == tunnel.OperStateFilter_OPER_STATE_UP)
+}
+
+//waitOperUp polls tunnel link until it is oper-up, 'operUpWait' is elapsed or ctx is done;
+//the last read link is returned anyway so caller learns readiness from it
+func (srv *tunnelService) waitOperUp(ctx context.Context, tunnelName string) (netlink.Link, error) {
+	const pollInterval = 50 * time.Millisecond
+	deadline := time.NewTimer(srv.operUpWait)
+	defer deadline.Stop()
+	poll := time.NewTicker(pollInterval)
+	defer poll.Stop()
+	for {
+		link, err := lookupTunnel(srv.nl, tunnelName)
+		if err != nil || operStateMatches(link, tunnel.OperStateFilter_OPER_STATE_UP) {
+			return link, err
+		}
+		select {
+		case <-ctx.Done():
+			return link, nil
+		case <-deadline.C:
+			return link, nil
+		case <-poll.C:
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package tunnel
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/stretchr/testify/assert"
+	"github.com/vishvananda/netlink"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_GetStateOperState(t *testing.T) {
+	ctx := context.Background()
+	srv := NewTunnelService(ctx).(*tunnelService)
+	srv.linkList = func() ([]netlink.Link, error) {
+		return []netlink.Link{
+			&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun1", OperState: netlink.OperUp, Flags: net.FlagUp}},
+			&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun2", OperState: netlink.OperUnknown, Flags: net.FlagUp}},
+			&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun3", OperState: netlink.OperUnknown}},
+			&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun4", OperState: netlink.OperDown}},
+		}, nil
+	}
+	cases := []struct {
+		filter   tunnel.OperStateFilter
+		detailed bool
+		expect   []string
+	}{
+		{tunnel.OperStateFilter_OPER_STATE_ANY, false, []string{"tun1", "tun2", "tun3", "tun4"}},
+		{tunnel.OperStateFilter_OPER_STATE_UP, false, []string{"tun1", "tun2"}},
+		{tunnel.OperStateFilter_OPER_STATE_DOWN, true, []string{"tun3", "tun4"}},
+	}
+	for _, c := range cases {
+		resp, err := srv.GetState(ctx, &tunnel.GetStateRequest{Detailed: c.detailed, OperState: c.filter})
+		if assert.NoError(t, err) {
+			assert.Equal(t, c.expect, resp.GetTunnels())
+		}
+	}
+	_, err := srv.GetState(ctx, &tunnel.GetStateRequest{OperState: 10})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func Test_WaitOperUpTimeout(t *testing.T) {
+	srv := NewTunnelService(context.Background(), WithOperUpWait(100*time.Millisecond)).(*tunnelService)
+	_, err := srv.waitOperUp(context.Background(), "tun-not-exists")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
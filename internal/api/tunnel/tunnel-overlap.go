@@ -0,0 +1,47 @@
+package tunnel
+
+import (
+	"net"
+
+	"github.com/vishvananda/netlink"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//tunnelOverlapKey identity of tunnel by which two tunnels overlap whatever they are named;
+//kernel can't tell overlapping tunnels apart so the second one is a misconfiguration
+//  - ipip: local and remote addresses; IPIP has no key so tunnels between the same endpoints always overlap
+//  - unspecified local address is the same as any other unspecified one
+func tunnelOverlapKey(tunnelType string, local, remote net.IP) string {
+	l := "any"
+	if len(local) > 0 && !local.IsUnspecified() {
+		l = local.String()
+	}
+	return tunnelType + "|" + l + "|" + remote.String()
+}
+
+//linkOverlapKey gets overlap key of tunnel link; false for links of unsupported types
+func linkOverlapKey(link netlink.Link) (string, bool) {
+	if t, ok := link.(*netlink.Iptun); ok && t.Remote != nil {
+		return tunnelOverlapKey(tunnelTypeIpip, t.Local, t.Remote), true
+	}
+	return "", false
+}
+
+//checkOverlap fails if a managed tunnel named other than 'tunnelName' has overlap key 'key'
+func (srv *tunnelService) checkOverlap(tunnelName, key string) error {
+	var other string
+	err := srv.enumLinks(func(nl netlink.Link) error {
+		if k, ok := linkOverlapKey(nl); ok && k == key && nl.Attrs().Name != tunnelName && isOwnedLink(nl) {
+			other = nl.Attrs().Name
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if len(other) > 0 {
+		return status.Errorf(codes.AlreadyExists, "tunnel '%s' overlaps managed tunnel '%s' (%s)", tunnelName, other, key)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package tunnel
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/stretchr/testify/assert"
+	"github.com/vishvananda/netlink"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_TunnelOverlap(t *testing.T) {
+	ip := func(s string) net.IP { return net.ParseIP(s).To4() }
+	cases := []struct {
+		local1, remote1, local2, remote2 string
+		overlap                          bool
+	}{
+		{"", "1.1.1.1", "", "1.1.1.1", true},
+		{"", "1.1.1.1", "0.0.0.0", "1.1.1.1", true},
+		{"10.0.0.1", "1.1.1.1", "10.0.0.1", "1.1.1.1", true},
+		{"", "1.1.1.1", "", "1.1.1.2", false},
+		{"10.0.0.1", "1.1.1.1", "10.0.0.2", "1.1.1.1", false},
+		{"", "1.1.1.1", "10.0.0.1", "1.1.1.1", false},
+	}
+	for i, c := range cases {
+		k1 := tunnelOverlapKey(tunnelTypeIpip, ip(c.local1), ip(c.remote1))
+		k2 := tunnelOverlapKey(tunnelTypeIpip, ip(c.local2), ip(c.remote2))
+		assert.Equalf(t, c.overlap, k1 == k2, "case #%v", i)
+	}
+
+	ctx := context.Background()
+	srv := NewTunnelService(ctx).(*tunnelService)
+	managed := &netlink.Iptun{
+		LinkAttrs: netlink.LinkAttrs{Name: "tun7", Alias: aliasLabelsPrefix + "weight=1"},
+		Remote:    ip("1.1.1.1"),
+	}
+	unmanaged := &netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun8"}, Remote: ip("2.2.2.2")}
+	srv.linkList = func() ([]netlink.Link, error) {
+		return []netlink.Link{managed, unmanaged, &netlink.Dummy{LinkAttrs: netlink.LinkAttrs{Name: "tun9"}}}, nil
+	}
+	key := func(remote string) string { return tunnelOverlapKey(tunnelTypeIpip, nil, ip(remote)) }
+	name := func(remote string) string { return mustTunnelName(ip(remote)) }
+
+	err := srv.checkOverlap(name("1.1.1.1"), key("1.1.1.1"))
+	if assert.Equal(t, codes.AlreadyExists, status.Code(err)) {
+		assert.Contains(t, err.Error(), "tun7")
+	}
+	assert.NoError(t, srv.checkOverlap("tun7", key("1.1.1.1")), "the same tunnel does not overlap itself")
+	assert.NoError(t, srv.checkOverlap(name("1.1.1.2"), key("1.1.1.2")))
+	assert.NoError(t, srv.checkOverlap(name("2.2.2.2"), key("2.2.2.2")), "unmanaged links are not checked")
+
+	results := runBatch([]string{"1.1.1.1", "1.1.1.2", "1.1.1.1"}, func(int) (*tunnel.TunnelInfo, error) {
+		return new(tunnel.TunnelInfo), nil
+	})
+	assert.Equal(t, batchItemDuplicate, results[2].GetStatus())
+}
@@ -0,0 +1,140 @@
+package tunnel
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/pkg/errors"
+	"github.com/vishvananda/netlink"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//Policy route bundle of tunnel is a route through the tunnel in routing table N and
+//'fwmark M lookup N' rule; route goes away with the link, the rule is found by its labels
+const (
+	rtTableDefault = 253
+	rtTableLocal   = 255
+)
+
+//policyRoute parsed 'policyRoute' request argument
+type policyRoute struct {
+	table    int
+	mark     fwMark
+	dst      *net.IPNet
+	priority int
+}
+
+//parsePolicyRoute validates 'policyRoute' request argument; nil argument is no policy route
+func parsePolicyRoute(pr *tunnel.PolicyRoute) (*policyRoute, error) {
+	if pr == nil {
+		return nil, nil
+	}
+	ret := &policyRoute{table: int(pr.GetTable()), priority: int(pr.GetPriority())}
+	if t := pr.GetTable(); t == 0 || (t >= rtTableDefault && t <= rtTableLocal) {
+		return nil, status.Errorf(codes.InvalidArgument, "'policyRoute.table': %v is not custom routing table", t)
+	}
+	var err error
+	if ret.mark, err = parseFwMark(pr.GetFwMark()); err != nil {
+		msg := strings.TrimPrefix(status.Convert(err).Message(), "'fwMark': ")
+		return nil, status.Errorf(codes.InvalidArgument, "'policyRoute.fwMark': %s", msg)
+	}
+	dst := pr.GetDst()
+	if len(dst) == 0 {
+		dst = "0.0.0.0/0"
+	}
+	var ip net.IP
+	if ip, ret.dst, err = net.ParseCIDR(dst); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "'policyRoute.dst': %v",
+			errors.Wrap(err, "net.ParseCIDR"),
+		)
+	}
+	if ip.To4() == nil || !ip.Equal(ret.dst.IP) {
+		return nil, status.Errorf(codes.InvalidArgument, "'policyRoute.dst': '%s' is not IPv4 network", dst)
+	}
+	return ret, nil
+}
+
+//policyRouteOf gets policy route bundle of tunnel from its labels
+func policyRouteOf(ll linkLabels) (*policyRoute, bool) {
+	t, err := strconv.Atoi(ll[labelPolicyTable])
+	if err != nil {
+		return nil, false
+	}
+	ret := &policyRoute{table: t}
+	if ret.mark, err = parseFwMark(ll[labelPolicyMark]); err != nil {
+		return nil, false
+	}
+	if _, ret.dst, err = net.ParseCIDR(ll[labelPolicyDst]); err != nil {
+		return nil, false
+	}
+	ret.priority, _ = strconv.Atoi(ll[labelPolicyPriority])
+	return ret, true
+}
+
+//setLabels stores policy route bundle in tunnel labels
+func (pr *policyRoute) setLabels(ll linkLabels) {
+	ll[labelPolicyTable] = strconv.Itoa(pr.table)
+	ll[labelPolicyMark] = pr.mark.String()
+	ll[labelPolicyDst] = pr.dst.String()
+	if pr.priority > 0 {
+		ll[labelPolicyPriority] = strconv.Itoa(pr.priority)
+	}
+}
+
+//info makes API form of policy route bundle
+func (pr *policyRoute) info() *tunnel.PolicyRoute {
+	return &tunnel.PolicyRoute{
+		Table:    uint32(pr.table),
+		FwMark:   pr.mark.String(),
+		Dst:      pr.dst.String(),
+		Priority: uint32(pr.priority),
+	}
+}
+
+func (pr *policyRoute) rule() *netlink.Rule {
+	r := netlink.NewRule()
+	r.Family = netlink.FAMILY_V4
+	r.Table = pr.table
+	r.Mark = int(pr.mark.value)
+	r.Mask = int(pr.mark.mask)
+	if pr.priority > 0 {
+		r.Priority = pr.priority
+	}
+	return r
+}
+
+func (pr *policyRoute) String() string {
+	return fmt.Sprintf("fwmark %s lookup %v priority %v", pr.mark, pr.table, pr.priority)
+}
+
+//addPolicyRoute adds route through the tunnel into the table and the rule leading to the table
+func addPolicyRoute(nl netlinkOps, link netlink.Link, pr *policyRoute) error {
+	r := &netlink.Route{
+		LinkIndex: link.Attrs().Index,
+		Dst:       pr.dst,
+		Table:     pr.table,
+		Scope:     netlink.SCOPE_LINK,
+	}
+	if err := nl.RouteAdd(r); err != nil {
+		return errors.Wrapf(err, "netlink.RouteAdd(%s dev %s table %v)", pr.dst, link.Attrs().Name, pr.table)
+	}
+	if err := nl.RuleAdd(pr.rule()); err != nil {
+		return errors.Wrapf(err, "netlink.RuleAdd(%s)", pr)
+	}
+	return nil
+}
+
+//delPolicyRule removes the rule of policy route bundle; rule which is not there is not an error
+func delPolicyRule(nl netlinkOps, pr *policyRoute) (removed bool, err error) {
+	if err = nl.RuleDel(pr.rule()); errors.Is(err, syscall.ENOENT) {
+		return false, nil
+	} else if err != nil {
+		return false, errors.Wrapf(err, "netlink.RuleDel(%s)", pr)
+	}
+	return true, nil
+}
@@ -0,0 +1,63 @@
+package tunnel
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/stretchr/testify/assert"
+	"github.com/vishvananda/netlink"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_PolicyRoute(t *testing.T) {
+	pr, err := parsePolicyRoute(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, pr)
+
+	pr, err = parsePolicyRoute(&tunnel.PolicyRoute{Table: 100, FwMark: "0x10/0xf0"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "0.0.0.0/0", pr.dst.String())
+		assert.Equal(t, fwMark{value: 0x10, mask: 0xf0}, pr.mark)
+		r := pr.rule()
+		assert.Equal(t, 100, r.Table)
+		assert.Equal(t, 0x10, r.Mark)
+		assert.Equal(t, 0xf0, r.Mask)
+		assert.Equal(t, -1, r.Priority)
+	}
+	for _, bad := range []*tunnel.PolicyRoute{
+		{Table: 0, FwMark: "1"},
+		{Table: 254, FwMark: "1"},
+		{Table: 100},
+		{Table: 100, FwMark: "1", Dst: "10.0.0.1/8"},
+		{Table: 100, FwMark: "1", Dst: "fe80::/64"},
+	} {
+		_, err = parsePolicyRoute(bad)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), bad.String())
+	}
+
+	pr, err = parsePolicyRoute(&tunnel.PolicyRoute{Table: 100, FwMark: "7", Dst: "10.0.0.0/8", Priority: 1000})
+	if !assert.NoError(t, err) {
+		return
+	}
+	ll := make(linkLabels)
+	pr.setLabels(ll)
+	link := &netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun16843009", Alias: ll.alias()}, Remote: net.ParseIP("1.1.1.1")}
+	info, err := tunnelInfoFromLink(link)
+	if assert.NoError(t, err) {
+		assert.Equal(t, uint32(100), info.GetPolicyRoute().GetTable())
+		assert.Equal(t, "0x7/0xffffffff", info.GetPolicyRoute().GetFwMark())
+		assert.Equal(t, "10.0.0.0/8", info.GetPolicyRoute().GetDst())
+		assert.Equal(t, uint32(1000), info.GetPolicyRoute().GetPriority())
+	}
+	_, ok := policyRouteOf(linkLabels{labelWeight: "1"})
+	assert.False(t, ok)
+
+	srv := NewTunnelService(context.Background()).(*tunnelService)
+	err = srv.validateAddTunnelFields(tunnelTypeIpip, &tunnel.AddTunnelRequest{
+		TunDestIP: "1.1.1.1", StartDown: true, PolicyRoute: &tunnel.PolicyRoute{Table: 100, FwMark: "7"},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,262 @@
+package tunnel
+
+import (
+	"context"
+	"net"
+	"sort"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/pkg/errors"
+	"github.com/vishvananda/netlink"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+//isQuarantined checks if tunnel link is quarantined
+func isQuarantined(link netlink.Link) bool {
+	_, ok := labelsOf(link)[labelQuarantined]
+	return ok
+}
+
+//QuarantineTunnel impl tunnel service
+func (srv *tunnelService) QuarantineTunnel(ctx context.Context, req *tunnel.QuarantineTunnelRequest) (*emptypb.Empty, error) {
+	if err := srv.denyMutation(); err != nil {
+		return nil, err
+	}
+	resp, err := srv.idempotency.do(ctx, "QuarantineTunnel", req.GetIdempotencyKey(), req, func() (proto.Message, error) {
+		return srv.quarantineTunnel(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*emptypb.Empty), nil
+}
+
+//quarantineTunnel brings tunnel down and labels it quarantined
+func (srv *tunnelService) quarantineTunnel(ctx context.Context, req *tunnel.QuarantineTunnelRequest) (resp *emptypb.Empty, err error) {
+	tunnelIP := req.GetTunDestIP()
+	span := srv.spanOf(ctx)
+	span.SetAttributes(attribute.String("tunDestIP", tunnelIP))
+
+	ctx, cancel := withDefaultDeadline(ctx, srv.writeTimeout)
+	defer cancel()
+
+	var leave func()
+	if leave, err = srv.enter(ctx); err != nil {
+		return nil, err
+	}
+	defer func() {
+		leave()
+		err = srv.correctError(err)
+	}()
+	resp = new(emptypb.Empty)
+
+	var hcTunDestNetIP net.IP
+	if hcTunDestNetIP, err = parseTunDestIP(tunnelIP); err != nil {
+		return
+	}
+	var tunnelName string
+	if tunnelName, err = TunnelNameForIP(hcTunDestNetIP); err != nil {
+		return
+	}
+	span.SetAttributes(attribute.String("tunnel-name", tunnelName))
+
+	var unlock func()
+	if unlock, err = srv.lockForMutation(ctx, tunnelName); err != nil {
+		return
+	}
+	defer unlock()
+
+	var link netlink.Link
+	if link, err = lookupTunnel(srv.nl, tunnelName); err != nil {
+		return
+	}
+	srv.addSpanDbgEvent(ctx, span, "netlink.LinkSetDown")
+	if err = srv.nl.LinkSetDown(link); err != nil {
+		err = errors.Wrapf(err, "netlink.LinkSetDown(%s)", tunnelName)
+		return
+	}
+	srv.addSpanDbgEvent(ctx, span, "setLinkLabels")
+	labels := labelsOf(link)
+	labels[labelQuarantined] = ""
+	if err = setLinkLabels(srv.nl, link, labels); err != nil {
+		return
+	}
+	srv.notifyChange(ctx, webhookActionQuarantine, tunnelName, hcTunDestNetIP.String())
+	return //nolint:nakedret
+}
+
+//RestoreTunnel impl tunnel service
+func (srv *tunnelService) RestoreTunnel(ctx context.Context, req *tunnel.RestoreTunnelRequest) (*emptypb.Empty, error) {
+	if err := srv.denyMutation(); err != nil {
+		return nil, err
+	}
+	resp, err := srv.idempotency.do(ctx, "RestoreTunnel", req.GetIdempotencyKey(), req, func() (proto.Message, error) {
+		return srv.restoreTunnel(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*emptypb.Empty), nil
+}
+
+//restoreTunnel drops quarantine label of the tunnel and brings it up
+func (srv *tunnelService) restoreTunnel(ctx context.Context, req *tunnel.RestoreTunnelRequest) (resp *emptypb.Empty, err error) {
+	tunnelIP := req.GetTunDestIP()
+	span := srv.spanOf(ctx)
+	span.SetAttributes(attribute.String("tunDestIP", tunnelIP))
+
+	ctx, cancel := withDefaultDeadline(ctx, srv.writeTimeout)
+	defer cancel()
+
+	var leave func()
+	if leave, err = srv.enter(ctx); err != nil {
+		return nil, err
+	}
+	defer func() {
+		leave()
+		err = srv.correctError(err)
+	}()
+	resp = new(emptypb.Empty)
+
+	var hcTunDestNetIP net.IP
+	if hcTunDestNetIP, err = parseTunDestIP(tunnelIP); err != nil {
+		return
+	}
+	var tunnelName string
+	if tunnelName, err = TunnelNameForIP(hcTunDestNetIP); err != nil {
+		return
+	}
+	span.SetAttributes(attribute.String("tunnel-name", tunnelName))
+
+	var unlock func()
+	if unlock, err = srv.lockForMutation(ctx, tunnelName); err != nil {
+		return
+	}
+	defer unlock()
+
+	var link netlink.Link
+	if link, err = lookupTunnel(srv.nl, tunnelName); err != nil {
+		return
+	}
+	if !isQuarantined(link) {
+		err = status.Errorf(codes.FailedPrecondition, "tunnel '%v' is not quarantined", tunnelName)
+		return
+	}
+	srv.addSpanDbgEvent(ctx, span, "setLinkLabels")
+	labels := labelsOf(link)
+	delete(labels, labelQuarantined)
+	if err = setLinkLabels(srv.nl, link, labels); err != nil {
+		return
+	}
+	srv.addSpanDbgEvent(ctx, span, "netlink.LinkSetUp")
+	if err = srv.nl.LinkSetUp(link); err != nil {
+		err = errors.Wrapf(err, "netlink.LinkSetUp(%s)", tunnelName)
+		return
+	}
+	srv.notifyChange(ctx, webhookActionRestore, tunnelName, hcTunDestNetIP.String())
+	return //nolint:nakedret
+}
+
+//ListQuarantined impl tunnel service
+func (srv *tunnelService) ListQuarantined(ctx context.Context, _ *emptypb.Empty) (resp *tunnel.ListQuarantinedResponse, err error) {
+	ctx, cancel := withDefaultDeadline(ctx, srv.readTimeout)
+	defer cancel()
+
+	var leave func()
+	if leave, err = srv.enter(ctx); err != nil {
+		return nil, err
+	}
+	defer func() {
+		leave()
+		err = srv.correctError(err)
+	}()
+
+	resp = new(tunnel.ListQuarantinedResponse)
+	err = srv.enumLinks(func(nl netlink.Link) error {
+		if isQuarantined(nl) {
+			resp.Tunnels = append(resp.Tunnels, nl.Attrs().Name)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(resp.Tunnels)
+	return resp, nil
+}
+
+//PurgeTunnels impl tunnel service
+//  - 'confirm' is required
+//  - only quarantined tunnels are removed unless 'all' is set; 'keepTunDestIPs' are kept anyway
+//  - only managed tunnels named by their remote are removed; unmanaged interfaces are never touched, even acknowledged ones
+//  - cordoned tunnels are kept and reported
+//  - tunnels are removed one by one the way RemoveTunnel does; the first failure stops purge
+func (srv *tunnelService) PurgeTunnels(ctx context.Context, req *tunnel.PurgeTunnelsRequest) (resp *tunnel.PurgeTunnelsResponse, err error) {
+	if err = srv.denyMutation(); err != nil {
+		return nil, err
+	}
+	if err = srv.denyBulkMutation(); err != nil {
+		return nil, err
+	}
+	span := srv.spanOf(ctx)
+	defer func() {
+		err = srv.correctError(err)
+	}()
+
+	if !req.GetConfirm() {
+		return nil, status.Errorf(codes.FailedPrecondition, "'confirm': is required to purge tunnels")
+	}
+	keep := make(map[string]bool)
+	for _, tunnelIP := range req.GetKeepTunDestIPs() {
+		var ip net.IP
+		if ip, err = parseTunDestIP(tunnelIP); err != nil {
+			return nil, err
+		}
+		var name string
+		if name, err = TunnelNameForIP(ip); err != nil {
+			return nil, err
+		}
+		keep[name] = true
+	}
+	quarantinedOnly := !req.GetAll() || req.GetQuarantinedOnly()
+	resp = new(tunnel.PurgeTunnelsResponse)
+	var victims []net.IP
+	names := make(map[string]string)
+	err = srv.enumLinks(func(nl netlink.Link) error {
+		t, ok := nl.(*netlink.Iptun)
+		if !ok || !isOwnedLink(nl) || !namedAfterRemote(nl) {
+			return nil
+		}
+		if keep[nl.Attrs().Name] || (quarantinedOnly && !isQuarantined(nl)) {
+			return nil
+		}
+		if isCordoned(nl) {
+			resp.Cordoned = append(resp.Cordoned, nl.Attrs().Name)
+		} else {
+			victims = append(victims, t.Remote)
+			names[t.Remote.String()] = nl.Attrs().Name
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(resp.Cordoned)
+	sort.Slice(victims, func(i, j int) bool {
+		return tunnelIndexOf(victims[i]) < tunnelIndexOf(victims[j])
+	})
+	span.SetAttributes(attribute.Int("tunnels", len(victims)))
+	for _, ip := range victims {
+		removeReq := &tunnel.RemoveTunnelRequest{TunDestIP: ip.String(), VerifyRemote: true}
+		if _, err = srv.removeTunnel(ctx, removeReq, webhookActionPurge); err != nil {
+			return nil, err
+		}
+		resp.Removed = append(resp.Removed, names[ip.String()])
+	}
+	sort.Strings(resp.Removed)
+	return resp, nil
+}
@@ -0,0 +1,76 @@
+package tunnel
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_QuarantineAndPurge(t *testing.T) {
+	ctx := context.Background()
+	srv := NewTunnelService(ctx, WithExecEnv(fakeCommands(t, "sysctl")), WithRemoveTombstones(time.Minute, 10)).(*tunnelService)
+	fake := useFakeNetlink(srv)
+	ips := []string{"10.0.0.1", "10.0.0.2", "10.0.0.3", "10.0.0.4"}
+	names := make([]string, len(ips))
+	for i, ip := range ips {
+		names[i] = mustTunnelName(net.ParseIP(ip))
+		_, err := srv.AddTunnel(ctx, &tunnel.AddTunnelRequest{TunDestIP: ip})
+		if !assert.NoError(t, err, ip) {
+			return
+		}
+	}
+	for _, ip := range []string{ips[0], ips[1], ips[3]} {
+		_, err := srv.QuarantineTunnel(ctx, &tunnel.QuarantineTunnelRequest{TunDestIP: ip})
+		assert.NoError(t, err, ip)
+	}
+	info, err := srv.GetTunnel(ctx, &tunnel.GetTunnelRequest{TunDestIP: ips[0]})
+	if assert.NoError(t, err) {
+		assert.False(t, info.GetAdminUp())
+	}
+	_, err = srv.RestoreTunnel(ctx, &tunnel.RestoreTunnelRequest{TunDestIP: ips[1]})
+	assert.NoError(t, err)
+	info, err = srv.GetTunnel(ctx, &tunnel.GetTunnelRequest{TunDestIP: ips[1]})
+	if assert.NoError(t, err) {
+		assert.True(t, info.GetAdminUp())
+	}
+	_, err = srv.RestoreTunnel(ctx, &tunnel.RestoreTunnelRequest{TunDestIP: ips[2]})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = srv.QuarantineTunnel(ctx, &tunnel.QuarantineTunnelRequest{TunDestIP: "10.0.0.9"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	q, err := srv.ListQuarantined(ctx, nil)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{names[0], names[3]}, q.GetTunnels())
+	}
+	_, err = srv.CordonTunnel(ctx, &tunnel.CordonTunnelRequest{TunDestIP: ips[3]})
+	assert.NoError(t, err)
+
+	_, err = srv.PurgeTunnels(ctx, &tunnel.PurgeTunnelsRequest{})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.ElementsMatch(t, names, fake.names())
+
+	//by default only quarantined tunnels go, cordoned ones stay
+	purged, err := srv.PurgeTunnels(ctx, &tunnel.PurgeTunnelsRequest{Confirm: true})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{names[0]}, purged.GetRemoved())
+		assert.Equal(t, []string{names[3]}, purged.GetCordoned())
+	}
+	assert.ElementsMatch(t, names[1:], fake.names())
+	resp, err := srv.RemoveTunnel(ctx, &tunnel.RemoveTunnelRequest{TunDestIP: ips[0]})
+	if assert.NoError(t, err) {
+		assert.True(t, resp.GetReplayed(), "purged tunnel leaves tombstone")
+	}
+
+	purged, err = srv.PurgeTunnels(ctx, &tunnel.PurgeTunnelsRequest{Confirm: true, All: true, KeepTunDestIPs: []string{ips[1]}})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{names[2]}, purged.GetRemoved())
+		assert.Equal(t, []string{names[3]}, purged.GetCordoned())
+	}
+	assert.ElementsMatch(t, []string{names[1], names[3]}, fake.names())
+}
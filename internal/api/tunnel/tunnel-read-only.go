@@ -0,0 +1,33 @@
+package tunnel
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//denyMutation is the first step of every RPC which changes the host; it fails in read-only or maintenance mode
+//before anything is parsed, locked or touched
+func (srv *tunnelService) denyMutation() error {
+	if srv.readOnly {
+		return status.Error(codes.FailedPrecondition, "service is read-only")
+	}
+	if srv.inMaintenance() {
+		return status.Error(codes.FailedPrecondition, "service is in maintenance mode")
+	}
+	return nil
+}
+
+//lockForMutation takes tunnel locks of a mutating RPC and checks 'denyMutation' again under them:
+//maintenance mode could be turned on while the RPC was waiting for a slot or a lock
+func (srv *tunnelService) lockForMutation(ctx context.Context, names ...string) (unlock func(), err error) {
+	if unlock, err = srv.tunnelLocks.lockAll(ctx, names...); err != nil {
+		return nil, err
+	}
+	if err = srv.denyMutation(); err != nil {
+		unlock()
+		return nil, err
+	}
+	return unlock, nil
+}
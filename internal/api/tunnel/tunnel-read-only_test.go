@@ -0,0 +1,93 @@
+package tunnel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_ReadOnly(t *testing.T) {
+	ctx := context.Background()
+	srv := NewTunnelService(ctx, WithReadOnly()).(*tunnelService)
+	mutations := map[string]func() error{
+		"AddTunnel": func() error {
+			_, err := srv.AddTunnel(ctx, &tunnel.AddTunnelRequest{TunDestIP: "1.1.1.1"})
+			return err
+		},
+		"RemoveTunnel": func() error {
+			_, err := srv.RemoveTunnel(ctx, &tunnel.RemoveTunnelRequest{TunDestIP: "1.1.1.1"})
+			return err
+		},
+		"QuarantineTunnel": func() error {
+			_, err := srv.QuarantineTunnel(ctx, &tunnel.QuarantineTunnelRequest{TunDestIP: "1.1.1.1"})
+			return err
+		},
+		"RestoreTunnel": func() error {
+			_, err := srv.RestoreTunnel(ctx, &tunnel.RestoreTunnelRequest{TunDestIP: "1.1.1.1"})
+			return err
+		},
+		"PurgeTunnels": func() error {
+			_, err := srv.PurgeTunnels(ctx, &tunnel.PurgeTunnelsRequest{})
+			return err
+		},
+		"MigrateNames": func() error {
+			_, err := srv.MigrateNames(ctx, &tunnel.MigrateNamesRequest{})
+			return err
+		},
+		"RemoveByRemoteCIDR": func() error {
+			_, err := srv.RemoveByRemoteCIDR(ctx, &tunnel.RemoveByRemoteCIDRRequest{RemoteCIDR: "1.1.1.0/24", Confirm: true})
+			return err
+		},
+		"RepairSysctls": func() error {
+			_, err := srv.RepairSysctls(ctx, nil)
+			return err
+		},
+		"CordonTunnel": func() error {
+			_, err := srv.CordonTunnel(ctx, &tunnel.CordonTunnelRequest{TunDestIP: "1.1.1.1"})
+			return err
+		},
+		"UncordonTunnel": func() error {
+			_, err := srv.UncordonTunnel(ctx, &tunnel.UncordonTunnelRequest{TunDestIP: "1.1.1.1"})
+			return err
+		},
+		"Apply": func() error {
+			_, err := srv.Apply(ctx, &tunnel.ApplyRequest{Prune: true})
+			return err
+		},
+		"SetTunnelState": func() error {
+			_, err := srv.SetTunnelState(ctx, &tunnel.SetTunnelStateRequest{TunDestIP: "1.1.1.1", Up: true})
+			return err
+		},
+		"SwapRemote": func() error {
+			_, err := srv.SwapRemote(ctx, &tunnel.SwapRemoteRequest{CurrentIP: "1.1.1.1", NewIP: "2.2.2.2"})
+			return err
+		},
+		"ApplySysctls": func() error {
+			_, err := srv.ApplySysctls(ctx, &tunnel.ApplySysctlsRequest{Desired: map[string]string{"rp_filter": "2"}})
+			return err
+		},
+		"AddTunnels": func() error {
+			_, err := srv.AddTunnels(ctx, &tunnel.AddTunnelsRequest{})
+			return err
+		},
+		"RemoveTunnels": func() error {
+			_, err := srv.RemoveTunnels(ctx, &tunnel.RemoveTunnelsRequest{})
+			return err
+		},
+		"SetTunnelAlias": func() error {
+			_, err := srv.SetTunnelAlias(ctx, &tunnel.SetTunnelAliasRequest{TunDestIP: "1.1.1.1", Alias: "a"})
+			return err
+		},
+	}
+	for name, call := range mutations {
+		assert.Equalf(t, codes.FailedPrecondition, status.Code(call()), "%s", name)
+	}
+	info, err := srv.GetServiceInfo(ctx, nil)
+	if assert.NoError(t, err) {
+		assert.True(t, info.GetReadOnly())
+	}
+}
@@ -0,0 +1,107 @@
+package tunnel
+
+import (
+	"context"
+	"net"
+	"sort"
+	"time"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/pkg/errors"
+	"github.com/vishvananda/netlink"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	//maxDrainSeconds the longest drain RemoveByRemoteCIDR may wait
+	maxDrainSeconds = 600
+)
+
+//parseRemoteCIDR parses 'remoteCIDR' argument of RemoveByRemoteCIDR: IPv4 network in CIDR notation
+func parseRemoteCIDR(s string) (*net.IPNet, error) {
+	ip, ret, err := net.ParseCIDR(s)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "'remoteCIDR': %v",
+			errors.Wrap(err, "net.ParseCIDR"),
+		)
+	}
+	if ip.To4() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "'remoteCIDR': '%s' is not IPv4 network", s)
+	}
+	return ret, nil
+}
+
+//RemoveByRemoteCIDR impl tunnel service
+//  - only managed tunnels named by their remote are removed; interfaces outside the managed pattern are never touched
+//  - cordoned tunnels are reported as failed with FailedPrecondition and are kept
+//  - with 'drainSeconds' each tunnel gets 'drain' change event first and is removed after that time
+//  - tunnels are removed one by one the way RemoveTunnel does, each result is reported like RemoveTunnels does
+func (srv *tunnelService) RemoveByRemoteCIDR(ctx context.Context, req *tunnel.RemoveByRemoteCIDRRequest) (resp *tunnel.BatchResponse, err error) {
+	if err = srv.denyMutation(); err != nil {
+		return nil, err
+	}
+	if err = srv.denyBulkMutation(); err != nil {
+		return nil, err
+	}
+	span := srv.spanOf(ctx)
+	span.SetAttributes(attribute.String("remoteCIDR", req.GetRemoteCIDR()))
+	defer func() {
+		err = srv.correctError(err)
+	}()
+
+	var remotes *net.IPNet
+	if remotes, err = parseRemoteCIDR(req.GetRemoteCIDR()); err != nil {
+		return nil, err
+	}
+	if req.GetDrainSeconds() > maxDrainSeconds {
+		return nil, status.Errorf(codes.InvalidArgument, "'drainSeconds': %v is above maximum %v", req.GetDrainSeconds(), maxDrainSeconds)
+	}
+	if !req.GetConfirm() {
+		return nil, status.Errorf(codes.FailedPrecondition, "'confirm': is required to remove tunnels to '%s'", remotes)
+	}
+	var victims []net.IP
+	names, cordoned := make(map[string]string), make(map[string]bool)
+	err = srv.enumLinks(func(nl netlink.Link) error {
+		t, ok := nl.(*netlink.Iptun)
+		if ok && namedAfterRemote(nl) && remotes.Contains(t.Remote) {
+			victims = append(victims, t.Remote)
+			names[t.Remote.String()] = nl.Attrs().Name
+			cordoned[nl.Attrs().Name] = isCordoned(nl)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(victims, func(i, j int) bool {
+		return tunnelIndexOf(victims[i]) < tunnelIndexOf(victims[j])
+	})
+	span.SetAttributes(attribute.Int("tunnels", len(victims)))
+
+	if drain := time.Duration(req.GetDrainSeconds()) * time.Second; drain > 0 && len(victims) > 0 {
+		for _, ip := range victims {
+			if name := names[ip.String()]; !cordoned[name] {
+				srv.notifyChange(ctx, webhookActionDrain, name, ip.String())
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(drain):
+		}
+	}
+	ips := make([]string, 0, len(victims))
+	for _, ip := range victims {
+		ips = append(ips, ip.String())
+	}
+	results := runBatch(ips, func(i int) (*tunnel.TunnelInfo, error) {
+		if name := names[ips[i]]; cordoned[name] {
+			return nil, status.Errorf(codes.FailedPrecondition, "tunnel '%s' is cordoned", name)
+		}
+		_, e := srv.RemoveTunnel(ctx, &tunnel.RemoveTunnelRequest{TunDestIP: ips[i], VerifyRemote: true})
+		return nil, e
+	})
+	return &tunnel.BatchResponse{Results: results}, nil
+}
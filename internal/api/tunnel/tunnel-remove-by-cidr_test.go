@@ -0,0 +1,47 @@
+package tunnel
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/stretchr/testify/assert"
+	"github.com/vishvananda/netlink"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_RemoveByRemoteCIDR(t *testing.T) {
+	ctx := context.Background()
+	srv := NewTunnelService(ctx).(*tunnelService)
+	ip := func(s string) net.IP { return net.ParseIP(s).To4() }
+	srv.linkList = func() ([]netlink.Link, error) {
+		return []netlink.Link{
+			&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: mustTunnelName(ip("1.1.1.9"))}, Remote: ip("1.1.1.9")},
+			&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: mustTunnelName(ip("1.1.1.1"))}, Remote: ip("1.1.1.1")},
+			&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: mustTunnelName(ip("2.2.2.2"))}, Remote: ip("2.2.2.2")},
+			&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun5"}, Remote: ip("1.1.1.5")},
+			&netlink.Dummy{LinkAttrs: netlink.LinkAttrs{Name: "tun6"}},
+		}, nil
+	}
+	cases := []struct {
+		req  *tunnel.RemoveByRemoteCIDRRequest
+		code codes.Code
+	}{
+		{&tunnel.RemoveByRemoteCIDRRequest{RemoteCIDR: "1.1.1.0/24"}, codes.FailedPrecondition},
+		{&tunnel.RemoveByRemoteCIDRRequest{RemoteCIDR: "1.1.1.1", Confirm: true}, codes.InvalidArgument},
+		{&tunnel.RemoveByRemoteCIDRRequest{RemoteCIDR: "::/0", Confirm: true}, codes.InvalidArgument},
+		{&tunnel.RemoveByRemoteCIDRRequest{RemoteCIDR: "1.1.1.0/24", Confirm: true, DrainSeconds: 601}, codes.InvalidArgument},
+	}
+	for i, c := range cases {
+		_, err := srv.RemoveByRemoteCIDR(ctx, c.req)
+		assert.Equalf(t, c.code, status.Code(err), "case #%v", i)
+	}
+
+	resp, err := srv.RemoveByRemoteCIDR(ctx, &tunnel.RemoveByRemoteCIDRRequest{RemoteCIDR: "1.1.1.0/24", Confirm: true})
+	if assert.NoError(t, err) && assert.Len(t, resp.GetResults(), 2) {
+		assert.Equal(t, "1.1.1.1", resp.GetResults()[0].GetTunDestIP())
+		assert.Equal(t, "1.1.1.9", resp.GetResults()[1].GetTunDestIP())
+	}
+}
@@ -0,0 +1,87 @@
+package tunnel
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/gradusp/go-platform/logger"
+	"github.com/vishvananda/netlink"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+//safeMode protects interfaces which match detect rule but the service can not prove it owns
+//  - such interfaces are detected once at startup
+//  - until they are acknowledged ('WithUnmanagedAcknowledged' or AcknowledgeUnmanaged RPC) bulk RPCs
+//    which may touch them (PurgeTunnels, MigrateNames) are refused
+//  - RPCs on a single tunnel by explicit IP are allowed anyway
+//  - acknowledged or not, interfaces the service does not own are never touched by bulk RPCs
+//    (PurgeTunnels, MigrateNames, RemoveByRemoteCIDR, Apply, maintenance, RepairSysctls, ApplySysctls)
+type safeMode struct {
+	mu        sync.Mutex
+	unmanaged []string
+	acked     bool
+}
+
+//isOwnedLink link carries service labels or it is IPIP tunnel named after its remote
+func isOwnedLink(link netlink.Link) bool {
+	if strings.HasPrefix(link.Attrs().Alias, aliasLabelsPrefix) {
+		return true
+	}
+	return namedAfterRemote(link)
+}
+
+//detectUnmanaged finds interfaces the service does not own; failed detection does not turn safe mode on
+func (srv *tunnelService) detectUnmanaged(ctx context.Context) {
+	var names []string
+	err := srv.enumLinks(func(nl netlink.Link) error {
+		if !isOwnedLink(nl) {
+			names = append(names, nl.Attrs().Name)
+		}
+		return nil
+	})
+	log := logger.FromContext(ctx)
+	if err != nil {
+		log.Warnf("safe mode: unmanaged interfaces are not detected: %v", err)
+		return
+	}
+	sort.Strings(names)
+	srv.safe.mu.Lock()
+	defer srv.safe.mu.Unlock()
+	srv.safe.unmanaged = names
+	if len(names) > 0 && !srv.safe.acked {
+		log.Warnf("safe mode: bulk operations are refused until unmanaged interfaces %v are acknowledged", names)
+	}
+}
+
+//unmanagedLinks interfaces detected as unmanaged and whether safe mode is on
+func (srv *tunnelService) unmanagedLinks() (names []string, on bool) {
+	srv.safe.mu.Lock()
+	defer srv.safe.mu.Unlock()
+	return append([]string(nil), srv.safe.unmanaged...), len(srv.safe.unmanaged) > 0 && !srv.safe.acked
+}
+
+//denyBulkMutation fails bulk RPC in safe mode
+func (srv *tunnelService) denyBulkMutation() error {
+	if names, on := srv.unmanagedLinks(); on {
+		return status.Errorf(codes.FailedPrecondition,
+			"service is in safe mode because of unmanaged interfaces %v; acknowledge them by AcknowledgeUnmanaged", names)
+	}
+	return nil
+}
+
+//AcknowledgeUnmanaged impl tunnel service
+func (srv *tunnelService) AcknowledgeUnmanaged(ctx context.Context, _ *emptypb.Empty) (*tunnel.AcknowledgeUnmanagedResponse, error) {
+	srv.safe.mu.Lock()
+	srv.safe.acked = true
+	names := append([]string(nil), srv.safe.unmanaged...)
+	srv.safe.mu.Unlock()
+	if len(names) > 0 {
+		logger.FromContext(ctx).Warnf("safe mode: unmanaged interfaces %v are acknowledged", names)
+	}
+	return &tunnel.AcknowledgeUnmanagedResponse{Acknowledged: names}, nil
+}
@@ -0,0 +1,92 @@
+package tunnel
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/stretchr/testify/assert"
+	"github.com/vishvananda/netlink"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_SafeMode(t *testing.T) {
+	ctx := context.Background()
+	srv := NewTunnelService(ctx).(*tunnelService)
+	srv.linkList = func() ([]netlink.Link, error) {
+		return []netlink.Link{
+			&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun16843009"}, Remote: net.ParseIP("1.1.1.1")},
+			&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun5", Alias: "crispy-tunnel:weight=1"}, Remote: net.ParseIP("2.2.2.2")},
+			&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun7"}, Remote: net.ParseIP("3.3.3.3")},
+			&netlink.Tuntap{LinkAttrs: netlink.LinkAttrs{Name: "tun0"}},
+			&netlink.Dummy{LinkAttrs: netlink.LinkAttrs{Name: "eth0"}},
+		}, nil
+	}
+	srv.safe.acked = false
+	srv.detectUnmanaged(ctx)
+	info, err := srv.GetServiceInfo(ctx, nil)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"tun0", "tun7"}, info.GetUnmanagedLinks())
+		assert.True(t, info.GetSafeMode())
+	}
+	_, err = srv.PurgeTunnels(ctx, &tunnel.PurgeTunnelsRequest{})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = srv.MigrateNames(ctx, &tunnel.MigrateNamesRequest{})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	ack, err := srv.AcknowledgeUnmanaged(ctx, nil)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"tun0", "tun7"}, ack.GetAcknowledged())
+	}
+	assert.NoError(t, srv.denyBulkMutation())
+	info, err = srv.GetServiceInfo(ctx, nil)
+	if assert.NoError(t, err) {
+		assert.False(t, info.GetSafeMode())
+	}
+
+	srv = NewTunnelService(ctx, WithUnmanagedAcknowledged()).(*tunnelService)
+	srv.safe.unmanaged = []string{"tun0"}
+	assert.NoError(t, srv.denyBulkMutation())
+}
+
+func Test_BulkSkipsUnmanaged(t *testing.T) {
+	ctx := context.Background()
+	srv := NewTunnelService(ctx, WithExecEnv(fakeCommands(t, "sysctl"))).(*tunnelService)
+	const unmanaged = "tun7"
+	fake := useFakeNetlink(srv,
+		&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: unmanaged, Flags: net.FlagUp}, Remote: net.ParseIP("10.0.0.7")},
+	)
+	_, err := srv.PurgeTunnels(ctx, &tunnel.PurgeTunnelsRequest{Confirm: true, All: true})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = srv.AcknowledgeUnmanaged(ctx, nil)
+	assert.NoError(t, err)
+
+	add := func(ips ...string) {
+		for _, ip := range ips {
+			_, e := srv.AddTunnel(ctx, &tunnel.AddTunnelRequest{TunDestIP: ip})
+			assert.NoError(t, e, ip)
+		}
+	}
+	add("10.0.0.1")
+	purged, err := srv.PurgeTunnels(ctx, &tunnel.PurgeTunnelsRequest{Confirm: true, All: true})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{mustTunnelName(net.ParseIP("10.0.0.1"))}, purged.GetRemoved())
+	}
+	add("10.0.0.2")
+	_, err = srv.RemoveByRemoteCIDR(ctx, &tunnel.RemoveByRemoteCIDRRequest{RemoteCIDR: "10.0.0.0/24", Confirm: true})
+	assert.NoError(t, err)
+	_, err = srv.Apply(ctx, &tunnel.ApplyRequest{Tunnels: []*tunnel.AddTunnelRequest{{TunDestIP: "10.0.0.3"}}, Prune: true})
+	assert.NoError(t, err)
+	_, err = srv.MigrateNames(ctx, &tunnel.MigrateNamesRequest{})
+	assert.NoError(t, err)
+	_, err = srv.SetMaintenanceMode(ctx, &tunnel.SetMaintenanceModeRequest{Enabled: true, BringDown: true})
+	assert.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{unmanaged, mustTunnelName(net.ParseIP("10.0.0.3"))}, fake.names())
+	for _, call := range fake.calls {
+		assert.NotEqual(t, unmanaged, call[strings.LastIndexByte(call, ' ')+1:], call)
+	}
+}
@@ -0,0 +1,330 @@
+package tunnel
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	//DefaultReadTimeout default deadline for read RPCs (GetState, GetTunnel, DiffState)
+	DefaultReadTimeout = 10 * time.Second
+
+	//DefaultWriteTimeout default deadline for write RPCs (AddTunnel, RemoveTunnel)
+	DefaultWriteTimeout = 30 * time.Second
+)
+
+//TunnelServiceOption option of tunnel service
+type TunnelServiceOption func(*tunnelService)
+
+//WithDefaultTimeout sets both read and write default deadlines
+func WithDefaultTimeout(d time.Duration) TunnelServiceOption {
+	return func(srv *tunnelService) {
+		srv.markSet(cfgReadTimeout, cfgWriteTimeout)
+		srv.readTimeout = d
+		srv.writeTimeout = d
+	}
+}
+
+//WithReadTimeout sets default deadline for read RPCs; zero or negative turns it off
+func WithReadTimeout(d time.Duration) TunnelServiceOption {
+	return func(srv *tunnelService) {
+		srv.markSet(cfgReadTimeout)
+		srv.readTimeout = d
+	}
+}
+
+//WithWriteTimeout sets default deadline for write RPCs; zero or negative turns it off
+func WithWriteTimeout(d time.Duration) TunnelServiceOption {
+	return func(srv *tunnelService) {
+		srv.markSet(cfgWriteTimeout)
+		srv.writeTimeout = d
+	}
+}
+
+//withDefaultDeadline applies default deadline 'd' only when caller did not supply its own one;
+//caller provided deadline always takes precedence even if it is longer than the default
+func withDefaultDeadline(ctx context.Context, d time.Duration) (context.Context, context.CancelFunc) {
+	if _, has := ctx.Deadline(); has || d <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, d)
+}
+
+//WithExecTracePropagation turns on passing of W3C trace context ('TRACEPARENT'/'TRACESTATE' env vars)
+//to external commands the service runs; it is off by default
+func WithExecTracePropagation(on bool) TunnelServiceOption {
+	return func(srv *tunnelService) {
+		srv.markSet(cfgExecTracePropagation)
+		srv.execTracePropagation = on
+	}
+}
+
+//WithTunnelIndexAnnotation adds derived integer index of tunnel (the number in its name) to AddTunnel/RemoveTunnel spans
+//as numeric 'tunnel-index' attribute and to their logs as structured field; it is off by default
+func WithTunnelIndexAnnotation(on bool) TunnelServiceOption {
+	return func(srv *tunnelService) {
+		srv.markSet(cfgTunnelIndexAnnotation)
+		srv.tunnelIndexAnnotation = on
+	}
+}
+
+//WithHealthDebounce hides brief tunnel flaps from TestAllTunnels and WatchTunnelEvents: tunnel is reported
+//down once it is observed down for 'downGrace' and up again once it is observed up for 'upStable';
+//zero windows (default) report every change at once
+func WithHealthDebounce(downGrace, upStable time.Duration) TunnelServiceOption {
+	return func(srv *tunnelService) {
+		srv.markSet(cfgHealthDownGrace, cfgHealthUpStable)
+		srv.healthDownGrace, srv.healthUpStable = downGrace, upStable
+	}
+}
+
+//WithMaintenanceStateFile keeps maintenance mode in file 'path' so restart in the middle of maintenance
+//resumes it; the file exists while the mode is on. Without it (default) the service starts out of maintenance
+func WithMaintenanceStateFile(path string) TunnelServiceOption {
+	return func(srv *tunnelService) {
+		srv.markSet(cfgMaintenanceFile)
+		srv.maintenanceFile = path
+	}
+}
+
+//WithTunnelMetrics turns on per tunnel metrics ('NewTunnelMetrics'); they are off by default
+func WithTunnelMetrics(m *TunnelMetrics) TunnelServiceOption {
+	return func(srv *tunnelService) {
+		srv.markSet(cfgTunnelMetrics)
+		m.src.Store(srv)
+		srv.collectors = append(srv.collectors, m)
+	}
+}
+
+//WithExecEnv makes external commands run with fixed environment 'env' (e.g. 'LC_ALL=C', 'PATH='+DefaultExecPath)
+//instead of inherited one; commands without path are looked up by PATH of 'env'.
+//External commands inherit environment of the service by default
+func WithExecEnv(env []string) TunnelServiceOption {
+	return func(srv *tunnelService) {
+		srv.markSet(cfgExecEnv)
+		srv.execEnv = append(make([]string, 0, len(env)), env...)
+	}
+}
+
+//WithProcPath sets procfs mount point the service reads/writes sysctls through; 'DefaultProcPath' by default
+func WithProcPath(path string) TunnelServiceOption {
+	return func(srv *tunnelService) {
+		srv.markSet(cfgProcPath)
+		srv.procPath = path
+	}
+}
+
+//WithHealthCache sets TTL and jitter of health result cache; zero or negative TTL turns the cache off
+func WithHealthCache(ttl, jitter time.Duration) TunnelServiceOption {
+	return func(srv *tunnelService) {
+		srv.markSet(cfgHealthCacheTTL, cfgHealthCacheJitter)
+		srv.healthTTL, srv.healthJitter = ttl, jitter
+	}
+}
+
+//WithMaxConcurrency sets how many RPCs may change/read tunnels concurrently; 1 by default.
+//Operations over the same tunnel are always serialized
+func WithMaxConcurrency(n int) TunnelServiceOption {
+	return func(srv *tunnelService) {
+		srv.markSet(cfgMaxConcurrency)
+		srv.maxConcurrency = n
+	}
+}
+
+//WithMinMtu forbids creating tunnels with explicit MTU below 'mtu'; zero (default) means no floor
+func WithMinMtu(mtu uint32) TunnelServiceOption {
+	return func(srv *tunnelService) {
+		srv.markSet(cfgMinMtu)
+		srv.minMtu = mtu
+	}
+}
+
+//withRequestDeadline applies per request timeout 'timeoutMs' if it is set, otherwise default deadline 'd'
+//  - per request timeout only shortens caller provided deadline and never extends it
+//  - if caller did not supply deadline the per request timeout sets one instead of the default
+func withRequestDeadline(ctx context.Context, timeoutMs uint32, d time.Duration) (context.Context, context.CancelFunc) {
+	if timeoutMs == 0 {
+		return withDefaultDeadline(ctx, d)
+	}
+	return context.WithTimeout(ctx, time.Duration(timeoutMs)*time.Millisecond)
+}
+
+//WithExecMetrics turns on external command metrics
+func WithExecMetrics(m *ExecMetrics) TunnelServiceOption {
+	return func(srv *tunnelService) {
+		srv.markSet(cfgExecMetrics)
+		srv.execMetrics = m
+		srv.collectors = append(srv.collectors, m)
+	}
+}
+
+//WithSpans turns on/off span attributes and events the service adds to incoming span; on by default.
+//Turning spans off saves attribute allocations and log level check on every step of every RPC
+//that are wasted when tracing is not configured. Logging is not affected.
+//Benchmark_*Spans with recording span and debug level off (Xeon, fake netlink) show the saving is small:
+//  - AddTunnel+RemoveTunnel: 484µs, 165 allocs on; 462µs, 161 allocs off (external 'sysctl' dominates)
+//  - GetState of 1000 detailed tunnels: 2.1ms, 13098 allocs either way
+func WithSpans(on bool) TunnelServiceOption {
+	return func(srv *tunnelService) {
+		srv.markSet(cfgSpans)
+		srv.spansOff = !on
+	}
+}
+
+//WithPingPath sets ping binary TestTunnel runs; 'DefaultPingPath' by default
+func WithPingPath(path string) TunnelServiceOption {
+	return func(srv *tunnelService) {
+		srv.markSet(cfgPingPath)
+		srv.pingPath = path
+	}
+}
+
+//WithFirewallBackend sets backend of per tunnel firewall rules:
+//'FirewallBackendIptables', 'FirewallBackendNft' or 'FirewallBackendAuto' (default)
+func WithFirewallBackend(backend string) TunnelServiceOption {
+	return func(srv *tunnelService) {
+		srv.markSet(cfgFirewallBackend)
+		srv.fwBackendName = backend
+	}
+}
+
+//WithIdempotencyCache sets how long and how many results of mutations are kept for replays of their idempotency keys;
+//zero or negative TTL or size turns idempotency keys off
+func WithIdempotencyCache(ttl time.Duration, maxSize int) TunnelServiceOption {
+	return func(srv *tunnelService) {
+		srv.markSet(cfgIdempotencyTTL, cfgIdempotencyCacheSize)
+		srv.idemTTL, srv.idemSize = ttl, maxSize
+	}
+}
+
+//WithRemoveTombstones makes RemoveTunnel remember removed tunnels for 'ttl' (at most 'maxSize' of them)
+//so replayed RemoveTunnel of the same tunnel succeeds with 'replayed' flag instead of NotFound.
+//Tombstones are kept in memory only; zero or negative TTL or size (default) turns them off
+func WithRemoveTombstones(ttl time.Duration, maxSize int) TunnelServiceOption {
+	return func(srv *tunnelService) {
+		srv.markSet(cfgTombstoneTTL, cfgTombstoneSize)
+		srv.tombstoneTTL, srv.tombstoneSize = ttl, maxSize
+	}
+}
+
+//WithTunnelHistory makes the service keep timeline of changes it makes to each tunnel for GetTunnelHistory:
+//at most 'perTunnel' latest changes of tunnel, each for 'ttl'. History is kept in memory only;
+//zero or negative count or TTL (default) turns it off and GetTunnelHistory answers Unimplemented
+func WithTunnelHistory(perTunnel int, ttl time.Duration) TunnelServiceOption {
+	return func(srv *tunnelService) {
+		srv.markSet(cfgHistoryPerTunnel, cfgHistoryTTL)
+		srv.historyPerTunnel, srv.historyTTL = perTunnel, ttl
+	}
+}
+
+//WithMaxTunnels limits count of tunnels AddTunnel creates; zero (default) means no limit.
+//Concurrent AddTunnel calls of different tunnels may exceed the limit by 'maxConcurrency' minus one
+func WithMaxTunnels(n uint32) TunnelServiceOption {
+	return func(srv *tunnelService) {
+		srv.markSet(cfgMaxTunnels)
+		srv.maxTunnels = n
+	}
+}
+
+//WithBestEffortSysctl makes AddTunnel keep the tunnel when rp_filter can't be set because procfs is read-only;
+//it is reported as AddTunnel warning. Off by default: AddTunnel fails and rolls the tunnel back
+func WithBestEffortSysctl(on bool) TunnelServiceOption {
+	return func(srv *tunnelService) {
+		srv.markSet(cfgBestEffortSysctl)
+		srv.bestEffortSysctl = on
+	}
+}
+
+//WithTunnelSysctls sets sysctl policy: per interface sysctls AddTunnel sets on new tunnels and RepairSysctls
+//restores; they override defaults (rp_filter=0) and ApplySysctls changes them at runtime
+func WithTunnelSysctls(desired map[string]string) TunnelServiceOption {
+	return func(srv *tunnelService) {
+		srv.markSet(cfgTunnelSysctls)
+		srv.sysctls.update(desired)
+	}
+}
+
+//WithReadOnly makes the service only report state: RPCs which change the host fail with FailedPrecondition
+func WithReadOnly() TunnelServiceOption {
+	return func(srv *tunnelService) {
+		srv.markSet(cfgReadOnly)
+		srv.readOnly = true
+	}
+}
+
+//WithOperUpWait makes AddTunnel wait up to 'd' after link-up for the tunnel to become oper-up;
+//if it does not AddTunnel still succeeds but reports 'ready' false. Zero (default) turns waiting off
+func WithOperUpWait(d time.Duration) TunnelServiceOption {
+	return func(srv *tunnelService) {
+		srv.markSet(cfgOperUpWait)
+		srv.operUpWait = d
+	}
+}
+
+//WithTemplate registers AddTunnel template 'name'; the same name registered again replaces the template
+func WithTemplate(name string, t TunnelTemplate) TunnelServiceOption {
+	return func(srv *tunnelService) {
+		srv.markSet(cfgTemplates)
+		if srv.templates == nil {
+			srv.templates = make(map[string]*TunnelTemplate)
+		}
+		srv.templates[name] = &t
+	}
+}
+
+//WithWebhook turns on posting of tunnel change events to 'url' after successful mutations;
+//each POST attempt is limited by 'timeout' and failed ones are retried up to 'retries' times
+func WithWebhook(url string, timeout time.Duration, retries int) TunnelServiceOption {
+	return func(srv *tunnelService) {
+		srv.markSet(cfgWebhookURL, cfgWebhookTimeout, cfgWebhookRetries)
+		srv.webhookURL = url
+		srv.webhookTimeout = timeout
+		srv.webhookRetries = retries
+	}
+}
+
+//WithWebhookMetrics turns on webhook delivery metrics
+func WithWebhookMetrics(m *WebhookMetrics) TunnelServiceOption {
+	return func(srv *tunnelService) {
+		srv.markSet(cfgWebhookMetrics)
+		srv.webhookMetrics = m
+		srv.collectors = append(srv.collectors, m)
+	}
+}
+
+//WithUnmanagedAcknowledged acknowledges unmanaged interfaces in advance so the service does not start in safe mode
+func WithUnmanagedAcknowledged() TunnelServiceOption {
+	return func(srv *tunnelService) {
+		srv.markSet(cfgUnmanagedAck)
+		srv.safe.acked = true
+	}
+}
+
+//WithModuleLoad makes AddTunnel load 'ipip' kernel module when it is not loaded yet;
+//modprobe is tried up to 'attempts' times with linear 'backoff' between attempts; zero attempts turn it off
+func WithModuleLoad(attempts int, backoff time.Duration) TunnelServiceOption {
+	return func(srv *tunnelService) {
+		srv.markSet(cfgModuleLoadAttempts, cfgModuleLoadBackoff)
+		srv.moduleLoadAttempts = attempts
+		srv.moduleLoadBackoff = backoff
+	}
+}
+
+//WithConcurrencyMetrics turns on metrics of semaphore utilization and RPCs waiting for it
+func WithConcurrencyMetrics(m *ConcurrencyMetrics) TunnelServiceOption {
+	return func(srv *tunnelService) {
+		srv.markSet(cfgConcurrencyMetrics)
+		m.src.Store(srv)
+		srv.collectors = append(srv.collectors, m)
+	}
+}
+
+//WithResumableAdd makes AddTunnel crash-safe: it journals each done step in tunnel labels so retry of the same
+//request after the service was interrupted continues after the last done step instead of failing with AlreadyExists
+//and failed retry keeps the tunnel with its journal for the next one
+func WithResumableAdd() TunnelServiceOption {
+	return func(srv *tunnelService) {
+		srv.markSet(cfgResumableAdd)
+		srv.resumableAdd = true
+	}
+}
@@ -12,31 +12,94 @@ import (
 	"regexp"
 	"runtime"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
 
-	netPrivate "github.com/gradusp/crispy-tunnel/internal/pkg/net"
 	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
 	"github.com/gradusp/go-platform/logger"
 	"github.com/gradusp/go-platform/pkg/slice"
 	"github.com/gradusp/go-platform/server"
 	grpcRt "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/vishvananda/netlink"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	_ "google.golang.org/grpc/encoding/gzip" //registers 'gzip' compressor; clients opt in by 'grpc.UseCompressor(gzip.Name)'
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 type tunnelService struct {
 	tunnel.UnimplementedTunnelServiceServer
 
-	appCtx context.Context
-	sema   chan struct{}
+	semaWaiting    int64 //atomic; it goes first to be 64-bit aligned
+	appCtx         context.Context
+	sema           chan struct{}
+	maxConcurrency int
+	tunnelLocks    namedLocks
+	linkList       func() ([]netlink.Link, error)
+	routeGet       func(net.IP) ([]netlink.Route, error)
+	linkByIndex    func(int) (netlink.Link, error)
+	linkAdd        func(netlink.Link) error
+	nl             netlinkOps
+	readTimeout    time.Duration
+	writeTimeout   time.Duration
+	procPath       string
+	healthTTL      time.Duration
+	healthJitter   time.Duration
+	health         *healthCache
+	minMtu         uint32
+	execMetrics    *ExecMetrics
+	spansOff       bool
+	fwBackendName  string
+	pingPath       string
+	optionsSet     map[string]bool
+	idemTTL        time.Duration
+	idemSize       int
+	idempotency    *idempotencyCache
+	maxTunnels     uint32
+
+	bestEffortSysctl     bool
+	sysctls              sysctlPolicy
+	readOnly             bool
+	operUpWait           time.Duration
+	templates            map[string]*TunnelTemplate
+	webhookURL           string
+	webhookTimeout       time.Duration
+	webhookRetries       int
+	webhookMetrics       *WebhookMetrics
+	webhook              *webhook
+	safe                 safeMode
+	moduleLoadAttempts   int
+	moduleLoadBackoff    time.Duration
+	modprobePath         string
+	sysModulePath        string
+	execTracePropagation bool
+	execEnv              []string
+	tombstoneTTL         time.Duration
+	tombstoneSize        int
+	tombstones           *tombstones
+	historyPerTunnel     int
+	historyTTL           time.Duration
+	history              *tunnelHistory
+
+	tunnelIndexAnnotation bool
+	resumableAdd          bool
+	collectors            []prometheus.Collector //metrics the service is given; GetMetricsSnapshot reads them
+	healthDownGrace       time.Duration
+	healthUpStable        time.Duration
+	healthDebounce        *healthDebounce
+	maint                 maintenance
+	maintenanceFile       string
 }
 
 var (
@@ -59,11 +122,54 @@ var (
 type listLinksConsumer = func(netlink.Link) error
 
 //NewTunnelService creates tunnel service
-func NewTunnelService(ctx context.Context) server.APIService {
+//  - read RPCs get 'DefaultReadTimeout' and write RPCs get 'DefaultWriteTimeout' deadlines by default,
+//    use 'WithReadTimeout'/'WithWriteTimeout'/'WithDefaultTimeout' options to change them
+//  - default deadlines apply only when caller did not supply its own one
+func NewTunnelService(ctx context.Context, opts ...TunnelServiceOption) server.APIService {
 	ret := &tunnelService{
-		appCtx: ctx,
-		sema:   make(chan struct{}, 1),
+		appCtx:         ctx,
+		maxConcurrency: 1,
+		linkList:       netlink.LinkList,
+		routeGet:       netlink.RouteGet,
+		linkByIndex:    netlink.LinkByIndex,
+		linkAdd:        netlink.LinkAdd,
+		nl:             new(netlink.Handle),
+		readTimeout:    DefaultReadTimeout,
+		writeTimeout:   DefaultWriteTimeout,
+		procPath:       DefaultProcPath,
+		pingPath:       DefaultPingPath,
+		modprobePath:   DefaultModprobePath,
+		sysModulePath:  DefaultSysModulePath,
+		healthTTL:      DefaultHealthCacheTTL,
+		healthJitter:   DefaultHealthCacheJitter,
+		idemTTL:        DefaultIdempotencyTTL,
+		idemSize:       DefaultIdempotencyCacheSize,
+		webhookTimeout: DefaultWebhookTimeout,
+		webhookRetries: DefaultWebhookRetries,
+	}
+	for _, o := range opts {
+		o(ret)
+	}
+	if ret.maxConcurrency < 1 {
+		ret.maxConcurrency = 1
+	}
+	ret.sema = make(chan struct{}, ret.maxConcurrency)
+	ret.health = newHealthCache(ret.healthTTL, ret.healthJitter, ret.probeHealth)
+	ret.idempotency = newIdempotencyCache(ret.idemTTL, ret.idemSize)
+	ret.tombstones = newTombstones(ret.tombstoneTTL, ret.tombstoneSize)
+	ret.history = newTunnelHistory(ret.historyPerTunnel, ret.historyTTL)
+	ret.healthDebounce = newHealthDebounce(ret.healthDownGrace, ret.healthUpStable)
+	if err := ret.validateSysctlPolicy(); err != nil {
+		logger.FromContext(ctx).Errorf("sysctl policy is reset to defaults: %v", err)
+		ret.sysctls.desired = nil
 	}
+	ret.detectUnmanaged(ctx)
+	ret.loadMaintenance(ctx)
+	if len(ret.webhookURL) > 0 {
+		ret.webhook = newWebhook(ret.webhookURL, ret.webhookTimeout, ret.webhookRetries, ret.webhookMetrics)
+		go ret.webhook.run(ctx)
+	}
+	ret.logEffectiveConfig(ctx)
 	runtime.SetFinalizer(ret, func(o *tunnelService) {
 		close(o.sema)
 	})
@@ -95,76 +201,392 @@ func (srv *tunnelService) RegisterProxyGW(ctx context.Context, mux *grpcRt.Serve
 }
 
 //AddTunnel impl tunnel service
-func (srv *tunnelService) AddTunnel(ctx context.Context, req *tunnel.AddTunnelRequest) (resp *emptypb.Empty, err error) {
+func (srv *tunnelService) AddTunnel(ctx context.Context, req *tunnel.AddTunnelRequest) (*tunnel.AddTunnelResponse, error) {
+	if err := srv.denyMutation(); err != nil {
+		return nil, err
+	}
+	resp, err := srv.idempotency.do(ctx, "AddTunnel", req.GetIdempotencyKey(), req, func() (proto.Message, error) {
+		return srv.addTunnel(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*tunnel.AddTunnelResponse), nil
+}
+
+//addTunnel creates tunnel.
+//Template defaults are applied to request fields which are not set before anything else.
+//Steps go in order: module-load, link-add, link-flags, mss-clamp, fwmark, link-up, multicast-routes, policy-route,
+//rp-filter, template-sysctls.
+//If any step after link-add fails the tunnel and its firewall rules are rolled back.
+//With 'startDown' link-up is skipped and the tunnel stays admin-down until SetTunnelState;
+//rp_filter sysctl exists since link-add so it is applied to down tunnel as well.
+//Multicast and policy routes need link to be up so they can't be combined with 'startDown'
+func (srv *tunnelService) addTunnel(ctx context.Context, req *tunnel.AddTunnelRequest) (resp *tunnel.AddTunnelResponse, err error) {
 	tunnelIP := req.GetTunDestIP()
 
-	span := trace.SpanFromContext(ctx)
+	span := srv.spanOf(ctx)
 	span.SetAttributes(attribute.String("tunDestIP", tunnelIP))
 
+	ctx, cancel := withRequestDeadline(ctx, req.GetTimeoutMs(), srv.writeTimeout)
+	defer cancel()
+
 	var leave func()
 	if leave, err = srv.enter(ctx); err != nil {
 		return nil, err
 	}
+	phase := addPhaseParse
 	defer func() {
 		leave()
-		err = srv.correctError(err)
+		err = withFailedPhase(srv.correctError(err), phase)
 	}()
-	resp = new(emptypb.Empty)
 
 	var hcTunDestNetIP net.IP
-	if hcTunDestNetIP, _, err = net.ParseCIDR(tunnelIP + mask32); err != nil {
-		err = status.Errorf(codes.InvalidArgument, "'tunDestIP': %v",
-			errors.Wrap(err, "net.ParseCIDR"),
-		)
+	if hcTunDestNetIP, err = parseTunDestIP(tunnelIP); err != nil {
 		return
 	}
 	span.SetAttributes(attribute.String("hcTunDestNetIP", hcTunDestNetIP.String()))
-	tunnelName := fmt.Sprintf("tun%v", netPrivate.IPType(hcTunDestNetIP).Int())
+	var tunnelName string
+	if tunnelName, err = TunnelNameForIP(hcTunDestNetIP); err != nil {
+		return
+	}
 	span.SetAttributes(attribute.String("tunnel-name", tunnelName))
-
-	if _, err = netlink.LinkByName(tunnelName); err == nil {
-		err = status.Errorf(codes.AlreadyExists, "tunnel '%v'", tunnelName)
+	srv.annotateTunnelIndex(span, hcTunDestNetIP)
+	var tmpl *TunnelTemplate
+	if tmpl, err = srv.templateOf(req.GetTemplate()); err != nil {
 		return
-	} else if !errors.As(err, new(netlink.LinkNotFoundError)) {
-		err = errors.Wrapf(err, "netlink.LinkByName('%s')", tunnelName)
+	}
+	req = tmpl.apply(req)
+	if err = srv.validateAddTunnelFields(tunnelTypeIpip, req); err != nil {
 		return
 	}
-	linkNew := &netlink.Iptun{
-		LinkAttrs: netlink.LinkAttrs{Name: tunnelName},
-		Remote:    hcTunDestNetIP,
+	mss, _ := mssOfRequest(req)
+	mcastRoutes, _ := parseMulticastRoutes(req.GetMulticastRoutes())
+	policy, _ := parsePolicyRoute(req.GetPolicyRoute())
+	shaping, _ := parseEgressShaping(req.GetEgressRateBps(), req.GetQdisc())
+	if err = srv.checkAddLabelsLen(req, tmpl, mss, policy, shaping); err != nil {
+		return
+	}
+	var underlay netlink.Link
+	if devName := req.GetUnderlayDev(); len(devName) > 0 {
+		span.SetAttributes(attribute.String("underlayDev", devName))
+		if underlay, err = resolveUnderlayDev(srv.nl, devName); err != nil {
+			return
+		}
 	}
 
-	srv.addSpanDbgEvent(ctx, span, "netlink.LinkAdd",
-		trace.WithAttributes(
-			attribute.String("LinkAttrs.Name", tunnelName),
-			attribute.Stringer("Remote", hcTunDestNetIP),
-		))
-	if err = netlink.LinkAdd(linkNew); err != nil {
-		err = errors.Wrapf(err, "netlink.LinkAdd('%v')", tunnelName)
+	var unlock func()
+	if unlock, err = srv.lockForMutation(ctx, tunnelName); err != nil {
 		return
 	}
-	srv.addSpanDbgEvent(ctx, span, "netlink.LinkSetUp")
-	if err = netlink.LinkSetUp(linkNew); err != nil {
-		err = errors.Wrapf(err, "netlink.LinkSetUp('%v')", tunnelName)
+	defer unlock()
+	phase = addPhaseExistsCheck
+	var resume addResume
+	reqHash := addRequestHash(req)
+	var linkNew *netlink.Iptun
+	if existing, e := srv.nl.LinkByName(tunnelName); e == nil {
+		if !srv.resumableAdd {
+			err = status.Errorf(codes.AlreadyExists, "tunnel '%v'", tunnelName)
+			return
+		}
+		if resume, err = resumeOf(existing, hcTunDestNetIP, reqHash); err != nil {
+			return
+		}
+		linkNew = existing.(*netlink.Iptun)
+		span.SetAttributes(attribute.String("resume-after", resume.step))
+	} else if !errors.As(e, new(netlink.LinkNotFoundError)) {
+		err = errors.Wrapf(e, "netlink.LinkByName('%s')", tunnelName)
 		return
 	}
-	srv.addSpanDbgEvent(ctx, span, "newRpFilter",
-		trace.WithAttributes(
-			attribute.String("tunnelName", tunnelName),
-		),
-	)
-	if err = srv.newRpFilter(ctx, tunnelName); err != nil {
-		err = errors.Wrapf(err, "newRpFilter(%s)", tunnelName)
+	//step tells if step 'p' is to be done and makes it the current phase
+	step := func(p string) bool {
+		phase = p
+		return !resume.done(p)
 	}
-	return //nolint:nakedret
+	labels := make(linkLabels)
+	//created tells the link is made by this call; resumed link is never rolled back
+	//so the journal stays for the next retry
+	created := false
+	if linkNew == nil {
+		phase = addPhaseOverlapCheck
+		if err = srv.checkOverlap(tunnelName, tunnelOverlapKey(tunnelTypeIpip, nil, hcTunDestNetIP)); err != nil {
+			return
+		}
+		phase = addPhaseCapacity
+		if err = srv.checkCapacity(0); err != nil {
+			return
+		}
+		phase = addPhaseModuleLoad
+		if err = srv.ensureModule(ctx, span, ipipModule); err != nil {
+			return
+		}
+		linkNew = &netlink.Iptun{
+			LinkAttrs: netlink.LinkAttrs{Name: tunnelName},
+			Remote:    hcTunDestNetIP,
+		}
+		if req.GetMulticast() == tunnel.LinkFlag_LINK_FLAG_ON {
+			linkNew.Flags |= net.FlagMulticast
+		}
+		if underlay != nil {
+			linkNew.Link = uint32(underlay.Attrs().Index)
+		}
+		if mtu := req.GetMtu(); mtu > 0 {
+			linkNew.MTU = int(mtu)
+		}
+		linkNew.Ttl = uint8(req.GetTtl())
+
+		phase = addPhaseLinkAdd
+		srv.addSpanDbgEvent(ctx, span, "netlink.LinkAdd",
+			trace.WithAttributes(
+				attribute.String("LinkAttrs.Name", tunnelName),
+				attribute.Stringer("Remote", hcTunDestNetIP),
+			))
+		if err = srv.addTunnelLink(linkNew); err != nil {
+			return
+		}
+		created = true
+	} else {
+		//labels journaled by interrupted attempt tell what it has installed
+		labels = labelsOf(linkNew)
+	}
+	defer func() {
+		if err != nil && created {
+			srv.rollbackAddTunnel(linkNew, labels)
+		}
+	}()
+	if len(resume.step) == 0 {
+		if err = srv.journalAddStep(linkNew, labels, reqHash, addPhaseLinkAdd); err != nil {
+			return
+		}
+	}
+	if step(addPhaseLinkFlags) {
+		if err = srv.applyLinkFlags(ctx, span, linkNew, req); err != nil {
+			return
+		}
+		if err = srv.journalAddStep(linkNew, labels, reqHash, phase); err != nil {
+			return
+		}
+	}
+	if len(mss) > 0 && step(addPhaseMssClamp) {
+		var backend string
+		if backend, err = srv.firewallBackend(); err != nil {
+			return
+		}
+		srv.addSpanDbgEvent(ctx, span, "addMssClamp",
+			trace.WithAttributes(attribute.String("backend", backend), attribute.String("mss", mss)),
+		)
+		//interrupted attempt may have installed the rule without journaling it
+		var installed bool
+		if len(resume.step) > 0 {
+			if installed, err = srv.hasMssClamp(ctx, backend, tunnelName, mss); err != nil {
+				return
+			}
+		}
+		if !installed {
+			if err = srv.addMssClamp(ctx, backend, tunnelName, mss); err != nil {
+				return
+			}
+		}
+		labels[labelMss], labels[labelMssBackend] = mss, backend
+		if err = srv.journalAddStep(linkNew, labels, reqHash, phase); err != nil {
+			return
+		}
+	}
+	if m := req.GetFwMark(); len(m) > 0 && step(addPhaseFwMark) {
+		mark, _ := parseFwMark(m)
+		var backend string
+		if backend, err = srv.firewallBackend(); err != nil {
+			return
+		}
+		srv.addSpanDbgEvent(ctx, span, "addFwMark",
+			trace.WithAttributes(attribute.String("backend", backend), attribute.Stringer("mark", mark)),
+		)
+		var installed bool
+		if len(resume.step) > 0 {
+			if installed, err = srv.hasFwMark(ctx, backend, tunnelName, mark); err != nil {
+				return
+			}
+		}
+		if !installed {
+			if err = srv.addFwMark(ctx, backend, tunnelName, mark); err != nil {
+				return
+			}
+		}
+		labels[labelFwMark], labels[labelFwBackend] = mark.String(), backend
+		if err = srv.journalAddStep(linkNew, labels, reqHash, phase); err != nil {
+			return
+		}
+	}
+	if shaping != nil && step(addPhaseShaping) {
+		//qdisc does not need the link up so tunnel never passes traffic unshaped
+		srv.addSpanDbgEvent(ctx, span, "addEgressShaping",
+			trace.WithAttributes(attribute.Stringer("shaping", shaping)),
+		)
+		if err = addEgressShaping(srv.nl, linkNew, shaping); err != nil {
+			return
+		}
+		shaping.setLabels(labels)
+		if err = srv.journalAddStep(linkNew, labels, reqHash, phase); err != nil {
+			return
+		}
+	}
+	if step(addPhaseLinkLabels) {
+		labels.setRequestLabels(req, tmpl, policy)
+		if srv.resumableAdd {
+			err = srv.journalAddStep(linkNew, labels, reqHash, phase)
+		} else if len(labels) > 0 {
+			srv.addSpanDbgEvent(ctx, span, "setLinkLabels")
+			err = setLinkLabels(srv.nl, linkNew, labels)
+		}
+		if err != nil {
+			return
+		}
+	}
+	if !req.GetStartDown() && step(addPhaseLinkUp) {
+		srv.addSpanDbgEvent(ctx, span, "netlink.LinkSetUp")
+		if err = srv.nl.LinkSetUp(linkNew); err != nil {
+			err = errors.Wrapf(err, "netlink.LinkSetUp('%v')", tunnelName)
+			return
+		}
+		if err = srv.journalAddStep(linkNew, labels, reqHash, phase); err != nil {
+			return
+		}
+	}
+	if len(mcastRoutes) > 0 && step(addPhaseMcastRoutes) {
+		srv.addSpanDbgEvent(ctx, span, "addMulticastRoutes")
+		if err = addMulticastRoutes(srv.nl, linkNew, mcastRoutes); err != nil {
+			return
+		}
+		if err = srv.journalAddStep(linkNew, labels, reqHash, phase); err != nil {
+			return
+		}
+	}
+	if policy != nil && step(addPhasePolicyRoute) {
+		srv.addSpanDbgEvent(ctx, span, "addPolicyRoute",
+			trace.WithAttributes(attribute.Stringer("policy", policy)),
+		)
+		if err = addPolicyRoute(srv.nl, linkNew, policy); err != nil {
+			//the same rule may already be there and it is not ours to roll back
+			delete(labels, labelPolicyTable)
+			return
+		}
+		if err = srv.journalAddStep(linkNew, labels, reqHash, phase); err != nil {
+			return
+		}
+	}
+	var warnings []string
+	if step(addPhaseRpFilter) {
+		srv.addSpanDbgEvent(ctx, span, "newRpFilter",
+			trace.WithAttributes(
+				attribute.String("tunnelName", tunnelName),
+			),
+		)
+		if err = srv.newRpFilter(ctx, tunnelName); err != nil {
+			if !srv.bestEffortSysctl || !srv.sysctlReadOnly(tunnelName, "rp_filter") {
+				err = errors.Wrapf(err, "newRpFilter(%s)", tunnelName)
+				return
+			}
+			w := fmt.Sprintf("rp_filter of '%s' is not set because sysctl is read-only", tunnelName)
+			logger.FromContext(ctx).Warnw(w, srv.tunnelLogFields(tunnelName, hcTunDestNetIP)...)
+			warnings, err = append(warnings, w), nil
+		}
+		if err = srv.journalAddStep(linkNew, labels, reqHash, phase); err != nil {
+			return
+		}
+	}
+	if keys, desired := srv.newTunnelSysctls(tmpl); len(keys) > 0 && step(addPhaseTemplateSysctls) {
+		srv.addSpanDbgEvent(ctx, span, "applyTunnelSysctls")
+		if _, err = srv.applyTunnelSysctls(tunnelName, keys, desired); err != nil {
+			return
+		}
+	}
+	if srv.resumableAdd {
+		//all steps are done so journal is dropped
+		phase = addPhaseLinkLabels
+		delete(labels, labelAddStep)
+		delete(labels, labelAddRequest)
+		if err = setLinkLabels(srv.nl, linkNew, labels); err != nil {
+			return
+		}
+	}
+	phase = addPhaseReadBack
+	var link netlink.Link
+	if !req.GetStartDown() && srv.operUpWait > 0 {
+		srv.addSpanDbgEvent(ctx, span, "waitOperUp")
+		link, err = srv.waitOperUp(ctx, tunnelName)
+	} else {
+		link, err = lookupTunnel(srv.nl, tunnelName)
+	}
+	if err != nil {
+		return
+	}
+	resp = &tunnel.AddTunnelResponse{
+		Warnings: warnings,
+		Ready:    operStateMatches(link, tunnel.OperStateFilter_OPER_STATE_UP),
+	}
+	if resp.Tunnel, err = srv.tunnelInfoOf(link); err != nil {
+		return nil, err
+	}
+	srv.tombstones.forget(tunnelName)
+	logger.FromContext(ctx).Debugw("tunnel is added", srv.tunnelLogFields(tunnelName, hcTunDestNetIP)...)
+	srv.notifyChange(ctx, webhookActionAdd, tunnelName, hcTunDestNetIP.String())
+	return resp, nil
+}
+
+//addTunnelLink adds tunnel link; EEXIST means another process created the interface after exists-check
+//so it is reported the way exists-check does
+func (srv *tunnelService) addTunnelLink(link *netlink.Iptun) error {
+	name := link.Attrs().Name
+	if err := srv.linkAdd(link); errors.Is(err, syscall.EEXIST) {
+		return status.Errorf(codes.AlreadyExists, "tunnel '%v'", name)
+	} else if err != nil {
+		return errors.Wrapf(err, "netlink.LinkAdd('%v')", name)
+	}
+	return nil
+}
+
+//tunnelInfoOf makes tunnel info of link together with its underlay device and multicast routes
+func (srv *tunnelService) tunnelInfoOf(link netlink.Link) (*tunnel.TunnelInfo, error) {
+	ret, err := tunnelInfoFromLink(link)
+	if err != nil {
+		return nil, err
+	}
+	if ret.UnderlayDev, err = underlayDevName(srv.nl, link); err != nil {
+		return nil, err
+	}
+	var routes []netlink.Route
+	if routes, err = listMulticastRoutes(srv.nl, link); err != nil {
+		return nil, err
+	}
+	for _, r := range routes {
+		ret.MulticastRoutes = append(ret.MulticastRoutes, r.Dst.String())
+	}
+	return ret, nil
 }
 
 //RemoveTunnel impl tunnel service
-func (srv *tunnelService) RemoveTunnel(ctx context.Context, req *tunnel.RemoveTunnelRequest) (resp *emptypb.Empty, err error) {
+func (srv *tunnelService) RemoveTunnel(ctx context.Context, req *tunnel.RemoveTunnelRequest) (*tunnel.RemoveTunnelResponse, error) {
+	if err := srv.denyMutation(); err != nil {
+		return nil, err
+	}
+	resp, err := srv.idempotency.do(ctx, "RemoveTunnel", req.GetIdempotencyKey(), req, func() (proto.Message, error) {
+		return srv.removeTunnel(ctx, req, webhookActionRemove)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*tunnel.RemoveTunnelResponse), nil
+}
+
+//removeTunnel removes tunnel; 'action' is change event the webhook gets about it
+func (srv *tunnelService) removeTunnel(ctx context.Context, req *tunnel.RemoveTunnelRequest, action string) (resp *tunnel.RemoveTunnelResponse, err error) {
 	tunnelIP := req.GetTunDestIP()
-	span := trace.SpanFromContext(ctx)
+	span := srv.spanOf(ctx)
 	span.SetAttributes(attribute.String("req-tunnel-IP", tunnelIP))
 
+	ctx, cancel := withRequestDeadline(ctx, req.GetTimeoutMs(), srv.writeTimeout)
+	defer cancel()
+
 	var leave func()
 	if leave, err = srv.enter(ctx); err != nil {
 		return nil, err
@@ -173,44 +595,105 @@ func (srv *tunnelService) RemoveTunnel(ctx context.Context, req *tunnel.RemoveTu
 		leave()
 		err = srv.correctError(err)
 	}()
-	resp = new(emptypb.Empty)
+	resp = new(tunnel.RemoveTunnelResponse)
 
 	var hcTunDestNetIP net.IP
-	if hcTunDestNetIP, _, err = net.ParseCIDR(tunnelIP + mask32); err != nil {
-		err = status.Errorf(codes.InvalidArgument, "'tunDestIP': %v",
-			errors.Wrap(err, "net.ParseCIDR"),
-		)
+	if hcTunDestNetIP, err = parseTunDestIP(tunnelIP); err != nil {
+		return
+	}
+	var tunnelName string
+	if tunnelName, err = TunnelNameForIP(hcTunDestNetIP); err != nil {
+		return
+	}
+	srv.annotateTunnelIndex(span, hcTunDestNetIP)
+
+	var unlock func()
+	if unlock, err = srv.lockForMutation(ctx, tunnelName); err != nil {
 		return
 	}
-	tunnelName := fmt.Sprintf("tun%v", netPrivate.IPType(hcTunDestNetIP).Int())
+	defer unlock()
 
 	var linkOld netlink.Link
-	linkOld, err = netlink.LinkByName(tunnelName)
+	linkOld, err = srv.nl.LinkByName(tunnelName)
 	if errors.As(err, new(netlink.LinkNotFoundError)) {
+		if srv.tombstones.has(tunnelName, hcTunDestNetIP.String()) {
+			resp.Replayed, err = true, nil
+			return
+		}
 		err = status.Errorf(codes.NotFound, "tunnel '%v' is not found", tunnelName)
 		return
 	} else if err != nil {
 		err = errors.Wrapf(err, "netlink.LinkByName(%s)", tunnelName)
 		return
 	}
+	if req.GetVerifyRemote() {
+		if err = verifyTunnelRemote(linkOld, hcTunDestNetIP); err != nil {
+			return
+		}
+	}
+	if req.GetCascadeDelete() {
+		srv.addSpanDbgEvent(ctx, span, "cascadeDelete",
+			trace.WithAttributes(attribute.String("tunnel-name", tunnelName)),
+		)
+		if err = cascadeDelete(srv.nl, linkOld, resp); err != nil {
+			return
+		}
+	}
+	srv.addSpanDbgEvent(ctx, span, "delTunnelFwRules",
+		trace.WithAttributes(attribute.String("tunnel-name", tunnelName)),
+	)
+	if err = srv.delTunnelFwRules(ctx, tunnelName, labelsOf(linkOld)); err != nil {
+		return
+	}
+	if pr, ok := policyRouteOf(labelsOf(linkOld)); ok {
+		srv.addSpanDbgEvent(ctx, span, "delPolicyRule",
+			trace.WithAttributes(attribute.String("tunnel-name", tunnelName)),
+		)
+		var removed bool
+		if removed, err = delPolicyRule(srv.nl, pr); err != nil {
+			return
+		}
+		if removed {
+			resp.RemovedRules = append(resp.RemovedRules, pr.String())
+		}
+	}
+	srv.addSpanDbgEvent(ctx, span, "delMulticastRoutes",
+		trace.WithAttributes(attribute.String("tunnel-name", tunnelName)),
+	)
+	if err = delMulticastRoutes(srv.nl, linkOld); err != nil {
+		return
+	}
+	srv.addSpanDbgEvent(ctx, span, "delEgressShaping",
+		trace.WithAttributes(attribute.String("tunnel-name", tunnelName)),
+	)
+	if err = delEgressShaping(srv.nl, linkOld); err != nil {
+		return
+	}
 	srv.addSpanDbgEvent(ctx, span, "netlink.LinkSetDown",
 		trace.WithAttributes(attribute.String("tunnel-name", tunnelName)),
 	)
-	if err = netlink.LinkSetDown(linkOld); err != nil {
+	if err = srv.nl.LinkSetDown(linkOld); err != nil {
 		err = errors.Wrapf(err, "netlink.LinkSetDown(%s)", tunnelName)
 		return
 	}
 	srv.addSpanDbgEvent(ctx, span, "netlink.LinkDel",
 		trace.WithAttributes(attribute.String("tunnel-name", tunnelName)),
 	)
-	if err = netlink.LinkDel(linkOld); err != nil {
+	if err = srv.nl.LinkDel(linkOld); err != nil {
 		err = errors.Wrapf(err, "netlink.LinkDel(%s)", tunnelName)
+		return
 	}
+	srv.tombstones.bury(tunnelName, hcTunDestNetIP.String())
+	logger.FromContext(ctx).Debugw("tunnel is removed", srv.tunnelLogFields(tunnelName, hcTunDestNetIP)...)
+	srv.notifyChange(ctx, action, tunnelName, hcTunDestNetIP.String())
 	return //nolint:nakedret
 }
 
 //GetState impl tunnel service
-func (srv *tunnelService) GetState(ctx context.Context, _ *emptypb.Empty) (*tunnel.GetStateResponse, error) {
+func (srv *tunnelService) GetState(ctx context.Context, req *tunnel.GetStateRequest) (*tunnel.GetStateResponse, error) {
+	ctx, cancel := withDefaultDeadline(ctx, srv.readTimeout)
+	defer cancel()
+
 	leave, err := srv.enter(ctx)
 	if err != nil {
 		return nil, err
@@ -219,22 +702,286 @@ func (srv *tunnelService) GetState(ctx context.Context, _ *emptypb.Empty) (*tunn
 		leave()
 		err = srv.correctError(err)
 	}()
+	if err = validateStateSortBy(req.GetSortBy()); err != nil {
+		return nil, err
+	}
+	if err = validateOperStateFilter(req.GetOperState()); err != nil {
+		return nil, err
+	}
+	if req.GetGroupByEgress() && !req.GetDetailed() {
+		return nil, status.Errorf(codes.InvalidArgument, "'groupByEgress': needs 'detailed'")
+	}
+	operState := req.GetOperState()
 	ret := new(tunnel.GetStateResponse)
-	err = srv.enumLinks(func(nl netlink.Link) error {
-		ret.Tunnels = append(ret.Tunnels, nl.Attrs().Name)
-		return nil
-	})
+	remotes := make(map[string]net.IP)
+	bound := make(map[string]int)
+	var etagKeys []string
+	//stopped is set when ctx is done in the middle of enumeration; the rest of links are skipped
+	var stopped error
+	isStopped := func() bool {
+		if stopped == nil {
+			stopped = ctx.Err()
+		}
+		return stopped != nil
+	}
+	if req.GetDetailed() {
+		ret.Problems, err = srv.enumLinksTolerant(func(nl netlink.Link) error {
+			if isStopped() || isQuarantined(nl) || !operStateMatches(nl, operState) {
+				return nil
+			}
+			ret.Tunnels = append(ret.Tunnels, nl.Attrs().Name)
+			etagKeys = append(etagKeys, linkEtagKey(nl))
+			info, e := tunnelInfoFromLink(nl)
+			if e == nil {
+				ret.Details = append(ret.Details, info)
+				remotes[info.GetName()] = net.ParseIP(info.GetRemote())
+				if t, ok := nl.(*netlink.Iptun); ok {
+					bound[info.GetName()] = int(t.Link)
+				}
+			}
+			return e
+		})
+	} else {
+		err = srv.enumLinks(func(nl netlink.Link) error {
+			if isStopped() {
+				return stopped
+			}
+			if !isQuarantined(nl) && operStateMatches(nl, operState) {
+				ret.Tunnels = append(ret.Tunnels, nl.Attrs().Name)
+				etagKeys = append(etagKeys, linkEtagKey(nl))
+				if t, ok := nl.(*netlink.Iptun); ok {
+					remotes[nl.Attrs().Name] = t.Remote
+				}
+			}
+			return nil
+		})
+	}
+	if stopped != nil {
+		if !req.GetPartialOnCancel() {
+			return nil, srv.correctError(stopped)
+		}
+		ret.Truncated, err = true, nil
+	}
 	if err != nil {
 		return nil, err
 	}
+	if !ret.Truncated {
+		//truncated answer is partial so it gets no etag and is never 'not modified'
+		ret.Etag = stateEtagOf(req, etagKeys, ret.Problems)
+		if m := req.GetIfNoneMatch(); len(m) > 0 && m == ret.Etag {
+			return &tunnel.GetStateResponse{Etag: ret.Etag, NotModified: true}, nil
+		}
+	}
 	sort.Strings(ret.Tunnels)
 	_ = slice.DedupSlice(&ret.Tunnels, func(i, j int) bool {
 		l, r := ret.Tunnels[i], ret.Tunnels[j]
 		return strings.EqualFold(l, r)
 	})
+	sortTunnelNames(ret.Tunnels, remotes, req.GetSortBy(), req.GetDescending())
+	order := make(map[string]int, len(ret.Tunnels))
+	for i, n := range ret.Tunnels {
+		order[n] = i
+	}
+	sort.Slice(ret.Details, func(i, j int) bool {
+		return order[ret.Details[i].GetName()] < order[ret.Details[j].GetName()]
+	})
+	if req.GetGroupByEgress() {
+		ret.EgressGroups = srv.groupByEgress(ret.Details, bound)
+	}
+	return ret, nil
+}
+
+//GetTunnel impl tunnel service
+func (srv *tunnelService) GetTunnel(ctx context.Context, req *tunnel.GetTunnelRequest) (resp *tunnel.TunnelInfo, err error) {
+	tunnelIP := req.GetTunDestIP()
+	span := srv.spanOf(ctx)
+	span.SetAttributes(attribute.String("tunDestIP", tunnelIP))
+
+	ctx, cancel := withDefaultDeadline(ctx, srv.readTimeout)
+	defer cancel()
+
+	var leave func()
+	if leave, err = srv.enter(ctx); err != nil {
+		return nil, err
+	}
+	defer func() {
+		leave()
+		err = srv.correctError(err)
+	}()
+
+	var hcTunDestNetIP net.IP
+	if hcTunDestNetIP, err = parseTunDestIP(tunnelIP); err != nil {
+		return nil, err
+	}
+	var tunnelName string
+	if tunnelName, err = TunnelNameForIP(hcTunDestNetIP); err != nil {
+		return nil, err
+	}
+
+	var link netlink.Link
+	link, err = srv.nl.LinkByName(tunnelName)
+	if errors.As(err, new(netlink.LinkNotFoundError)) {
+		return nil, status.Errorf(codes.NotFound, "tunnel '%v' is not found", tunnelName)
+	} else if err != nil {
+		return nil, errors.Wrapf(err, "netlink.LinkByName(%s)", tunnelName)
+	}
+	if resp, err = tunnelInfoFromLink(link); err != nil {
+		return nil, err
+	}
+	if resp.UnderlayDev, err = underlayDevName(srv.nl, link); err != nil {
+		return nil, err
+	}
+	var routes []netlink.Route
+	if routes, err = listMulticastRoutes(srv.nl, link); err != nil {
+		return nil, err
+	}
+	for _, r := range routes {
+		resp.MulticastRoutes = append(resp.MulticastRoutes, r.Dst.String())
+	}
+	return resp, nil
+}
+
+//ResolveName impl tunnel service
+func (srv *tunnelService) ResolveName(ctx context.Context, req *tunnel.ResolveNameRequest) (resp *tunnel.ResolveNameResponse, err error) {
+	tunnelIP := req.GetTunDestIP()
+	span := srv.spanOf(ctx)
+	span.SetAttributes(attribute.String("tunDestIP", tunnelIP))
+
+	defer func() {
+		err = srv.correctError(err)
+	}()
+
+	var hcTunDestNetIP net.IP
+	if hcTunDestNetIP, err = parseTunDestIP(tunnelIP); err != nil {
+		return nil, err
+	}
+	resp = new(tunnel.ResolveNameResponse)
+	if resp.Name, err = TunnelNameForIP(hcTunDestNetIP); err != nil {
+		return nil, err
+	}
+	span.SetAttributes(attribute.String("tunnel-name", resp.Name))
+	return resp, nil
+}
+
+//Health impl tunnel service
+func (srv *tunnelService) Health(ctx context.Context, _ *emptypb.Empty) (resp *tunnel.HealthResponse, err error) {
+	defer func() {
+		err = srv.correctError(err)
+	}()
+	return srv.health.get(ctx)
+}
+
+func (srv *tunnelService) probeHealth() *tunnel.HealthResponse {
+	ret := &tunnel.HealthResponse{Healthy: true}
+	err := srv.enumLinks(func(netlink.Link) error {
+		ret.Tunnels++
+		return nil
+	})
+	if err != nil {
+		ret.Healthy, ret.Error, ret.Tunnels = false, err.Error(), 0
+	}
+	return ret
+}
+
+//GetServiceInfo impl tunnel service
+func (srv *tunnelService) GetServiceInfo(_ context.Context, _ *emptypb.Empty) (*tunnel.ServiceInfo, error) {
+	ret := &tunnel.ServiceInfo{
+		MinMtu:      srv.minMtu,
+		NamePrefix:  tunnelNamePrefix,
+		DetectRule:  reDetectRule.String(),
+		TunnelTypes: tunnelTypesInfo(),
+	}
+	ret.FirewallBackend, _ = srv.firewallBackend()
+	ret.ReadOnly = srv.readOnly
+	ret.UnmanagedLinks, ret.SafeMode = srv.unmanagedLinks()
+	ret.Maintenance = srv.inMaintenance()
 	return ret, nil
 }
 
+//ValidateTunnels impl tunnel service
+func (srv *tunnelService) ValidateTunnels(_ context.Context, req *tunnel.ValidateTunnelsRequest) (*tunnel.ValidateTunnelsResponse, error) {
+	local, err := hostAddrs(srv.nl)
+	if err != nil {
+		return nil, srv.correctError(err)
+	}
+	ret := &tunnel.ValidateTunnelsResponse{
+		Results: make([]*tunnel.TunnelValidity, 0, len(req.GetTunDestIPs())),
+	}
+	for _, tunnelIP := range req.GetTunDestIPs() {
+		v := &tunnel.TunnelValidity{TunDestIP: tunnelIP, Valid: true}
+		ip, err := parseTunDestIP(tunnelIP)
+		if err == nil {
+			err = checkNotLocal(tunnelIP, ip, local)
+		}
+		if err != nil {
+			v.Valid, v.Reason = false, status.Convert(err).Message()
+		}
+		ret.Results = append(ret.Results, v)
+	}
+	return ret, nil
+}
+
+//DiffState impl tunnel service
+func (srv *tunnelService) DiffState(ctx context.Context, req *tunnel.DiffStateRequest) (resp *tunnel.DiffStateResponse, err error) {
+	ctx, cancel := withDefaultDeadline(ctx, srv.readTimeout)
+	defer cancel()
+
+	var leave func()
+	if leave, err = srv.enter(ctx); err != nil {
+		return nil, err
+	}
+	defer func() {
+		leave()
+		err = srv.correctError(err)
+	}()
+
+	desired := make([]string, 0, len(req.GetDesiredTunDestIPs()))
+	if !req.GetDryRun() {
+		for _, tunnelIP := range req.GetDesiredTunDestIPs() {
+			var ip net.IP
+			if ip, err = parseTunDestIP(tunnelIP); err != nil {
+				return nil, err
+			}
+			var name string
+			if name, err = TunnelNameForIP(ip); err != nil {
+				return nil, err
+			}
+			desired = append(desired, name)
+		}
+	}
+	resp = new(tunnel.DiffStateResponse)
+	var links []netlink.Link
+	var actual []string
+	cordoned := make(map[string]bool)
+	err = srv.enumLinks(func(nl netlink.Link) error {
+		links = append(links, nl)
+		if isCordoned(nl) {
+			cordoned[nl.Attrs().Name] = true
+			resp.Cordoned = append(resp.Cordoned, nl.Attrs().Name)
+		} else {
+			actual = append(actual, nl.Attrs().Name)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(resp.Cordoned)
+	if req.GetDryRun() {
+		resp.Plan, desired = buildDiffPlan(req.GetDesiredTunDestIPs(), links)
+	} else {
+		kept := desired[:0]
+		for _, name := range desired {
+			if !cordoned[name] {
+				kept = append(kept, name)
+			}
+		}
+		desired = kept
+	}
+	resp.ToCreate, resp.ToRemove, resp.InSync = tunnelsDiff(desired, actual)
+	return resp, nil
+}
+
 func (srv *tunnelService) correctError(err error) error {
 	if err != nil && status.Code(err) == codes.Unknown {
 		switch errors.Cause(err) {
@@ -261,31 +1008,53 @@ func (srv *tunnelService) correctError(err error) error {
 	return err
 }
 
+//spanOf gets span from context; it is no-op span if spans are turned off
+func (srv *tunnelService) spanOf(ctx context.Context) trace.Span {
+	if srv.spansOff {
+		return trace.SpanFromContext(context.Background())
+	}
+	return trace.SpanFromContext(ctx)
+}
+
 func (srv *tunnelService) addSpanDbgEvent(ctx context.Context, span trace.Span, eventName string, opts ...trace.EventOption) {
-	if logger.IsLevelEnabled(ctx, zap.DebugLevel) {
+	if !srv.spansOff && logger.IsLevelEnabled(ctx, zap.DebugLevel) {
 		span.AddEvent(eventName, opts...)
 	}
 }
 
+//newRpFilter sets rp_filter of new tunnel to the value of sysctl policy
 func (srv *tunnelService) newRpFilter(ctx context.Context, tunnelName string) error {
 	cmd := "sysctl"
-	args := fmt.Sprintf("-w net.ipv4.conf.%s.rp_filter=0", tunnelName)
-	ec, err := srv.execExternal(ctx, nil, cmd, args)
-	if err != nil {
-		return errors.Wrapf(err, "exec-of:%s %s", cmd, args)
-	}
-	if ec != 0 {
-		return errors.Errorf("exec-of:%s %s -> exit-code(%v)", cmd, args, ec)
-	}
-	return nil
+	args := fmt.Sprintf("-w net.ipv4.conf.%s.rp_filter=%s", tunnelName, srv.sysctls.get()["rp_filter"])
+	_, err := srv.execExternal(ctx, nil, nil, cmd, args)
+	return err
 }
 
-func (srv *tunnelService) execExternal(ctx context.Context, output io.Writer, command string, args ...string) (exitCode int, err error) {
-	cmd := exec.Command(command, args...) //nolint:gosec
+//execExternal runs external command; exit code out of 'accept' set is an error, nil 'accept' means only 0 is success
+func (srv *tunnelService) execExternal(ctx context.Context, output io.Writer, accept exitCodes, command string, args ...string) (exitCode int, err error) {
+	start, exitLabel, killed := time.Now(), "-1", false
+	defer func() {
+		srv.execMetrics.observe(command, exitLabel, killed, time.Since(start))
+	}()
+	var path string
+	if path, err = srv.execCommandPath(command); err != nil {
+		err = errors.Wrapf(err, "exec-of:%s %s", command, strings.Join(args, " "))
+		return
+	}
+	cmd := exec.Command(path, args...) //nolint:gosec
 	if output != nil {
 		cmd.Stdout = output
 	}
+	if srv.execEnv != nil {
+		cmd.Env = srv.execBaseEnv()
+	}
+	if srv.execTracePropagation {
+		if env := traceContextEnv(ctx); len(env) > 0 {
+			cmd.Env = append(srv.execBaseEnv(), env...)
+		}
+	}
 	if err = cmd.Start(); err != nil {
+		err = errors.Wrapf(err, "exec-of:%s %s", command, strings.Join(args, " "))
 		return
 	}
 	ch := make(chan error, 1)
@@ -299,39 +1068,88 @@ func (srv *tunnelService) execExternal(ctx context.Context, output io.Writer, co
 	case <-srv.appCtx.Done():
 		err = srv.appCtx.Err()
 	case err = <-ch:
-		if err == nil {
-			exitCode = cmd.ProcessState.ExitCode()
+		var exitErr *exec.ExitError
+		if err == nil || errors.As(err, &exitErr) {
+			err, exitCode = nil, cmd.ProcessState.ExitCode()
+			exitLabel = strconv.Itoa(exitCode)
+			if !accept.has(exitCode) {
+				err = errors.Errorf("exec-of:%s %s -> exit-code(%v)", command, strings.Join(args, " "), exitCode)
+			}
+			return
 		}
 	}
 	if err == context.Canceled || err == context.DeadlineExceeded {
 		_ = cmd.Process.Kill()
+		killed = true
+	}
+	if err != nil {
+		err = errors.Wrapf(err, "exec-of:%s %s", command, strings.Join(args, " "))
 	}
 	return
 }
 
+//exitCodes set of acceptable exit codes of external command
+type exitCodes []int
+
+func (c exitCodes) has(exitCode int) bool {
+	if c == nil {
+		return exitCode == 0
+	}
+	for _, x := range c {
+		if x == exitCode {
+			return true
+		}
+	}
+	return false
+}
+
+//heldSlotKey context key marking concurrency slot of the service held by the caller
+type heldSlotKey struct {
+	srv *tunnelService
+}
+
+//hold takes concurrency slot and returns context under which the slot is taken as already held
+//so operations the caller runs under it do not wait for another slot
+func (srv *tunnelService) hold(ctx context.Context) (context.Context, func(), error) {
+	leave, err := srv.enter(ctx)
+	if err != nil {
+		return ctx, nil, err
+	}
+	return context.WithValue(ctx, heldSlotKey{srv: srv}, true), leave, nil
+}
+
 func (srv *tunnelService) enter(ctx context.Context) (leave func(), err error) {
+	if ctx.Value(heldSlotKey{srv: srv}) != nil {
+		return func() {}, nil
+	}
+	var o sync.Once
+	leave = func() {
+		o.Do(func() {
+			<-srv.sema
+		})
+	}
+	select {
+	case srv.sema <- struct{}{}:
+		return leave, nil
+	default:
+	}
+	atomic.AddInt64(&srv.semaWaiting, 1)
+	defer atomic.AddInt64(&srv.semaWaiting, -1)
 	select {
 	case <-srv.appCtx.Done():
 		err = srv.appCtx.Err()
 	case <-ctx.Done():
 		err = ctx.Err()
 	case srv.sema <- struct{}{}:
-		var o sync.Once
-		leave = func() {
-			o.Do(func() {
-				<-srv.sema
-			})
-		}
-		return
+		return leave, nil
 	}
-	err = status.FromContextError(err).Err()
-	return
+	return nil, status.FromContextError(err).Err()
 }
 
 func (srv *tunnelService) enumLinks(c listLinksConsumer) error {
 	const api = "tunnel/enumLinks"
 
-	linkList, err := netlink.LinkList()
+	linkList, err := srv.linkList()
 	if err != nil {
 		return errors.Wrapf(err, "%s: netlink.LinkList", api)
 	}
@@ -346,3 +1164,17 @@ func (srv *tunnelService) enumLinks(c listLinksConsumer) error {
 	}
 	return nil
 }
+
+//enumLinksTolerant does not stop on consumer errors but collects them as link problems
+func (srv *tunnelService) enumLinksTolerant(c listLinksConsumer) (problems []*tunnel.LinkProblem, err error) {
+	err = srv.enumLinks(func(link netlink.Link) error {
+		if e := c(link); e != nil {
+			problems = append(problems, &tunnel.LinkProblem{
+				Name:  link.Attrs().Name,
+				Error: e.Error(),
+			})
+		}
+		return nil
+	})
+	return problems, err
+}
@@ -0,0 +1,355 @@
+package tunnel
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/vishvananda/netlink"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_EnumLinksTolerant(t *testing.T) {
+	ctx := context.Background()
+	srv := NewTunnelService(ctx).(*tunnelService)
+	srv.linkList = func() ([]netlink.Link, error) {
+		return []netlink.Link{
+			&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun1"}, Remote: net.ParseIP("1.1.1.1")},
+			&netlink.Dummy{LinkAttrs: netlink.LinkAttrs{Name: "tun2"}},
+			&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun3"}, Remote: net.ParseIP("3.3.3.3")},
+			&netlink.Dummy{LinkAttrs: netlink.LinkAttrs{Name: "eth0"}},
+		}, nil
+	}
+
+	var visited []string
+	problems, err := srv.enumLinksTolerant(func(link netlink.Link) error {
+		name := link.Attrs().Name
+		visited = append(visited, name)
+		if name == "tun1" {
+			return errors.New("bad link")
+		}
+		return nil
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, []string{"tun1", "tun2", "tun3"}, visited)
+	if assert.Len(t, problems, 1) {
+		assert.Equal(t, "tun1", problems[0].GetName())
+		assert.Equal(t, "bad link", problems[0].GetError())
+	}
+
+	resp, err := srv.GetState(ctx, &tunnel.GetStateRequest{Detailed: true})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, []string{"tun1", "tun2", "tun3"}, resp.GetTunnels())
+	if assert.Len(t, resp.GetDetails(), 2) {
+		assert.Equal(t, "tun1", resp.GetDetails()[0].GetName())
+		assert.Equal(t, "3.3.3.3", resp.GetDetails()[1].GetRemote())
+	}
+	if assert.Len(t, resp.GetProblems(), 1) {
+		assert.Equal(t, "tun2", resp.GetProblems()[0].GetName())
+	}
+}
+
+func Test_WithDefaultDeadline(t *testing.T) {
+	ctx := context.Background()
+
+	c, cancel := withDefaultDeadline(ctx, time.Minute)
+	dl, has := c.Deadline()
+	cancel()
+	if assert.True(t, has) {
+		assert.WithinDuration(t, time.Now().Add(time.Minute), dl, time.Second)
+	}
+
+	c, cancel = withDefaultDeadline(ctx, 0)
+	_, has = c.Deadline()
+	cancel()
+	assert.False(t, has)
+
+	callerCtx, callerCancel := context.WithTimeout(ctx, time.Hour)
+	defer callerCancel()
+	c, cancel = withDefaultDeadline(callerCtx, time.Second)
+	defer cancel()
+	dl, _ = c.Deadline()
+	expected, _ := callerCtx.Deadline()
+	assert.Equal(t, expected, dl)
+}
+
+func Test_ValidateTunnels(t *testing.T) {
+	ctx := context.Background()
+	srv := NewTunnelService(ctx).(*tunnelService)
+
+	ips := []string{
+		"10.1.1.1", "1.1.1", "::1", "0.0.0.0", "127.0.0.1",
+		"169.254.1.1", "224.0.0.1", "255.255.255.255",
+	}
+	resp, err := srv.ValidateTunnels(ctx, &tunnel.ValidateTunnelsRequest{TunDestIPs: ips})
+	if !assert.NoError(t, err) || !assert.Len(t, resp.GetResults(), len(ips)) {
+		return
+	}
+	for i, r := range resp.GetResults() {
+		assert.Equal(t, ips[i], r.GetTunDestIP())
+		assert.Equal(t, i == 0, r.GetValid(), r.GetTunDestIP())
+		assert.Equal(t, i == 0, len(r.GetReason()) == 0, r.GetTunDestIP())
+	}
+
+	fake := useFakeNetlink(srv)
+	local, _ := netlink.ParseAddr("10.1.1.1/24")
+	fake.addrs = append(fake.addrs, *local)
+	resp, err = srv.ValidateTunnels(ctx, &tunnel.ValidateTunnelsRequest{TunDestIPs: []string{"10.1.1.1", "10.1.1.2"}})
+	if assert.NoError(t, err) && assert.Len(t, resp.GetResults(), 2) {
+		assert.False(t, resp.GetResults()[0].GetValid())
+		assert.Contains(t, resp.GetResults()[0].GetReason(), "is local address of this host")
+		assert.True(t, resp.GetResults()[1].GetValid())
+	}
+	_, err = srv.AddTunnel(ctx, &tunnel.AddTunnelRequest{TunDestIP: "10.1.1.1"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Empty(t, fake.names())
+
+	fake.fail["AddrList"] = syscall.EPERM
+	_, err = srv.ValidateTunnels(ctx, &tunnel.ValidateTunnelsRequest{TunDestIPs: []string{"10.1.1.2"}})
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func Test_ExecExternalExitCodes(t *testing.T) {
+	ctx := context.Background()
+	srv := NewTunnelService(ctx).(*tunnelService)
+
+	ec, err := srv.execExternal(ctx, nil, nil, "sh", "-c", "exit 0")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, ec)
+
+	ec, err = srv.execExternal(ctx, nil, nil, "sh", "-c", "exit 1")
+	assert.Error(t, err)
+	assert.Equal(t, 1, ec)
+
+	ec, err = srv.execExternal(ctx, nil, exitCodes{0, 1}, "sh", "-c", "exit 1")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, ec)
+
+	_, err = srv.execExternal(ctx, nil, exitCodes{0, 1}, "sh", "-c", "exit 2")
+	assert.Error(t, err)
+}
+
+func Test_WithRequestDeadline(t *testing.T) {
+	ctx := context.Background()
+
+	//caller has no deadline: per request timeout sets one
+	c, cancel := withRequestDeadline(ctx, 500, time.Hour)
+	dl, has := c.Deadline()
+	cancel()
+	if assert.True(t, has) {
+		assert.WithinDuration(t, time.Now().Add(500*time.Millisecond), dl, 100*time.Millisecond)
+	}
+
+	//caller deadline is shorter: per request timeout can't extend it
+	callerCtx, callerCancel := context.WithTimeout(ctx, time.Second)
+	defer callerCancel()
+	expected, _ := callerCtx.Deadline()
+	c, cancel = withRequestDeadline(callerCtx, 60000, time.Hour)
+	dl, _ = c.Deadline()
+	cancel()
+	assert.Equal(t, expected, dl)
+
+	//caller deadline is longer: per request timeout shortens it
+	c, cancel = withRequestDeadline(callerCtx, 10, time.Hour)
+	dl, _ = c.Deadline()
+	cancel()
+	assert.True(t, dl.Before(expected))
+
+	//not set: server default applies
+	c, cancel = withRequestDeadline(ctx, 0, time.Hour)
+	dl, _ = c.Deadline()
+	cancel()
+	assert.WithinDuration(t, time.Now().Add(time.Hour), dl, time.Second)
+}
+
+func Test_WithSpansOff(t *testing.T) {
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{1},
+		SpanID:  trace.SpanID{1},
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), sc)
+
+	srv := NewTunnelService(ctx).(*tunnelService)
+	assert.Equal(t, sc, srv.spanOf(ctx).SpanContext())
+
+	srv = NewTunnelService(ctx, WithSpans(false)).(*tunnelService)
+	assert.False(t, srv.spanOf(ctx).SpanContext().IsValid())
+}
+
+//blockingLink link which runs hook when its attributes are read
+type blockingLink struct {
+	netlink.Link
+	hook func()
+}
+
+func (l blockingLink) Attrs() *netlink.LinkAttrs {
+	l.hook()
+	return l.Link.Attrs()
+}
+
+func Test_GetStatePartialOnCancel(t *testing.T) {
+	for _, detailed := range []bool{false, true} {
+		ctx, cancel := context.WithCancel(context.Background())
+		srv := NewTunnelService(context.Background()).(*tunnelService)
+		srv.linkList = func() ([]netlink.Link, error) {
+			return []netlink.Link{
+				&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun1"}},
+				blockingLink{
+					Link: &netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun2"}},
+					hook: cancel,
+				},
+				&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun3"}},
+			}, nil
+		}
+		resp, err := srv.GetState(ctx, &tunnel.GetStateRequest{Detailed: detailed, PartialOnCancel: true})
+		if assert.NoError(t, err) {
+			assert.True(t, resp.GetTruncated())
+			assert.Equal(t, []string{"tun1"}, resp.GetTunnels())
+			assert.Empty(t, resp.GetEtag())
+		}
+		cancel()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	srv := NewTunnelService(context.Background()).(*tunnelService)
+	srv.linkList = func() ([]netlink.Link, error) {
+		return []netlink.Link{
+			blockingLink{
+				Link: &netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun1"}},
+				hook: cancel,
+			},
+		}, nil
+	}
+	_, err := srv.GetState(ctx, &tunnel.GetStateRequest{})
+	assert.Equal(t, codes.Canceled, status.Code(err))
+}
+
+func Test_GroupByEgress(t *testing.T) {
+	ctx := context.Background()
+	srv := NewTunnelService(ctx).(*tunnelService)
+	ip := func(s string) net.IP { return net.ParseIP(s).To4() }
+	tun := func(remote string, bound uint32) netlink.Link {
+		return &netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: mustTunnelName(ip(remote))}, Remote: ip(remote), Link: bound}
+	}
+	srv.linkList = func() ([]netlink.Link, error) {
+		return []netlink.Link{tun("1.1.1.1", 0), tun("2.2.2.2", 0), tun("3.3.3.3", 2), tun("9.9.9.9", 0)}, nil
+	}
+	var routeLookups int
+	srv.routeGet = func(dst net.IP) ([]netlink.Route, error) {
+		routeLookups++
+		switch dst.String() {
+		case "1.1.1.1", "2.2.2.2":
+			return []netlink.Route{{LinkIndex: 1}}, nil
+		}
+		return nil, syscall.ENETUNREACH
+	}
+	var devLookups int
+	srv.linkByIndex = func(i int) (netlink.Link, error) {
+		devLookups++
+		return &netlink.Dummy{LinkAttrs: netlink.LinkAttrs{Name: fmt.Sprintf("eth%d", i)}}, nil
+	}
+
+	_, err := srv.GetState(ctx, &tunnel.GetStateRequest{GroupByEgress: true})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	resp, err := srv.GetState(ctx, &tunnel.GetStateRequest{Detailed: true, GroupByEgress: true})
+	if !assert.NoError(t, err) || !assert.Len(t, resp.GetEgressGroups(), 3) {
+		return
+	}
+	g := resp.GetEgressGroups()
+	assert.Equal(t, "", g[0].GetEgressDev())
+	assert.Equal(t, []string{mustTunnelName(ip("9.9.9.9"))}, g[0].GetTunnels())
+	assert.Equal(t, "eth1", g[1].GetEgressDev())
+	assert.ElementsMatch(t, []string{mustTunnelName(ip("1.1.1.1")), mustTunnelName(ip("2.2.2.2"))}, g[1].GetTunnels())
+	assert.Equal(t, "eth2", g[2].GetEgressDev())
+	assert.Equal(t, 3, routeLookups, "bound tunnel needs no route lookup")
+	assert.Equal(t, 2, devLookups, "device names are cached")
+}
+
+func Test_AddTunnelLinkAddRace(t *testing.T) {
+	ctx := context.Background()
+	srv := NewTunnelService(ctx).(*tunnelService)
+	srv.linkList = func() ([]netlink.Link, error) { return nil, nil }
+	var added []string
+	srv.linkAdd = func(link netlink.Link) error {
+		added = append(added, link.Attrs().Name)
+		return errors.Wrap(syscall.EEXIST, "netlink")
+	}
+	_, err := srv.AddTunnel(ctx, &tunnel.AddTunnelRequest{TunDestIP: "203.0.113.77"})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	assert.Equal(t, []string{mustTunnelName(net.ParseIP("203.0.113.77"))}, added)
+
+	srv.linkAdd = func(netlink.Link) error { return syscall.EPERM }
+	_, err = srv.AddTunnel(ctx, &tunnel.AddTunnelRequest{TunDestIP: "203.0.113.77"})
+	assert.NotEqual(t, codes.AlreadyExists, status.Code(err))
+}
+
+//benchSpans runs 'bench' with spans on and off in context of recording span
+func benchSpans(b *testing.B, bench func(b *testing.B, ctx context.Context, srv *tunnelService)) {
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSampler(sdktrace.AlwaysSample()))
+	defer func() {
+		_ = tp.Shutdown(context.Background())
+	}()
+	for _, on := range []bool{true, false} {
+		name := "spans-off"
+		if on {
+			name = "spans-on"
+		}
+		b.Run(name, func(b *testing.B) {
+			ctx, span := tp.Tracer("bench").Start(context.Background(), name)
+			defer span.End()
+			srv := NewTunnelService(ctx, WithSpans(on), WithExecEnv(fakeCommands(b, "sysctl"))).(*tunnelService)
+			bench(b, ctx, srv)
+		})
+	}
+}
+
+func Benchmark_GetStateSpans(b *testing.B) {
+	benchSpans(b, func(b *testing.B, ctx context.Context, srv *tunnelService) {
+		var links []netlink.Link
+		for i := 1; i <= 1000; i++ {
+			ip := net.IPv4(10, 0, byte(i>>8), byte(i))
+			links = append(links, &netlink.Iptun{
+				LinkAttrs: netlink.LinkAttrs{Name: mustTunnelName(ip), MTU: 1480, Flags: net.FlagUp},
+				Remote:    ip,
+			})
+		}
+		useFakeNetlink(srv, links...)
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			if _, err := srv.GetState(ctx, &tunnel.GetStateRequest{Detailed: true}); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func Benchmark_AddTunnelSpans(b *testing.B) {
+	benchSpans(b, func(b *testing.B, ctx context.Context, srv *tunnelService) {
+		useFakeNetlink(srv)
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			if _, err := srv.AddTunnel(ctx, &tunnel.AddTunnelRequest{TunDestIP: "10.0.0.1"}); err != nil {
+				b.Fatal(err)
+			}
+			if _, err := srv.RemoveTunnel(ctx, &tunnel.RemoveTunnelRequest{TunDestIP: "10.0.0.1"}); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,100 @@
+package tunnel
+
+import (
+	"context"
+	"net"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/pkg/errors"
+	"github.com/vishvananda/netlink"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+//SetTunnelState impl tunnel service
+func (srv *tunnelService) SetTunnelState(ctx context.Context, req *tunnel.SetTunnelStateRequest) (*tunnel.TunnelInfo, error) {
+	if err := srv.denyMutation(); err != nil {
+		return nil, err
+	}
+	resp, err := srv.idempotency.do(ctx, "SetTunnelState", req.GetIdempotencyKey(), req, func() (proto.Message, error) {
+		return srv.setTunnelState(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*tunnel.TunnelInfo), nil
+}
+
+//setTunnelState brings tunnel link up or down
+func (srv *tunnelService) setTunnelState(ctx context.Context, req *tunnel.SetTunnelStateRequest) (resp *tunnel.TunnelInfo, err error) {
+	tunnelIP := req.GetTunDestIP()
+	span := srv.spanOf(ctx)
+	span.SetAttributes(attribute.String("tunDestIP", tunnelIP), attribute.Bool("up", req.GetUp()))
+
+	ctx, cancel := withDefaultDeadline(ctx, srv.writeTimeout)
+	defer cancel()
+
+	var leave func()
+	if leave, err = srv.enter(ctx); err != nil {
+		return nil, err
+	}
+	defer func() {
+		leave()
+		err = srv.correctError(err)
+	}()
+
+	var hcTunDestNetIP net.IP
+	if hcTunDestNetIP, err = parseTunDestIP(tunnelIP); err != nil {
+		return
+	}
+	var tunnelName string
+	if tunnelName, err = TunnelNameForIP(hcTunDestNetIP); err != nil {
+		return
+	}
+	span.SetAttributes(attribute.String("tunnel-name", tunnelName))
+
+	var unlock func()
+	if unlock, err = srv.lockForMutation(ctx, tunnelName); err != nil {
+		return
+	}
+	defer unlock()
+
+	var link netlink.Link
+	if link, err = lookupTunnel(srv.nl, tunnelName); err != nil {
+		return
+	}
+	if req.GetUp() {
+		if isQuarantined(link) {
+			err = status.Errorf(codes.FailedPrecondition, "tunnel '%v' is quarantined, use RestoreTunnel", tunnelName)
+			return
+		}
+		srv.addSpanDbgEvent(ctx, span, "netlink.LinkSetUp")
+		if err = srv.nl.LinkSetUp(link); err != nil {
+			err = errors.Wrapf(err, "netlink.LinkSetUp(%s)", tunnelName)
+			return
+		}
+	} else {
+		srv.addSpanDbgEvent(ctx, span, "netlink.LinkSetDown")
+		if err = srv.nl.LinkSetDown(link); err != nil {
+			err = errors.Wrapf(err, "netlink.LinkSetDown(%s)", tunnelName)
+			return
+		}
+	}
+	if link, err = lookupTunnel(srv.nl, tunnelName); err != nil {
+		return
+	}
+	if resp, err = tunnelInfoFromLink(link); err != nil {
+		return nil, err
+	}
+	if resp.UnderlayDev, err = underlayDevName(srv.nl, link); err != nil {
+		return nil, err
+	}
+	action := webhookActionSetDown
+	if req.GetUp() {
+		action = webhookActionSetUp
+	}
+	srv.notifyChange(ctx, action, tunnelName, hcTunDestNetIP.String())
+	return resp, nil
+}
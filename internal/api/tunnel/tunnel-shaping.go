@@ -0,0 +1,158 @@
+package tunnel
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/vishvananda/netlink"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	//qdiscTbf token bucket filter; the default shaping qdisc
+	qdiscTbf = "tbf"
+
+	//qdiscHtb HTB with the only default class limited by rate
+	qdiscHtb = "htb"
+
+	//minEgressRateBps the lowest egress rate in bits per second
+	minEgressRateBps = 8000
+
+	//maxEgressRateBps the highest egress rate in bits per second: kernel rate spec keeps 32 bit bytes per second
+	maxEgressRateBps = math.MaxUint32 * 8
+
+	//shapingBurstTime burst is the amount of bytes sent at rate during this time but at least 'minShapingBurst'
+	shapingBurstTime = 10 * time.Millisecond
+
+	//minShapingBurst the smallest burst in bytes; it is above any tunnel MTU
+	minShapingBurst = 16 << 10
+
+	//shapingLatency the longest time packet may wait in TBF queue
+	shapingLatency = 50 * time.Millisecond
+
+	//htbDefaultClass minor of HTB class all traffic goes through
+	htbDefaultClass = 0x10
+)
+
+//egressShaping root qdisc limiting traffic leaving through the tunnel
+type egressShaping struct {
+	qdisc   string
+	rateBps uint64
+}
+
+func (s egressShaping) String() string {
+	return fmt.Sprintf("%s/%v", s.qdisc, s.rateBps)
+}
+
+//parseEgressShaping validates 'egressRateBps' and 'qdisc' arguments; nil if no shaping is asked for
+func parseEgressShaping(rateBps uint64, qdisc string) (*egressShaping, error) {
+	if rateBps == 0 {
+		if len(qdisc) > 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "'qdisc': needs 'egressRateBps'")
+		}
+		return nil, nil
+	}
+	if rateBps < minEgressRateBps {
+		return nil, status.Errorf(codes.InvalidArgument, "'egressRateBps': %v is below minimum %v", rateBps, minEgressRateBps)
+	}
+	if rateBps > maxEgressRateBps {
+		return nil, status.Errorf(codes.InvalidArgument, "'egressRateBps': %v is above maximum %v", rateBps, uint64(maxEgressRateBps))
+	}
+	ret := &egressShaping{qdisc: qdiscTbf, rateBps: rateBps}
+	switch qdisc {
+	case "", qdiscTbf:
+	case qdiscHtb:
+		ret.qdisc = qdiscHtb
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "'qdisc': '%s' is not one of '%s', '%s'", qdisc, qdiscTbf, qdiscHtb)
+	}
+	return ret, nil
+}
+
+//setLabels keeps shaping in tunnel labels so GetTunnel reports it
+func (s *egressShaping) setLabels(ll linkLabels) {
+	ll[labelShaping] = s.String()
+}
+
+//egressShapingOf gets shaping of tunnel from its labels
+func egressShapingOf(ll linkLabels) (*egressShaping, bool) {
+	v := ll[labelShaping]
+	i := strings.IndexByte(v, '/')
+	if i < 0 {
+		return nil, false
+	}
+	rate, err := strconv.ParseUint(v[i+1:], 10, 64)
+	if err != nil {
+		return nil, false
+	}
+	return &egressShaping{qdisc: v[:i], rateBps: rate}, true
+}
+
+//addEgressShaping installs root qdisc of shaping onto the link; it is removed together with the link
+func addEgressShaping(nl netlinkOps, link netlink.Link, s *egressShaping) error {
+	name := link.Attrs().Name
+	rate := s.rateBps / 8
+	burst := uint32(rate * uint64(shapingBurstTime) / uint64(time.Second))
+	if burst < minShapingBurst {
+		burst = minShapingBurst
+	}
+	root := netlink.MakeHandle(1, 0)
+	attrs := netlink.QdiscAttrs{
+		LinkIndex: link.Attrs().Index,
+		Handle:    root,
+		Parent:    netlink.HANDLE_ROOT,
+	}
+	switch s.qdisc {
+	case qdiscTbf:
+		q := &netlink.Tbf{
+			QdiscAttrs: attrs,
+			Rate:       rate,
+			Limit:      uint32(rate*uint64(shapingLatency)/uint64(time.Second)) + burst,
+			Buffer:     uint32(netlink.Xmittime(rate, burst)),
+		}
+		if err := nl.QdiscAdd(q); err != nil {
+			return errors.Wrapf(err, "netlink.QdiscAdd('%s', tbf)", name)
+		}
+	case qdiscHtb:
+		q := netlink.NewHtb(attrs)
+		q.Defcls = htbDefaultClass
+		if err := nl.QdiscAdd(q); err != nil {
+			return errors.Wrapf(err, "netlink.QdiscAdd('%s', htb)", name)
+		}
+		cls := netlink.NewHtbClass(netlink.ClassAttrs{
+			LinkIndex: link.Attrs().Index,
+			Parent:    root,
+			Handle:    netlink.MakeHandle(1, htbDefaultClass),
+		}, netlink.HtbClassAttrs{Rate: s.rateBps, Buffer: burst})
+		if err := nl.ClassAdd(cls); err != nil {
+			return errors.Wrapf(err, "netlink.ClassAdd('%s', htb)", name)
+		}
+	default:
+		return errors.Errorf("unsupported qdisc '%s'", s.qdisc)
+	}
+	return nil
+}
+
+//delEgressShaping removes root qdisc of the tunnel if it has shaping; qdisc which is already gone is not an error
+func delEgressShaping(nl netlinkOps, link netlink.Link) error {
+	if _, ok := egressShapingOf(labelsOf(link)); !ok {
+		return nil
+	}
+	err := nl.QdiscDel(&netlink.GenericQdisc{
+		QdiscAttrs: netlink.QdiscAttrs{
+			LinkIndex: link.Attrs().Index,
+			Handle:    netlink.MakeHandle(1, 0),
+			Parent:    netlink.HANDLE_ROOT,
+		},
+	})
+	if err != nil && !errors.Is(err, syscall.ENOENT) && !errors.Is(err, syscall.EINVAL) {
+		return errors.Wrapf(err, "netlink.QdiscDel('%s')", link.Attrs().Name)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package tunnel
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vishvananda/netlink"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_EgressShaping(t *testing.T) {
+	s, err := parseEgressShaping(0, "")
+	assert.NoError(t, err)
+	assert.Nil(t, s)
+	for _, c := range []struct {
+		rate  uint64
+		qdisc string
+	}{
+		{0, qdiscTbf},
+		{minEgressRateBps - 1, ""},
+		{maxEgressRateBps + 1, ""},
+		{1000000, "cake"},
+	} {
+		_, err = parseEgressShaping(c.rate, c.qdisc)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), "%v %s", c.rate, c.qdisc)
+	}
+	s, err = parseEgressShaping(100000000, "")
+	if assert.NoError(t, err) {
+		assert.Equal(t, egressShaping{qdisc: qdiscTbf, rateBps: 100000000}, *s)
+	}
+	s, err = parseEgressShaping(maxEgressRateBps, qdiscHtb)
+	if assert.NoError(t, err) && assert.NotNil(t, s) {
+		labels := make(linkLabels)
+		s.setLabels(labels)
+		link := &netlink.Iptun{
+			LinkAttrs: netlink.LinkAttrs{Name: "tun1", Alias: labels.alias()},
+			Remote:    net.ParseIP("0.0.0.1"),
+		}
+		info, e := tunnelInfoFromLink(link)
+		if assert.NoError(t, e) {
+			assert.Equal(t, uint64(maxEgressRateBps), info.GetEgressRateBps())
+			assert.Equal(t, qdiscHtb, info.GetQdisc())
+		}
+	}
+	_, ok := egressShapingOf(linkLabels{labelShaping: "tbf"})
+	assert.False(t, ok)
+}
@@ -0,0 +1,39 @@
+package tunnel
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/vishvananda/netlink"
+)
+
+//linkEtagKey key parameters of tunnel link which change GetState answer when they change
+func linkEtagKey(nl netlink.Link) string {
+	a := nl.Attrs()
+	var underlay uint32
+	if t, ok := nl.(*netlink.Iptun); ok {
+		underlay = t.Link
+	}
+	return fmt.Sprintf("%s|%s|%s|%v|%v|%v|%s|%s",
+		a.Name, nl.Type(), linkRemote(nl), underlay, a.MTU, a.Flags, a.OperState, a.Alias)
+}
+
+//stateEtagOf hashes tunnels of GetState answer given by their 'keys' with problems and request shape;
+//tunnels and problems are sorted first so the etag does not depend on enumeration order
+func stateEtagOf(req *tunnel.GetStateRequest, keys []string, problems []*tunnel.LinkProblem) string {
+	sorted := make([]string, 0, len(keys)+len(problems))
+	sorted = append(sorted, keys...)
+	for _, p := range problems {
+		sorted = append(sorted, "!"+p.GetName()+"|"+p.GetError())
+	}
+	sort.Strings(sorted)
+	h := sha256.New()
+	fmt.Fprintf(h, "%v|%v|%v|%v|%v\n",
+		req.GetDetailed(), req.GetSortBy(), req.GetDescending(), req.GetOperState(), req.GetGroupByEgress())
+	_, _ = h.Write([]byte(strings.Join(sorted, "\n")))
+	return hex.EncodeToString(h.Sum(nil)[:16])
+}
@@ -0,0 +1,55 @@
+package tunnel
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/stretchr/testify/assert"
+	"github.com/vishvananda/netlink"
+)
+
+func Test_StateEtag(t *testing.T) {
+	links := []netlink.Link{
+		&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun16843009", MTU: 1480}, Remote: net.ParseIP("1.1.1.1")},
+		&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun33686018", MTU: 1480}, Remote: net.ParseIP("2.2.2.2")},
+	}
+	keys := []string{linkEtagKey(links[0]), linkEtagKey(links[1])}
+	req := &tunnel.GetStateRequest{Detailed: true}
+	etag := stateEtagOf(req, keys, nil)
+	assert.Len(t, etag, 32)
+	assert.Equal(t, etag, stateEtagOf(req, []string{keys[1], keys[0]}, nil))
+	assert.Equal(t, etag, stateEtagOf(&tunnel.GetStateRequest{Detailed: true, IfNoneMatch: etag, PartialOnCancel: true}, keys, nil))
+	assert.NotEqual(t, etag, stateEtagOf(&tunnel.GetStateRequest{}, keys, nil))
+	assert.NotEqual(t, etag, stateEtagOf(req, keys[:1], nil))
+	assert.NotEqual(t, etag, stateEtagOf(req, keys, []*tunnel.LinkProblem{{Name: "tun1", Error: "x"}}))
+
+	links[1].Attrs().MTU = 1400
+	assert.NotEqual(t, etag, stateEtagOf(req, []string{keys[0], linkEtagKey(links[1])}, nil))
+
+	ctx := context.Background()
+	srv := NewTunnelService(ctx).(*tunnelService)
+	srv.linkList = func() ([]netlink.Link, error) {
+		return links, nil
+	}
+	resp, err := srv.GetState(ctx, req)
+	if !assert.NoError(t, err) || !assert.NotEmpty(t, resp.GetEtag()) {
+		return
+	}
+	etag = resp.GetEtag()
+	resp, err = srv.GetState(ctx, &tunnel.GetStateRequest{Detailed: true, IfNoneMatch: etag})
+	if assert.NoError(t, err) {
+		assert.True(t, resp.GetNotModified())
+		assert.Equal(t, etag, resp.GetEtag())
+		assert.Empty(t, resp.GetTunnels())
+		assert.Empty(t, resp.GetDetails())
+	}
+	links[0].Attrs().Flags |= net.FlagUp
+	resp, err = srv.GetState(ctx, &tunnel.GetStateRequest{Detailed: true, IfNoneMatch: etag})
+	if assert.NoError(t, err) {
+		assert.False(t, resp.GetNotModified())
+		assert.NotEqual(t, etag, resp.GetEtag())
+		assert.Len(t, resp.GetDetails(), 2)
+	}
+}
@@ -0,0 +1,63 @@
+package tunnel
+
+import (
+	"bytes"
+	"net"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	reTunnelIndex = regexp.MustCompile(`\d+$`)
+)
+
+//validateStateSortBy checks 'sortBy' request argument
+func validateStateSortBy(by tunnel.StateSortBy) error {
+	if _, ok := tunnel.StateSortBy_name[int32(by)]; !ok {
+		return status.Errorf(codes.InvalidArgument, "'sortBy': unsupported value (%v)", by)
+	}
+	return nil
+}
+
+//tunnelIndex gets numeric index from tunnel name
+func tunnelIndex(name string) (uint64, bool) {
+	n, err := strconv.ParseUint(reTunnelIndex.FindString(name), 10, 64)
+	return n, err == nil
+}
+
+//sortTunnelNames sorts tunnel names in requested order; ties are broken by name
+func sortTunnelNames(names []string, remotes map[string]net.IP, by tunnel.StateSortBy, descending bool) {
+	less := func(l, r string) bool {
+		switch by {
+		case tunnel.StateSortBy_STATE_SORT_BY_INDEX:
+			il, okL := tunnelIndex(l)
+			ir, okR := tunnelIndex(r)
+			if okL != okR {
+				return okL
+			}
+			if okL && il != ir {
+				return il < ir
+			}
+		case tunnel.StateSortBy_STATE_SORT_BY_REMOTE_IP:
+			rl, rr := remotes[l].To4(), remotes[r].To4()
+			if (rl == nil) != (rr == nil) {
+				return rl != nil
+			}
+			if c := bytes.Compare(rl, rr); c != 0 {
+				return c < 0
+			}
+		}
+		return l < r
+	}
+	sort.SliceStable(names, func(i, j int) bool {
+		if descending {
+			return less(names[j], names[i])
+		}
+		return less(names[i], names[j])
+	})
+}
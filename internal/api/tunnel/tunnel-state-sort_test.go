@@ -0,0 +1,26 @@
+package tunnel
+
+import (
+	"net"
+	"testing"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_SortTunnelNames(t *testing.T) {
+	names := []string{"tun10", "tun2", "tun1"}
+	remotes := map[string]net.IP{
+		"tun1":  net.ParseIP("3.3.3.3"),
+		"tun2":  net.ParseIP("1.1.1.1"),
+		"tun10": net.ParseIP("2.2.2.2"),
+	}
+	sortTunnelNames(names, remotes, tunnel.StateSortBy_STATE_SORT_BY_NAME, false)
+	assert.Equal(t, []string{"tun1", "tun10", "tun2"}, names)
+	sortTunnelNames(names, remotes, tunnel.StateSortBy_STATE_SORT_BY_INDEX, false)
+	assert.Equal(t, []string{"tun1", "tun2", "tun10"}, names)
+	sortTunnelNames(names, remotes, tunnel.StateSortBy_STATE_SORT_BY_INDEX, true)
+	assert.Equal(t, []string{"tun10", "tun2", "tun1"}, names)
+	sortTunnelNames(names, remotes, tunnel.StateSortBy_STATE_SORT_BY_REMOTE_IP, false)
+	assert.Equal(t, []string{"tun2", "tun10", "tun1"}, names)
+}
@@ -0,0 +1,83 @@
+package tunnel
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+//DynamicSwaggerHandler serves embedded swagger spec patched with where the API is actually reachable;
+//'GetSwaggerDocs' keeps returning the spec as is
+//  - 'externalURL' (e.g. 'https://tunnel.example.com/api') wins when it is set: it gives schemes, host and base path
+//  - otherwise 'bindEndpoint' the server listens on (e.g. 'tcp://127.0.0.1:9003') gives host;
+//    unix socket or unspecified address leaves host out so clients use the host serving the spec
+func DynamicSwaggerHandler(bindEndpoint, externalURL string) (http.Handler, error) {
+	data, err := dynamicSwagger(bindEndpoint, externalURL)
+	if err != nil {
+		return nil, err
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(data)
+	}), nil
+}
+
+//dynamicSwagger patches 'host', 'basePath' and 'schemes' of embedded swagger spec
+func dynamicSwagger(bindEndpoint, externalURL string) ([]byte, error) {
+	const api = "tunnel/dynamicSwagger"
+
+	var spec map[string]interface{}
+	if err := json.Unmarshal(rawSwagger, &spec); err != nil {
+		return nil, errors.Wrap(err, api)
+	}
+	delete(spec, "host")
+	delete(spec, "basePath")
+	if len(externalURL) > 0 {
+		u, err := url.Parse(externalURL)
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s: external URL", api)
+		}
+		if (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+			return nil, errors.Errorf("%s: external URL '%s' is not absolute http(s) URL", api, externalURL)
+		}
+		spec["schemes"] = []string{u.Scheme}
+		spec["host"] = u.Host
+		if p := strings.TrimSuffix(u.Path, "/"); len(p) > 0 {
+			spec["basePath"] = p
+		}
+	} else if host, err := bindHost(bindEndpoint); err != nil {
+		return nil, errors.Wrap(err, api)
+	} else if len(host) > 0 {
+		spec["host"] = host
+	}
+	ret, err := json.Marshal(spec)
+	return ret, errors.Wrap(err, api)
+}
+
+//bindHost gets 'host:port' clients may use from server endpoint; empty if there is no such one
+func bindHost(bindEndpoint string) (string, error) {
+	u, err := url.Parse(bindEndpoint)
+	if err != nil {
+		return "", errors.Wrapf(err, "bind endpoint '%s'", bindEndpoint)
+	}
+	if u.Scheme != "tcp" {
+		return "", nil
+	}
+	h, _, err := net.SplitHostPort(u.Host)
+	if err != nil {
+		return "", errors.Wrapf(err, "bind endpoint '%s'", bindEndpoint)
+	}
+	if ip := net.ParseIP(h); len(h) == 0 || (ip != nil && ip.IsUnspecified()) {
+		return "", nil
+	}
+	return u.Host, nil
+}
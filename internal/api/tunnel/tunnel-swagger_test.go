@@ -0,0 +1,56 @@
+package tunnel
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_DynamicSwagger(t *testing.T) {
+	spec := func(bind, external string) map[string]interface{} {
+		data, err := dynamicSwagger(bind, external)
+		if !assert.NoError(t, err) {
+			return nil
+		}
+		var ret map[string]interface{}
+		assert.NoError(t, json.Unmarshal(data, &ret))
+		return ret
+	}
+	s := spec("tcp://10.0.0.1:9003", "")
+	assert.Equal(t, "10.0.0.1:9003", s["host"])
+	assert.NotContains(t, s, "basePath")
+	assert.NotEmpty(t, s["paths"])
+
+	s = spec("tcp://0.0.0.0:9003", "")
+	assert.NotContains(t, s, "host")
+	s = spec("unix:///run/tunnel.sock", "")
+	assert.NotContains(t, s, "host")
+
+	s = spec("tcp://127.0.0.1:9003", "https://tunnel.example.com/api/")
+	assert.Equal(t, "tunnel.example.com", s["host"])
+	assert.Equal(t, "/api", s["basePath"])
+	assert.Equal(t, []interface{}{"https"}, s["schemes"])
+
+	_, err := dynamicSwagger("tcp://127.0.0.1:9003", "tunnel.example.com")
+	assert.Error(t, err)
+
+	h, err := DynamicSwaggerHandler("tcp://127.0.0.1:9003", "")
+	if !assert.NoError(t, err) {
+		return
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/swagger.json", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/swagger.json", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+
+	docs, err := GetSwaggerDocs()
+	if assert.NoError(t, err) {
+		assert.NotNil(t, docs)
+	}
+}
@@ -0,0 +1,265 @@
+package tunnel
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"syscall"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/pkg/errors"
+	"github.com/vishvananda/netlink"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+//SwapRemote impl tunnel service
+func (srv *tunnelService) SwapRemote(ctx context.Context, req *tunnel.SwapRemoteRequest) (*tunnel.SwapRemoteResponse, error) {
+	if err := srv.denyMutation(); err != nil {
+		return nil, err
+	}
+	resp, err := srv.idempotency.do(ctx, "SwapRemote", req.GetIdempotencyKey(), req, func() (proto.Message, error) {
+		return srv.swapRemote(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*tunnel.SwapRemoteResponse), nil
+}
+
+//swapRemote moves tunnel onto new remote.
+//Tunnel name is derived from remote IP so remote can't be changed in place without renaming the link,
+//and renaming needs the link to be down. Instead new tunnel is made as a copy of the old one
+//(flags, MTU, underlay, labels, egress shaping, firewall rules and template sysctls), routes and policy rules of the old tunnel are
+//moved onto the new one, then the old tunnel is removed. Traffic switches over as routes are moved.
+//If anything fails before the old tunnel is removed the new tunnel is rolled back and the old one is kept intact
+func (srv *tunnelService) swapRemote(ctx context.Context, req *tunnel.SwapRemoteRequest) (resp *tunnel.SwapRemoteResponse, err error) {
+	span := srv.spanOf(ctx)
+	span.SetAttributes(
+		attribute.String("currentIP", req.GetCurrentIP()),
+		attribute.String("newIP", req.GetNewIP()),
+	)
+	ctx, cancel := withDefaultDeadline(ctx, srv.writeTimeout)
+	defer cancel()
+
+	var leave func()
+	if leave, err = srv.enter(ctx); err != nil {
+		return nil, err
+	}
+	defer func() {
+		leave()
+		err = srv.correctError(err)
+	}()
+
+	var curIP, newIP net.IP
+	if curIP, err = parseTunDestIP(req.GetCurrentIP()); err != nil {
+		return nil, renameArgError(err, "currentIP")
+	}
+	if newIP, err = parseTunDestIP(req.GetNewIP()); err != nil {
+		return nil, renameArgError(err, "newIP")
+	}
+	if curIP.Equal(newIP) {
+		return nil, status.Errorf(codes.InvalidArgument, "'newIP': is the same as 'currentIP'")
+	}
+	var oldName, newName string
+	if oldName, err = TunnelNameForIP(curIP); err != nil {
+		return nil, renameArgError(err, "currentIP")
+	}
+	if newName, err = TunnelNameForIP(newIP); err != nil {
+		return nil, renameArgError(err, "newIP")
+	}
+	span.SetAttributes(attribute.String("old-name", oldName), attribute.String("new-name", newName))
+
+	var unlock func()
+	if unlock, err = srv.lockForMutation(ctx, oldName, newName); err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	var linkOld netlink.Link
+	if linkOld, err = lookupTunnel(srv.nl, oldName); err != nil {
+		return nil, err
+	}
+	old, ok := linkOld.(*netlink.Iptun)
+	if !ok {
+		return nil, errors.Errorf("link '%s' has unexpected type '%s'", oldName, linkOld.Type())
+	}
+	if isQuarantined(linkOld) {
+		return nil, status.Errorf(codes.FailedPrecondition, "tunnel '%v' is quarantined", oldName)
+	}
+	if _, err = srv.nl.LinkByName(newName); err == nil {
+		return nil, status.Errorf(codes.AlreadyExists, "tunnel '%v'", newName)
+	} else if !errors.As(err, new(netlink.LinkNotFoundError)) {
+		return nil, errors.Wrapf(err, "netlink.LinkByName(%s)", newName)
+	}
+	if err = srv.checkOverlap(newName, tunnelOverlapKey(tunnelTypeIpip, old.Local, newIP)); err != nil {
+		return nil, err
+	}
+	//the old tunnel is removed once the new one carries traffic
+	if err = srv.checkCapacity(1); err != nil {
+		return nil, err
+	}
+
+	linkNew := &netlink.Iptun{
+		LinkAttrs: netlink.LinkAttrs{
+			Name:  newName,
+			MTU:   old.MTU,
+			Flags: old.Flags & net.FlagMulticast,
+		},
+		Link:     old.Link,
+		Local:    old.Local,
+		Remote:   newIP,
+		Ttl:      old.Ttl,
+		Tos:      old.Tos,
+		PMtuDisc: old.PMtuDisc,
+	}
+	srv.addSpanDbgEvent(ctx, span, "netlink.LinkAdd",
+		trace.WithAttributes(attribute.String("LinkAttrs.Name", newName)),
+	)
+	if err = srv.addTunnelLink(linkNew); err != nil {
+		return nil, err
+	}
+	installed, committed := make(linkLabels), false
+	defer func() {
+		if err != nil && !committed {
+			srv.rollbackAddTunnel(linkNew, installed)
+		}
+	}()
+	if old.RawFlags&syscall.IFF_NOARP != 0 {
+		if err = srv.nl.LinkSetARPOff(linkNew); err != nil {
+			return nil, errors.Wrapf(err, "netlink.LinkSetARPOff(%s)", newName)
+		}
+	}
+	labels := labelsOf(linkOld)
+	srv.addSpanDbgEvent(ctx, span, "addTunnelFwRules")
+	if installed, err = srv.addTunnelFwRules(ctx, newName, labels); err != nil {
+		return nil, err
+	}
+	if shaping, ok := egressShapingOf(labels); ok {
+		//qdisc goes before link-up so the new tunnel never passes traffic unshaped
+		srv.addSpanDbgEvent(ctx, span, "addEgressShaping",
+			trace.WithAttributes(attribute.Stringer("shaping", shaping)),
+		)
+		if err = addEgressShaping(srv.nl, linkNew, shaping); err != nil {
+			return nil, err
+		}
+	}
+	if len(labels) > 0 {
+		if err = setLinkLabels(srv.nl, linkNew, labels); err != nil {
+			return nil, err
+		}
+	}
+	if err = srv.newRpFilter(ctx, newName); err != nil {
+		return nil, errors.Wrapf(err, "newRpFilter(%s)", newName)
+	}
+	//template gone from config leaves the tunnel with the policy as RepairSysctls does
+	tmpl, _ := srv.templateOf(labels[labelTemplate])
+	if keys, desired := srv.newTunnelSysctls(tmpl); len(keys) > 0 {
+		srv.addSpanDbgEvent(ctx, span, "applyTunnelSysctls")
+		if _, err = srv.applyTunnelSysctls(newName, keys, desired); err != nil {
+			return nil, err
+		}
+	}
+	if old.Flags&net.FlagUp != 0 {
+		if err = srv.nl.LinkSetUp(linkNew); err != nil {
+			return nil, errors.Wrapf(err, "netlink.LinkSetUp(%s)", newName)
+		}
+	}
+	resp = &tunnel.SwapRemoteResponse{OldName: oldName, NewName: newName}
+	srv.addSpanDbgEvent(ctx, span, "migrateRoutes")
+	if resp.MigratedRoutes, err = migrateRoutes(srv.nl, linkOld, linkNew); err != nil {
+		return nil, err
+	}
+
+	//from here the new tunnel carries traffic so it is not rolled back anymore
+	committed = true
+	leftover := func(e error) error {
+		return errors.Wrapf(e, "tunnel is moved to '%s' but '%s' is not completely removed", newName, oldName)
+	}
+	srv.addSpanDbgEvent(ctx, span, "migrateRules")
+	if resp.MigratedRules, err = migrateRules(srv.nl, oldName, newName); err != nil {
+		return nil, leftover(err)
+	}
+	if err = srv.delTunnelFwRules(ctx, oldName, labels); err != nil {
+		return nil, leftover(err)
+	}
+	srv.addSpanDbgEvent(ctx, span, "netlink.LinkDel",
+		trace.WithAttributes(attribute.String("tunnel-name", oldName)),
+	)
+	if err = srv.nl.LinkDel(linkOld); err != nil {
+		return nil, leftover(errors.Wrapf(err, "netlink.LinkDel(%s)", oldName))
+	}
+	srv.notifyChange(ctx, webhookActionSwapRemote, newName, newIP.String())
+	var link netlink.Link
+	if link, err = lookupTunnel(srv.nl, newName); err == nil {
+		resp.Tunnel, err = srv.tunnelInfoOf(link)
+	}
+	return resp, err
+}
+
+//renameArgError makes 'tunDestIP' validation error refer to request argument 'arg'
+func renameArgError(err error, arg string) error {
+	msg := strings.TrimPrefix(status.Convert(err).Message(), "'tunDestIP': ")
+	return status.Errorf(codes.InvalidArgument, "'%s': %s", arg, msg)
+}
+
+//migrateRoutes moves routes of link 'from' onto link 'to' replacing them one by one;
+//on failure routes moved so far are put back onto 'from'
+func migrateRoutes(nl netlinkOps, from, to netlink.Link) ([]string, error) {
+	routes, err := nl.RouteListFiltered(netlink.FAMILY_V4,
+		&netlink.Route{LinkIndex: from.Attrs().Index, Table: rtTableUnspec},
+		netlink.RT_FILTER_OIF|netlink.RT_FILTER_TABLE,
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "netlink.RouteListFiltered(%s)", from.Attrs().Name)
+	}
+	var ret []string
+	for i := range routes {
+		r := routes[i]
+		r.LinkIndex = to.Attrs().Index
+		if err = nl.RouteReplace(&r); err != nil {
+			for j := 0; j < i; j++ {
+				_ = nl.RouteReplace(&routes[j])
+			}
+			return nil, errors.Wrapf(err, "netlink.RouteReplace(%s)", &r)
+		}
+		ret = append(ret, r.String())
+	}
+	return ret, nil
+}
+
+//migrateRules makes policy rules referencing interface 'from' by name reference interface 'to'
+//by adding updated copy and removing the original one
+func migrateRules(nl netlinkOps, from, to string) ([]string, error) {
+	rules, err := nl.RuleList(netlink.FAMILY_V4)
+	if err != nil {
+		return nil, errors.Wrap(err, "netlink.RuleList")
+	}
+	var ret []string
+	for i := range rules {
+		r := rules[i]
+		if r.IifName != from && r.OifName != from {
+			continue
+		}
+		old := r
+		if r.IifName == from {
+			r.IifName = to
+		}
+		if r.OifName == from {
+			r.OifName = to
+		}
+		descr := fmt.Sprintf("priority %v iif '%s' oif '%s' table %v", r.Priority, r.IifName, r.OifName, r.Table)
+		if err = nl.RuleAdd(&r); err != nil {
+			return ret, errors.Wrapf(err, "netlink.RuleAdd(%s)", descr)
+		}
+		if err = nl.RuleDel(&old); err != nil {
+			return ret, errors.Wrapf(err, "netlink.RuleDel(%s)", descr)
+		}
+		ret = append(ret, descr)
+	}
+	return ret, nil
+}
@@ -0,0 +1,129 @@
+package tunnel
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/stretchr/testify/assert"
+	"github.com/vishvananda/netlink"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_SwapRemoteArgs(t *testing.T) {
+	ctx := context.Background()
+	srv := NewTunnelService(ctx).(*tunnelService)
+	reqs := []*tunnel.SwapRemoteRequest{
+		{CurrentIP: "1.1.1.1", NewIP: "1.1.1.1"},
+		{CurrentIP: "1.1.1", NewIP: "2.2.2.2"},
+		{CurrentIP: "1.1.1.1", NewIP: "127.0.0.1"},
+	}
+	for _, req := range reqs {
+		_, err := srv.SwapRemote(ctx, req)
+		assert.Equalf(t, codes.InvalidArgument, status.Code(err), "%v", req)
+	}
+}
+
+func Test_SwapRemoteShaping(t *testing.T) {
+	ctx := context.Background()
+	srv := NewTunnelService(ctx, WithExecEnv(fakeCommands(t, "sysctl"))).(*tunnelService)
+	fake := useFakeNetlink(srv)
+	_, err := srv.AddTunnel(ctx, &tunnel.AddTunnelRequest{TunDestIP: "10.0.0.1", EgressRateBps: 10000000, Qdisc: qdiscHtb})
+	if !assert.NoError(t, err) {
+		return
+	}
+	fake.calls = nil
+	resp, err := srv.SwapRemote(ctx, &tunnel.SwapRemoteRequest{CurrentIP: "10.0.0.1", NewIP: "10.0.0.2"})
+	if !assert.NoError(t, err) {
+		return
+	}
+	newName := resp.GetNewName()
+	assert.Equal(t, uint64(10000000), resp.GetTunnel().GetEgressRateBps())
+	assert.Equal(t, qdiscHtb, resp.GetTunnel().GetQdisc())
+
+	shapedAt, upAt := -1, -1
+	for i, call := range fake.calls {
+		switch call {
+		case "QdiscAdd " + newName:
+			shapedAt = i
+		case "LinkSetUp " + newName:
+			upAt = i
+		}
+	}
+	if assert.True(t, shapedAt >= 0, "new tunnel is shaped") && assert.True(t, upAt >= 0) {
+		assert.Less(t, shapedAt, upAt, "shaping goes before link-up")
+	}
+	link, err := fake.LinkByName(newName)
+	if assert.NoError(t, err) && assert.Len(t, fake.qdiscs, 1) {
+		assert.Equal(t, link.Attrs().Index, fake.qdiscs[0].Attrs().LinkIndex)
+		assert.IsType(t, &netlink.Htb{}, fake.qdiscs[0])
+	}
+}
+
+func Test_SwapRemoteChecks(t *testing.T) {
+	ctx := context.Background()
+	ip := net.ParseIP
+	srv := NewTunnelService(ctx, WithExecEnv(fakeCommands(t, "sysctl")), WithMaxTunnels(2)).(*tunnelService)
+	fake := useFakeNetlink(srv,
+		&netlink.Dummy{LinkAttrs: netlink.LinkAttrs{Name: "eth0", Flags: net.FlagUp}},
+		//managed tunnel to 10.0.0.3 under other name
+		&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun7", Alias: aliasLabelsPrefix}, Remote: ip("10.0.0.3")},
+	)
+	_, err := srv.AddTunnel(ctx, &tunnel.AddTunnelRequest{
+		TunDestIP: "10.0.0.1", UnderlayDev: "eth0",
+		Multicast: tunnel.LinkFlag_LINK_FLAG_ON, MulticastRoutes: []string{"239.1.0.0/16"},
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	oldName := mustTunnelName(ip("10.0.0.1"))
+
+	fake.calls = nil
+	_, err = srv.SwapRemote(ctx, &tunnel.SwapRemoteRequest{CurrentIP: "10.0.0.1", NewIP: "10.0.0.3"})
+	if assert.Equal(t, codes.AlreadyExists, status.Code(err)) {
+		assert.Contains(t, err.Error(), "overlaps managed tunnel 'tun7'")
+	}
+	assert.NotContains(t, fake.calls, "LinkAdd "+mustTunnelName(ip("10.0.0.3")))
+	assert.Contains(t, fake.names(), oldName)
+
+	//the service is at its limit but the swap replaces the tunnel
+	resp, err := srv.SwapRemote(ctx, &tunnel.SwapRemoteRequest{CurrentIP: "10.0.0.1", NewIP: "10.0.0.2"})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "eth0", resp.GetTunnel().GetUnderlayDev())
+	assert.Equal(t, []string{"239.1.0.0/16"}, resp.GetTunnel().GetMulticastRoutes())
+	assert.NotContains(t, fake.names(), oldName)
+}
+
+func Test_SwapRemoteTemplateSysctls(t *testing.T) {
+	ctx := context.Background()
+	procPath := t.TempDir()
+	confRoot := filepath.Join(procPath, "sys/net/ipv4/conf")
+	oldName, newName := mustTunnelName(net.ParseIP("10.0.0.1")), mustTunnelName(net.ParseIP("10.0.0.2"))
+	for _, name := range []string{oldName, newName} {
+		if !assert.NoError(t, os.MkdirAll(filepath.Join(confRoot, name), 0755)) {
+			return
+		}
+		assert.NoError(t, os.WriteFile(filepath.Join(confRoot, name, "arp_ignore"), []byte("0\n"), 0644))
+	}
+	srv := NewTunnelService(ctx, WithProcPath(procPath), WithExecEnv(fakeCommands(t, "sysctl")),
+		WithTemplate("edge", TunnelTemplate{Sysctls: map[string]string{"arp_ignore": "1"}}),
+	).(*tunnelService)
+	useFakeNetlink(srv)
+	_, err := srv.AddTunnel(ctx, &tunnel.AddTunnelRequest{TunDestIP: "10.0.0.1", Template: "edge"})
+	if !assert.NoError(t, err) {
+		return
+	}
+	resp, err := srv.SwapRemote(ctx, &tunnel.SwapRemoteRequest{CurrentIP: "10.0.0.1", NewIP: "10.0.0.2"})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "edge", resp.GetTunnel().GetTemplate())
+	data, _ := os.ReadFile(filepath.Join(confRoot, newName, "arp_ignore"))
+	assert.Equal(t, "1", string(data), "template sysctls are applied to the new tunnel")
+}
@@ -0,0 +1,338 @@
+package tunnel
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/pkg/errors"
+	"github.com/vishvananda/netlink"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	//DefaultProcPath default mount point of procfs
+	DefaultProcPath = "/proc"
+)
+
+//tunnelSysctls per interface sysctls we report
+var tunnelSysctls = []string{
+	"rp_filter",
+	"arp_ignore",
+	"arp_announce",
+	"accept_local",
+	"forwarding",
+}
+
+//defaultTunnelSysctls values of per interface sysctls managed tunnels have unless the policy says otherwise
+var defaultTunnelSysctls = map[string]string{
+	"rp_filter": "0",
+}
+
+//sysctlPolicy per interface sysctls managed tunnels must have
+//  - it starts with defaultTunnelSysctls overridden by 'WithTunnelSysctls'
+//  - ApplySysctls changes it so tunnels added later get the same values
+//  - AddTunnel sets it on new tunnel and RepairSysctls restores it; sysctls of the template tunnel is made of
+//    override it for that tunnel
+type sysctlPolicy struct {
+	mu      sync.Mutex
+	desired map[string]string
+}
+
+//get copy of desired sysctls
+func (p *sysctlPolicy) get() map[string]string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	ret := make(map[string]string, len(defaultTunnelSysctls)+len(p.desired))
+	for k, v := range defaultTunnelSysctls {
+		ret[k] = v
+	}
+	for k, v := range p.desired {
+		ret[k] = v
+	}
+	return ret
+}
+
+//update makes 'desired' values the policy ones; sysctls not in 'desired' keep their values
+func (p *sysctlPolicy) update(desired map[string]string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.desired == nil {
+		p.desired = make(map[string]string, len(desired))
+	}
+	for k, v := range desired {
+		p.desired[k] = v
+	}
+}
+
+//validateSysctlPolicy checks sysctls given by 'WithTunnelSysctls' the way ApplySysctls checks desired ones
+func (srv *tunnelService) validateSysctlPolicy() error {
+	if len(srv.sysctls.desired) == 0 {
+		return nil
+	}
+	return validateDesiredSysctls(srv.sysctls.desired)
+}
+
+//desiredSysctlsOf sysctls tunnel made of template 't' must have: policy ones overridden by template ones
+func (srv *tunnelService) desiredSysctlsOf(t *TunnelTemplate) map[string]string {
+	ret := srv.sysctls.get()
+	if t != nil {
+		for k, v := range t.Sysctls {
+			ret[k] = v
+		}
+	}
+	return ret
+}
+
+//sortedSysctlKeys sorted keys of 'sysctls'
+func sortedSysctlKeys(sysctls map[string]string) []string {
+	ret := make([]string, 0, len(sysctls))
+	for k := range sysctls {
+		ret = append(ret, k)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+//sysctlReadOnly checks if per interface sysctl 'key' can't be written because procfs is mounted read-only
+func (srv *tunnelService) sysctlReadOnly(ifName, key string) bool {
+	f, err := os.OpenFile(filepath.Join(srv.procPath, "sys/net/ipv4/conf", ifName, key), os.O_WRONLY, 0)
+	if err != nil {
+		return errors.Is(err, syscall.EROFS)
+	}
+	_ = f.Close()
+	return false
+}
+
+//GetTunnelSysctls impl tunnel service
+func (srv *tunnelService) GetTunnelSysctls(ctx context.Context, req *tunnel.GetTunnelSysctlsRequest) (resp *tunnel.GetTunnelSysctlsResponse, err error) {
+	span := srv.spanOf(ctx)
+	defer func() {
+		err = srv.correctError(err)
+	}()
+
+	span.SetAttributes(attribute.String("tunDestIP", req.GetTunDestIP()))
+	var tunnelName string
+	if tunnelName, err = tunnelNameOf(req.GetTunDestIP(), req.GetName()); err != nil {
+		return nil, err
+	}
+	span.SetAttributes(attribute.String("tunnel-name", tunnelName))
+
+	confDir := filepath.Join(srv.procPath, "sys/net/ipv4/conf", tunnelName)
+	if _, err = os.Stat(confDir); os.IsNotExist(err) {
+		return nil, status.Errorf(codes.NotFound, "tunnel '%v' is not found", tunnelName)
+	} else if err != nil {
+		return nil, errors.Wrapf(err, "os.Stat('%s')", confDir)
+	}
+	resp = &tunnel.GetTunnelSysctlsResponse{
+		Name:   tunnelName,
+		Values: make(map[string]string, len(tunnelSysctls)),
+	}
+	for _, key := range tunnelSysctls {
+		var data []byte
+		data, err = os.ReadFile(filepath.Join(confDir, key))
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return nil, errors.Wrapf(err, "os.ReadFile('%s')", filepath.Join(confDir, key))
+		}
+		resp.Values[key] = strings.TrimSpace(string(data))
+	}
+	return resp, nil
+}
+
+//RepairSysctls impl tunnel service
+//  - each managed tunnel gets sysctls of the policy overridden by sysctls of its template
+//  - interfaces the service does not own are not repaired
+//  - there are no orphans to report: per interface sysctls live in procfs only and the kernel drops them with the link,
+//    the service persists nothing in sysctl.d
+func (srv *tunnelService) RepairSysctls(ctx context.Context, _ *emptypb.Empty) (resp *tunnel.RepairSysctlsResponse, err error) {
+	if err = srv.denyMutation(); err != nil {
+		return nil, err
+	}
+	span := srv.spanOf(ctx)
+
+	ctx, cancel := withDefaultDeadline(ctx, srv.writeTimeout)
+	defer cancel()
+
+	var leave func()
+	if leave, err = srv.enter(ctx); err != nil {
+		return nil, err
+	}
+	defer func() {
+		leave()
+		err = srv.correctError(err)
+	}()
+	//maintenance mode could be turned on while waiting for the slot
+	if err = srv.denyMutation(); err != nil {
+		return nil, err
+	}
+
+	//managed tunnel name -> its template name
+	managed := make(map[string]string)
+	err = srv.enumLinks(func(nl netlink.Link) error {
+		if !isCordoned(nl) && isOwnedLink(nl) {
+			managed[nl.Attrs().Name] = labelsOf(nl)[labelTemplate]
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	confRoot := filepath.Join(srv.procPath, "sys/net/ipv4/conf")
+	resp = new(tunnel.RepairSysctlsResponse)
+	names := make([]string, 0, len(managed))
+	for name := range managed {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		//template gone from config leaves the tunnel with the policy
+		tmpl, _ := srv.templateOf(managed[name])
+		desired := srv.desiredSysctlsOf(tmpl)
+		for _, key := range sortedSysctlKeys(desired) {
+			val := desired[key]
+			fn := filepath.Join(confRoot, name, key)
+			var data []byte
+			if data, err = os.ReadFile(fn); os.IsNotExist(err) {
+				continue
+			} else if err != nil {
+				return nil, errors.Wrapf(err, "os.ReadFile('%s')", fn)
+			}
+			if actual := strings.TrimSpace(string(data)); actual != val {
+				srv.addSpanDbgEvent(ctx, span, "repair-sysctl",
+					trace.WithAttributes(attribute.String("sysctl", fn)),
+				)
+				if err = os.WriteFile(fn, []byte(val), 0644); err != nil { //nolint:gosec
+					return nil, errors.Wrapf(err, "os.WriteFile('%s')", fn)
+				}
+				resp.Corrections = append(resp.Corrections, &tunnel.SysctlCorrection{
+					Name: name, Key: key, From: actual, To: val,
+				})
+			}
+		}
+	}
+	return resp, nil
+}
+
+//writableTunnelSysctls per interface sysctls ApplySysctls may set and their allowed value ranges
+var writableTunnelSysctls = map[string][2]int{
+	"rp_filter":    {0, 2},
+	"arp_ignore":   {0, 8},
+	"arp_announce": {0, 2},
+	"accept_local": {0, 1},
+	"forwarding":   {0, 1},
+}
+
+//validateDesiredSysctls checks keys of desired sysctls against allowlist and their values against ranges
+func validateDesiredSysctls(desired map[string]string) error {
+	if len(desired) == 0 {
+		return status.Errorf(codes.InvalidArgument, "'desired': is empty")
+	}
+	for key, val := range desired {
+		r, ok := writableTunnelSysctls[key]
+		if !ok {
+			return status.Errorf(codes.InvalidArgument, "'desired': sysctl '%s' is not allowed", key)
+		}
+		n, err := strconv.Atoi(val)
+		if err != nil || n < r[0] || n > r[1] {
+			return status.Errorf(codes.InvalidArgument, "'desired': '%s' value '%s' is out of range [%v, %v]", key, val, r[0], r[1])
+		}
+	}
+	return nil
+}
+
+//applyTunnelSysctls sets desired sysctls of the tunnel which differ from actual ones
+func (srv *tunnelService) applyTunnelSysctls(name string, keys []string, desired map[string]string) (changes []*tunnel.SysctlCorrection, err error) {
+	confDir := filepath.Join(srv.procPath, "sys/net/ipv4/conf", name)
+	for _, key := range keys {
+		fn := filepath.Join(confDir, key)
+		var data []byte
+		if data, err = os.ReadFile(fn); err != nil {
+			return changes, errors.Wrapf(err, "os.ReadFile('%s')", fn)
+		}
+		actual, val := strings.TrimSpace(string(data)), desired[key]
+		if actual == val {
+			continue
+		}
+		if err = os.WriteFile(fn, []byte(val), 0644); err != nil { //nolint:gosec
+			return changes, errors.Wrapf(err, "os.WriteFile('%s')", fn)
+		}
+		changes = append(changes, &tunnel.SysctlCorrection{Name: name, Key: key, From: actual, To: val})
+	}
+	return changes, nil
+}
+
+//ApplySysctls impl tunnel service
+//  - desired sysctls become the policy so tunnels added later and RepairSysctls keep them
+//  - interfaces the service does not own are skipped
+func (srv *tunnelService) ApplySysctls(ctx context.Context, req *tunnel.ApplySysctlsRequest) (resp *tunnel.ApplySysctlsResponse, err error) {
+	if err = srv.denyMutation(); err != nil {
+		return nil, err
+	}
+	span := srv.spanOf(ctx)
+
+	ctx, cancel := withDefaultDeadline(ctx, srv.writeTimeout)
+	defer cancel()
+
+	var leave func()
+	if leave, err = srv.enter(ctx); err != nil {
+		return nil, err
+	}
+	defer func() {
+		leave()
+		err = srv.correctError(err)
+	}()
+
+	desired := req.GetDesired()
+	if err = validateDesiredSysctls(desired); err != nil {
+		return nil, err
+	}
+	keys := sortedSysctlKeys(desired)
+	srv.sysctls.update(desired)
+
+	var names []string
+	err = srv.enumLinks(func(nl netlink.Link) error {
+		if !isQuarantined(nl) && !isCordoned(nl) && isOwnedLink(nl) {
+			names = append(names, nl.Attrs().Name)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+	resp = &tunnel.ApplySysctlsResponse{
+		Results: make([]*tunnel.TunnelSysctlsResult, 0, len(names)),
+	}
+	for _, name := range names {
+		if err = ctx.Err(); err != nil {
+			return nil, err
+		}
+		var unlock func()
+		if unlock, err = srv.lockForMutation(ctx, name); err != nil {
+			return nil, err
+		}
+		srv.addSpanDbgEvent(ctx, span, "applyTunnelSysctls",
+			trace.WithAttributes(attribute.String("tunnel-name", name)),
+		)
+		r := &tunnel.TunnelSysctlsResult{Name: name}
+		var e error
+		if r.Changes, e = srv.applyTunnelSysctls(name, keys, desired); e != nil {
+			r.Error = e.Error()
+		}
+		unlock()
+		resp.Results = append(resp.Results, r)
+	}
+	return resp, nil
+}
@@ -0,0 +1,181 @@
+package tunnel
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/stretchr/testify/assert"
+	"github.com/vishvananda/netlink"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_GetTunnelSysctls(t *testing.T) {
+	ctx := context.Background()
+	procPath := t.TempDir()
+	confDir := filepath.Join(procPath, "sys/net/ipv4/conf/tun16843009")
+	if !assert.NoError(t, os.MkdirAll(confDir, 0755)) {
+		return
+	}
+	assert.NoError(t, os.WriteFile(filepath.Join(confDir, "rp_filter"), []byte("0\n"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(confDir, "arp_ignore"), []byte("1\n"), 0644))
+	srv := NewTunnelService(ctx, WithProcPath(procPath)).(*tunnelService)
+
+	resp, err := srv.GetTunnelSysctls(ctx, &tunnel.GetTunnelSysctlsRequest{TunDestIP: "1.1.1.1"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "tun16843009", resp.GetName())
+		assert.Equal(t, map[string]string{"rp_filter": "0", "arp_ignore": "1"}, resp.GetValues())
+	}
+	_, err = srv.GetTunnelSysctls(ctx, &tunnel.GetTunnelSysctlsRequest{Name: "tun1"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = srv.GetTunnelSysctls(ctx, &tunnel.GetTunnelSysctlsRequest{Name: "../tun1"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = srv.GetTunnelSysctls(ctx, &tunnel.GetTunnelSysctlsRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func Test_RepairSysctls(t *testing.T) {
+	ctx := context.Background()
+	procPath := t.TempDir()
+	confRoot := filepath.Join(procPath, "sys/net/ipv4/conf")
+	for name, rpFilter := range map[string]string{"tun1": "1\n", "tun2": "0\n", "tun3": "2\n", "tun4": "1\n", "eth0": "1\n"} {
+		if !assert.NoError(t, os.MkdirAll(filepath.Join(confRoot, name), 0755)) {
+			return
+		}
+		assert.NoError(t, os.WriteFile(filepath.Join(confRoot, name, "rp_filter"), []byte(rpFilter), 0644))
+	}
+	srv := NewTunnelService(ctx, WithProcPath(procPath)).(*tunnelService)
+	srv.linkList = func() ([]netlink.Link, error) {
+		return []netlink.Link{
+			&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun1", Alias: aliasLabelsPrefix}},
+			&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun2", Alias: aliasLabelsPrefix}},
+			&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun4"}},
+			&netlink.Dummy{LinkAttrs: netlink.LinkAttrs{Name: "eth0"}},
+		}, nil
+	}
+
+	resp, err := srv.RepairSysctls(ctx, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	if assert.Len(t, resp.GetCorrections(), 1) {
+		c := resp.GetCorrections()[0]
+		assert.Equal(t, "tun1", c.GetName())
+		assert.Equal(t, "1", c.GetFrom())
+		assert.Equal(t, "0", c.GetTo())
+	}
+	//'tun3' is not a link so its leftover conf dir is neither repaired nor reported
+	data, _ := os.ReadFile(filepath.Join(confRoot, "tun3", "rp_filter"))
+	assert.Equal(t, "2\n", string(data))
+	data, _ = os.ReadFile(filepath.Join(confRoot, "tun1", "rp_filter"))
+	assert.Equal(t, "0", string(data))
+	//not owned interface is kept as it is
+	data, _ = os.ReadFile(filepath.Join(confRoot, "tun4", "rp_filter"))
+	assert.Equal(t, "1\n", string(data))
+}
+
+func Test_SysctlReadOnly(t *testing.T) {
+	procPath := t.TempDir()
+	confDir := filepath.Join(procPath, "sys/net/ipv4/conf/tun1")
+	if !assert.NoError(t, os.MkdirAll(confDir, 0755)) {
+		return
+	}
+	assert.NoError(t, os.WriteFile(filepath.Join(confDir, "rp_filter"), []byte("1\n"), 0644))
+	srv := NewTunnelService(context.Background(), WithProcPath(procPath), WithBestEffortSysctl(true)).(*tunnelService)
+	assert.True(t, srv.bestEffortSysctl)
+	assert.False(t, srv.sysctlReadOnly("tun1", "rp_filter"))
+	assert.False(t, srv.sysctlReadOnly("tun2", "rp_filter"))
+}
+
+func Test_ApplySysctls(t *testing.T) {
+	ctx := context.Background()
+	procPath := t.TempDir()
+	confRoot := filepath.Join(procPath, "sys/net/ipv4/conf")
+	for name, rpFilter := range map[string]string{"tun1": "0\n", "tun2": "2\n", "tun7": "0\n"} {
+		if !assert.NoError(t, os.MkdirAll(filepath.Join(confRoot, name), 0755)) {
+			return
+		}
+		assert.NoError(t, os.WriteFile(filepath.Join(confRoot, name, "rp_filter"), []byte(rpFilter), 0644))
+	}
+	srv := NewTunnelService(ctx, WithProcPath(procPath)).(*tunnelService)
+	srv.linkList = func() ([]netlink.Link, error) {
+		return []netlink.Link{
+			&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun2", Alias: aliasLabelsPrefix}},
+			&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun1", Alias: aliasLabelsPrefix}},
+			&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun5", Alias: aliasLabelsPrefix}},
+			&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun7"}},
+		}, nil
+	}
+	for _, bad := range []map[string]string{nil, {"mtu": "1400"}, {"rp_filter": "3"}, {"rp_filter": "x"}} {
+		_, err := srv.ApplySysctls(ctx, &tunnel.ApplySysctlsRequest{Desired: bad})
+		assert.Equalf(t, codes.InvalidArgument, status.Code(err), "%v", bad)
+	}
+
+	resp, err := srv.ApplySysctls(ctx, &tunnel.ApplySysctlsRequest{Desired: map[string]string{"rp_filter": "2"}})
+	if !assert.NoError(t, err) || !assert.Len(t, resp.GetResults(), 3) {
+		return
+	}
+	r := resp.GetResults()
+	assert.Equal(t, "tun1", r[0].GetName())
+	if assert.Len(t, r[0].GetChanges(), 1) {
+		assert.Equal(t, "0", r[0].GetChanges()[0].GetFrom())
+	}
+	assert.Empty(t, r[1].GetChanges())
+	assert.Empty(t, r[1].GetError())
+	assert.Equal(t, "tun5", r[2].GetName())
+	assert.NotEmpty(t, r[2].GetError())
+	data, _ := os.ReadFile(filepath.Join(confRoot, "tun1", "rp_filter"))
+	assert.Equal(t, "2", string(data))
+	data, _ = os.ReadFile(filepath.Join(confRoot, "tun7", "rp_filter"))
+	assert.Equal(t, "0\n", string(data))
+}
+
+func Test_SysctlPolicy(t *testing.T) {
+	ctx := context.Background()
+	srv := NewTunnelService(ctx, WithTunnelSysctls(map[string]string{"rp_filter": "1", "arp_ignore": "1"})).(*tunnelService)
+	assert.Equal(t, map[string]string{"rp_filter": "1", "arp_ignore": "1"}, srv.sysctls.get())
+	srv = NewTunnelService(ctx, WithTunnelSysctls(map[string]string{"mtu": "1400"})).(*tunnelService)
+	assert.Equal(t, defaultTunnelSysctls, srv.sysctls.get(), "bad policy falls back to defaults")
+
+	procPath := t.TempDir()
+	confRoot := filepath.Join(procPath, "sys/net/ipv4/conf")
+	plain, templated := mustTunnelName(net.ParseIP("1.1.1.1")), mustTunnelName(net.ParseIP("2.2.2.2"))
+	for _, name := range []string{plain, templated} {
+		if !assert.NoError(t, os.MkdirAll(filepath.Join(confRoot, name), 0755)) {
+			return
+		}
+		assert.NoError(t, os.WriteFile(filepath.Join(confRoot, name, "rp_filter"), []byte("0\n"), 0644))
+	}
+	env, calls := recordCommands(t, "sysctl")
+	srv = NewTunnelService(ctx, WithProcPath(procPath), WithExecEnv(env),
+		WithTemplate("edge", TunnelTemplate{Sysctls: map[string]string{"rp_filter": "1"}}),
+	).(*tunnelService)
+	useFakeNetlink(srv)
+
+	//ApplySysctls changes the policy for tunnels added later
+	_, err := srv.ApplySysctls(ctx, &tunnel.ApplySysctlsRequest{Desired: map[string]string{"rp_filter": "2"}})
+	if !assert.NoError(t, err) {
+		return
+	}
+	_, err = srv.AddTunnel(ctx, &tunnel.AddTunnelRequest{TunDestIP: "1.1.1.1"})
+	assert.NoError(t, err)
+	_, err = srv.AddTunnel(ctx, &tunnel.AddTunnelRequest{TunDestIP: "2.2.2.2", Template: "edge"})
+	assert.NoError(t, err)
+	assert.Contains(t, calls(), "sysctl -w net.ipv4.conf."+plain+".rp_filter=2")
+	data, _ := os.ReadFile(filepath.Join(confRoot, templated, "rp_filter"))
+	assert.Equal(t, "1", string(data), "template overrides the policy")
+
+	//RepairSysctls restores the policy and keeps template sysctls
+	resp, err := srv.RepairSysctls(ctx, nil)
+	if assert.NoError(t, err) && assert.Len(t, resp.GetCorrections(), 1) {
+		c := resp.GetCorrections()[0]
+		assert.Equal(t, plain, c.GetName())
+		assert.Equal(t, "2", c.GetTo())
+	}
+	data, _ = os.ReadFile(filepath.Join(confRoot, templated, "rp_filter"))
+	assert.Equal(t, "1", string(data))
+}
@@ -0,0 +1,109 @@
+package tunnel
+
+import (
+	"sort"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+//TunnelTemplate defaults of AddTunnel request which refers the template by name;
+//request fields which are set explicitly (not zero/default) override template values
+type TunnelTemplate struct {
+	//Type tunnel type; empty or 'ipip'
+	Type      string
+	Mtu       uint32
+	Ttl       uint32
+	NoArp     tunnel.LinkFlag
+	Multicast tunnel.LinkFlag
+	Weight    uint32
+	ClampMss  bool
+	MssValue  uint32
+	FwMark    string
+	//Sysctls per interface sysctls applied after the tunnel is created;
+	//they override sysctl policy of the service for tunnels made of the template
+	Sysctls map[string]string
+}
+
+//templateOf finds template by name; no template for empty name
+func (srv *tunnelService) templateOf(name string) (*TunnelTemplate, error) {
+	if len(name) == 0 {
+		return nil, nil
+	}
+	t, ok := srv.templates[name]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "'template': '%s' is not found", name)
+	}
+	if len(t.Type) > 0 && t.Type != tunnelTypeIpip {
+		return nil, status.Errorf(codes.FailedPrecondition, "template '%s' has unsupported tunnel type '%s'", name, t.Type)
+	}
+	if len(t.Sysctls) > 0 {
+		if err := validateDesiredSysctls(t.Sysctls); err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "template '%s': %s", name, status.Convert(err).Message())
+		}
+	}
+	return t, nil
+}
+
+//apply makes copy of request with template defaults filled in where request fields are not set
+func (t *TunnelTemplate) apply(req *tunnel.AddTunnelRequest) *tunnel.AddTunnelRequest {
+	if t == nil {
+		return req
+	}
+	ret := proto.Clone(req).(*tunnel.AddTunnelRequest)
+	if ret.Mtu == 0 {
+		ret.Mtu = t.Mtu
+	}
+	if ret.Ttl == 0 {
+		ret.Ttl = t.Ttl
+	}
+	if ret.NoArp == tunnel.LinkFlag_LINK_FLAG_DEFAULT {
+		ret.NoArp = t.NoArp
+	}
+	if ret.Multicast == tunnel.LinkFlag_LINK_FLAG_DEFAULT {
+		ret.Multicast = t.Multicast
+	}
+	if ret.Weight == 0 {
+		ret.Weight = t.Weight
+	}
+	if !ret.ClampMss {
+		ret.ClampMss, ret.MssValue = t.ClampMss, t.MssValue
+	}
+	if len(ret.FwMark) == 0 {
+		ret.FwMark = t.FwMark
+	}
+	return ret
+}
+
+//newTunnelSysctls sysctls new tunnel made of template 't' gets after rp_filter of the policy is set:
+//the rest of the policy and template sysctls; 'keys' are sorted keys to apply of 'desired'
+func (srv *tunnelService) newTunnelSysctls(t *TunnelTemplate) (keys []string, desired map[string]string) {
+	desired = srv.desiredSysctlsOf(t)
+	for _, k := range sortedSysctlKeys(desired) {
+		if _, byTemplate := t.sysctl(k); k != "rp_filter" || byTemplate {
+			keys = append(keys, k)
+		}
+	}
+	return keys, desired
+}
+
+//sysctl value of template sysctl 'key'
+func (t *TunnelTemplate) sysctl(key string) (string, bool) {
+	if t == nil {
+		return "", false
+	}
+	v, ok := t.Sysctls[key]
+	return v, ok
+}
+
+//templateNames sorted names of registered templates
+func (srv *tunnelService) templateNames() []string {
+	ret := make([]string, 0, len(srv.templates))
+	for name := range srv.templates {
+		ret = append(ret, name)
+	}
+	sort.Strings(ret)
+	return ret
+}
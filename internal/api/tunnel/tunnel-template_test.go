@@ -0,0 +1,59 @@
+package tunnel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_TunnelTemplate(t *testing.T) {
+	srv := NewTunnelService(context.Background(),
+		WithTemplate("edge", TunnelTemplate{
+			Mtu: 1400, Ttl: 64, NoArp: tunnel.LinkFlag_LINK_FLAG_ON, Weight: 10,
+			Sysctls: map[string]string{"rp_filter": "2"},
+		}),
+		WithTemplate("gre", TunnelTemplate{Type: "gre"}),
+		WithTemplate("bad", TunnelTemplate{Sysctls: map[string]string{"forwarding": "x"}}),
+	).(*tunnelService)
+	assert.Equal(t, []string{"bad", "edge", "gre"}, srv.templateNames())
+
+	tmpl, err := srv.templateOf("")
+	assert.NoError(t, err)
+	assert.Nil(t, tmpl)
+	for name, code := range map[string]codes.Code{
+		"none": codes.InvalidArgument,
+		"gre":  codes.FailedPrecondition,
+		"bad":  codes.FailedPrecondition,
+	} {
+		_, err = srv.templateOf(name)
+		assert.Equal(t, code, status.Code(err), name)
+	}
+
+	tmpl, err = srv.templateOf("edge")
+	if !assert.NoError(t, err) {
+		return
+	}
+	req := &tunnel.AddTunnelRequest{TunDestIP: "1.1.1.1", Template: "edge", Mtu: 1300}
+	merged := tmpl.apply(req)
+	assert.Equal(t, uint32(1300), merged.GetMtu())
+	assert.Equal(t, uint32(64), merged.GetTtl())
+	assert.Equal(t, tunnel.LinkFlag_LINK_FLAG_ON, merged.GetNoArp())
+	assert.Equal(t, uint32(10), merged.GetWeight())
+	assert.Equal(t, uint32(0), req.GetTtl())
+	keys, desired := srv.newTunnelSysctls(tmpl)
+	assert.Equal(t, []string{"rp_filter"}, keys, "template overrides rp_filter of the policy")
+	assert.Equal(t, "2", desired["rp_filter"])
+	keys, _ = srv.newTunnelSysctls(nil)
+	assert.Empty(t, keys, "rp_filter of the policy is set apart")
+	srv.sysctls.update(map[string]string{"arp_ignore": "1"})
+	keys, desired = srv.newTunnelSysctls(nil)
+	assert.Equal(t, []string{"arp_ignore"}, keys)
+	assert.Equal(t, "1", desired["arp_ignore"])
+
+	err = srv.validateAddTunnelFields(tunnelTypeIpip, &tunnel.AddTunnelRequest{TunDestIP: "1.1.1.1", Ttl: 256})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,130 @@
+package tunnel
+
+import (
+	"context"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/vishvananda/netlink"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultTestAllParallelism = 8
+	maxTestAllParallelism     = 64
+
+	//defaultTestAllProbeTimeout default time limit of probe of one tunnel
+	defaultTestAllProbeTimeout = 5 * time.Second
+)
+
+//TestAllTunnels impl tunnel service
+//  - managed tunnels are probed the same way TestTunnel does with at most 'parallelism' probes at once
+//  - each probe is limited by 'timeoutMs' so a slow tunnel delays only its own worker
+//  - probes which did not run before the RPC context is done are reported with the context error
+func (srv *tunnelService) TestAllTunnels(ctx context.Context, req *tunnel.TestAllTunnelsRequest) (resp *tunnel.TestAllTunnelsResponse, err error) {
+	span := srv.spanOf(ctx)
+	defer func() {
+		err = srv.correctError(err)
+	}()
+
+	parallelism := int(req.GetParallelism())
+	if parallelism == 0 {
+		parallelism = defaultTestAllParallelism
+	} else if parallelism > maxTestAllParallelism {
+		return nil, status.Errorf(codes.InvalidArgument, "'parallelism': %v is above maximum %v", parallelism, maxTestAllParallelism)
+	}
+	probeTimeout := defaultTestAllProbeTimeout
+	if ms := req.GetTimeoutMs(); ms > 0 {
+		probeTimeout = time.Duration(ms) * time.Millisecond
+	}
+	var count uint32
+	if count, err = pingCountOf(req.GetCount()); err != nil {
+		return nil, err
+	}
+
+	remotes := make(map[string]net.IP)
+	sources := make(map[string]string)
+	err = srv.enumLinks(func(nl netlink.Link) error {
+		if t, ok := nl.(*netlink.Iptun); ok && t.Remote.To4() != nil {
+			remotes[nl.Attrs().Name] = t.Remote
+			sources[nl.Attrs().Name] = probeSourceOf(nl, nil)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	span.SetAttributes(attribute.Int("tunnels", len(remotes)), attribute.Int("parallelism", parallelism))
+
+	resp = &tunnel.TestAllTunnelsResponse{
+		Results: make([]*tunnel.TunnelProbe, 0, len(remotes)),
+	}
+	for name, remote := range remotes {
+		resp.Results = append(resp.Results, &tunnel.TunnelProbe{Name: name, Remote: remote.String(), Source: sources[name]})
+	}
+	sort.Slice(resp.Results, func(i, j int) bool {
+		return resp.Results[i].GetName() < resp.Results[j].GetName()
+	})
+	jobs := make(chan *tunnel.TunnelProbe)
+	var wg sync.WaitGroup
+	for i := 0; i < parallelism && i < len(resp.Results); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for p := range jobs {
+				srv.probeTunnel(ctx, p, remotes[p.Name], count, probeTimeout)
+			}
+		}()
+	}
+	for _, p := range resp.Results {
+		select {
+		case jobs <- p:
+		case <-ctx.Done():
+			p.Error = ctx.Err().Error()
+		}
+	}
+	close(jobs)
+	wg.Wait()
+	srv.debounceProbes(resp.Results)
+	for _, p := range resp.Results {
+		if p.Reachable {
+			resp.ReachableCount++
+		} else {
+			resp.UnreachableCount++
+		}
+	}
+	return resp, nil
+}
+
+//debounceProbes reports reachability of tunnels with flaps shorter than 'WithHealthDebounce' windows hidden;
+//tunnels which were not probed keep their debounce state as is
+func (srv *tunnelService) debounceProbes(probes []*tunnel.TunnelProbe) {
+	names := make(map[string]bool, len(probes))
+	for _, p := range probes {
+		names[p.Name] = true
+		p.ObservedReachable = p.Reachable
+		if srv.healthDebounce.enabled() && len(p.Error) == 0 {
+			p.Reachable, _, _ = srv.healthDebounce.observe(p.Name, p.Reachable)
+		}
+	}
+	if srv.healthDebounce.enabled() {
+		srv.healthDebounce.retain(names)
+	}
+}
+
+//probeTunnel fills probe result of one tunnel
+func (srv *tunnelService) probeTunnel(ctx context.Context, p *tunnel.TunnelProbe, remote net.IP, count uint32, timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	r, err := srv.pingTunnel(ctx, p.Source, remote, count)
+	if err != nil {
+		p.Error = err.Error()
+		return
+	}
+	p.Reachable, p.LossPercent, p.RttAvgMs = r.Reachable, r.LossPercent, r.RttAvgMs
+}
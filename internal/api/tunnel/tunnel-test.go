@@ -0,0 +1,133 @@
+package tunnel
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/vishvananda/netlink"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	//DefaultPingPath default ping binary
+	DefaultPingPath = "ping"
+
+	//pingModeBinary the only test mode for now: raw ICMP sockets need CAP_NET_RAW
+	//so the system 'ping' binary bound to the tunnel interface is run instead
+	pingModeBinary = "ping-binary"
+
+	defaultPingCount = 3
+	maxPingCount     = 100
+)
+
+var (
+	rePingTransmitted = regexp.MustCompile(`(\d+) packets transmitted, (\d+) (?:packets )?received`)
+	rePingRtt         = regexp.MustCompile(`= [\d.]+/([\d.]+)/[\d.]+`)
+)
+
+//TestTunnel impl tunnel service
+func (srv *tunnelService) TestTunnel(ctx context.Context, req *tunnel.TestTunnelRequest) (resp *tunnel.TestTunnelResponse, err error) {
+	tunnelIP := req.GetTunDestIP()
+	span := srv.spanOf(ctx)
+	span.SetAttributes(attribute.String("tunDestIP", tunnelIP))
+	defer func() {
+		err = srv.correctError(err)
+	}()
+
+	var count uint32
+	if count, err = pingCountOf(req.GetCount()); err != nil {
+		return nil, err
+	}
+	hcTunDestNetIP, err := parseTunDestIP(tunnelIP)
+	if err != nil {
+		return nil, err
+	}
+	var sourceIP net.IP
+	if s := req.GetSourceIP(); len(s) > 0 {
+		if sourceIP = net.ParseIP(s); sourceIP.To4() == nil || sourceIP.String() != s {
+			return nil, status.Errorf(codes.InvalidArgument, "'sourceIP': '%s' is not canonical IPv4 address", s)
+		}
+	}
+	var tunnelName string
+	if tunnelName, err = TunnelNameForIP(hcTunDestNetIP); err != nil {
+		return nil, err
+	}
+	span.SetAttributes(attribute.String("tunnel-name", tunnelName))
+	var link netlink.Link
+	if link, err = lookupTunnel(srv.nl, tunnelName); err != nil {
+		return nil, err
+	}
+	source := probeSourceOf(link, sourceIP)
+	span.SetAttributes(attribute.String("source", source))
+
+	srv.addSpanDbgEvent(ctx, span, "exec-ping")
+	return srv.pingTunnel(ctx, source, hcTunDestNetIP, count)
+}
+
+//probeSourceOf gets what probe of the tunnel is bound to: 'sourceIP' if it is given, tunnel local address
+//if the tunnel has one so asymmetric routing setups are tested along the intended path, the tunnel interface otherwise
+func probeSourceOf(link netlink.Link, sourceIP net.IP) string {
+	if sourceIP != nil {
+		return sourceIP.String()
+	}
+	if t, ok := link.(*netlink.Iptun); ok && t.Local != nil && !t.Local.IsUnspecified() {
+		return t.Local.String()
+	}
+	return link.Attrs().Name
+}
+
+//pingCountOf validates 'count' request argument; zero means default count
+func pingCountOf(count uint32) (uint32, error) {
+	if count == 0 {
+		return defaultPingCount, nil
+	} else if count > maxPingCount {
+		return 0, status.Errorf(codes.InvalidArgument, "'count': %v is above maximum %v", count, maxPingCount)
+	}
+	return count, nil
+}
+
+//pingTunnel probes remote of the tunnel by ping bound to 'source' (address or interface, see probeSourceOf) within 'ctx' deadline
+func (srv *tunnelService) pingTunnel(ctx context.Context, source string, remote net.IP, count uint32) (*tunnel.TestTunnelResponse, error) {
+	args := []string{"-n", "-q", "-I", source, "-c", strconv.Itoa(int(count))}
+	if dl, has := ctx.Deadline(); has {
+		secs := int(time.Until(dl) / time.Second)
+		if secs < 1 {
+			secs = 1
+		}
+		args = append(args, "-w", strconv.Itoa(secs))
+	}
+	args = append(args, remote.String())
+	var out bytes.Buffer
+	//ping exit codes: 0 - got replies, 1 - no reply, other - failure
+	if _, err := srv.execExternal(ctx, &out, exitCodes{0, 1}, srv.pingPath, args...); err != nil {
+		return nil, err
+	}
+	ret := parsePingOutput(out.Bytes())
+	ret.Mode, ret.Source = pingModeBinary, source
+	return ret, nil
+}
+
+//parsePingOutput gets test results from ping summary output
+func parsePingOutput(out []byte) *tunnel.TestTunnelResponse {
+	ret := new(tunnel.TestTunnelResponse)
+	if m := rePingTransmitted.FindSubmatch(out); m != nil {
+		tx, _ := strconv.ParseUint(string(m[1]), 10, 32)
+		rx, _ := strconv.ParseUint(string(m[2]), 10, 32)
+		ret.Transmitted, ret.Received = uint32(tx), uint32(rx)
+	}
+	if ret.Transmitted > 0 {
+		ret.LossPercent = 100 * float64(ret.Transmitted-ret.Received) / float64(ret.Transmitted)
+	}
+	if m := rePingRtt.FindSubmatch(out); m != nil {
+		ret.RttAvgMs, _ = strconv.ParseFloat(string(m[1]), 64)
+	}
+	ret.Reachable = ret.Received > 0
+	return ret
+}
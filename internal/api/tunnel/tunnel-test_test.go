@@ -0,0 +1,117 @@
+package tunnel
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/stretchr/testify/assert"
+	"github.com/vishvananda/netlink"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_ParsePingOutput(t *testing.T) {
+	out := []byte(`PING 1.1.1.1 (1.1.1.1) from 10.0.0.1 tun16843009: 56(84) bytes of data.
+
+--- 1.1.1.1 ping statistics ---
+4 packets transmitted, 3 received, 25% packet loss, time 3004ms
+rtt min/avg/max/mdev = 10.100/12.500/15.000/1.900 ms
+`)
+	resp := parsePingOutput(out)
+	assert.True(t, resp.GetReachable())
+	assert.Equal(t, uint32(4), resp.GetTransmitted())
+	assert.Equal(t, uint32(3), resp.GetReceived())
+	assert.Equal(t, float64(25), resp.GetLossPercent())
+	assert.Equal(t, 12.5, resp.GetRttAvgMs())
+
+	resp = parsePingOutput([]byte("3 packets transmitted, 0 received, 100% packet loss, time 2030ms\n"))
+	assert.False(t, resp.GetReachable())
+	assert.Equal(t, float64(100), resp.GetLossPercent())
+}
+
+func Test_TestAllTunnels(t *testing.T) {
+	ping := filepath.Join(t.TempDir(), "ping")
+	script := `#!/bin/sh
+for last; do :; done
+if [ "$last" = "10.0.0.2" ]; then exec sleep 10; fi
+if [ "$last" = "10.0.0.3" ]; then echo "3 packets transmitted, 0 received, 100% packet loss"; exit 1; fi
+echo "3 packets transmitted, 3 received, 0% packet loss"
+echo "rtt min/avg/max/mdev = 0.100/0.200/0.300/0.050 ms"
+`
+	if !assert.NoError(t, os.WriteFile(ping, []byte(script), 0755)) {
+		return
+	}
+	ctx := context.Background()
+	srv := NewTunnelService(ctx, WithPingPath(ping)).(*tunnelService)
+	var links []netlink.Link
+	for _, ip := range []string{"10.0.0.3", "10.0.0.1", "10.0.0.2"} {
+		remote := net.ParseIP(ip)
+		links = append(links, &netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: mustTunnelName(remote)}, Remote: remote})
+	}
+	srv.linkList = func() ([]netlink.Link, error) {
+		return links, nil
+	}
+
+	start := time.Now()
+	resp, err := srv.TestAllTunnels(ctx, &tunnel.TestAllTunnelsRequest{Parallelism: 2, TimeoutMs: 300})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Less(t, int64(time.Since(start)), int64(5*time.Second))
+	byRemote := make(map[string]*tunnel.TunnelProbe)
+	for _, p := range resp.GetResults() {
+		byRemote[p.GetRemote()] = p
+	}
+	if assert.Len(t, byRemote, 3) {
+		assert.True(t, byRemote["10.0.0.1"].GetReachable())
+		assert.Equal(t, 0.2, byRemote["10.0.0.1"].GetRttAvgMs())
+		assert.False(t, byRemote["10.0.0.2"].GetReachable())
+		assert.NotEmpty(t, byRemote["10.0.0.2"].GetError())
+		assert.False(t, byRemote["10.0.0.3"].GetReachable())
+		assert.Empty(t, byRemote["10.0.0.3"].GetError())
+	}
+	assert.Equal(t, uint32(1), resp.GetReachableCount())
+	assert.Equal(t, uint32(2), resp.GetUnreachableCount())
+
+	_, err = srv.TestAllTunnels(ctx, &tunnel.TestAllTunnelsRequest{Parallelism: maxTestAllParallelism + 1})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func Test_ProbeSource(t *testing.T) {
+	ping := filepath.Join(t.TempDir(), "ping")
+	script := `#!/bin/sh
+case "$*" in
+*"-I 192.0.2.1 "*10.0.0.1|*"-I tun167772164 "*10.0.0.4) echo "3 packets transmitted, 3 received, 0% packet loss";;
+*) echo "3 packets transmitted, 0 received, 100% packet loss"; exit 1;;
+esac
+`
+	if !assert.NoError(t, os.WriteFile(ping, []byte(script), 0755)) {
+		return
+	}
+	ctx := context.Background()
+	srv := NewTunnelService(ctx, WithPingPath(ping)).(*tunnelService)
+	links := []netlink.Link{
+		&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun167772161"}, Remote: net.ParseIP("10.0.0.1"), Local: net.ParseIP("192.0.2.1")},
+		&netlink.Iptun{LinkAttrs: netlink.LinkAttrs{Name: "tun167772164"}, Remote: net.ParseIP("10.0.0.4"), Local: net.IPv4zero},
+	}
+	srv.linkList = func() ([]netlink.Link, error) {
+		return links, nil
+	}
+	assert.Equal(t, "192.0.2.1", probeSourceOf(links[0], nil))
+	assert.Equal(t, "tun167772164", probeSourceOf(links[1], nil))
+	assert.Equal(t, "198.51.100.1", probeSourceOf(links[1], net.ParseIP("198.51.100.1")))
+
+	resp, err := srv.TestAllTunnels(ctx, &tunnel.TestAllTunnelsRequest{})
+	if assert.NoError(t, err) && assert.Len(t, resp.GetResults(), 2) {
+		assert.Equal(t, "192.0.2.1", resp.GetResults()[0].GetSource())
+		assert.Equal(t, "tun167772164", resp.GetResults()[1].GetSource())
+		assert.Equal(t, uint32(2), resp.GetReachableCount())
+	}
+	_, err = srv.TestTunnel(ctx, &tunnel.TestTunnelRequest{TunDestIP: "10.0.0.1", SourceIP: "1.1.1"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
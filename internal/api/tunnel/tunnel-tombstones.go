@@ -0,0 +1,92 @@
+package tunnel
+
+import (
+	"sync"
+	"time"
+)
+
+//tombstones remembers recently removed tunnels so replayed RemoveTunnel succeeds instead of NotFound
+//  - tombstone is kept by tunnel name together with its remote; replay to another remote is not matched
+//  - tombstones live no longer than 'ttl'; the oldest ones are evicted when there are more than 'maxSize'
+//  - AddTunnel of the same name drops its tombstone so tunnel removed again later is reported as usual
+//  - tombstones are kept in memory and are lost on restart
+type tombstones struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	maxSize int
+	now     func() time.Time
+	entries map[string]*tombstone
+	order   []*tombstone
+}
+
+type tombstone struct {
+	name   string
+	remote string
+	at     time.Time
+}
+
+func newTombstones(ttl time.Duration, maxSize int) *tombstones {
+	return &tombstones{
+		ttl:     ttl,
+		maxSize: maxSize,
+		now:     time.Now,
+		entries: make(map[string]*tombstone),
+	}
+}
+
+//enabled tells if tombstones are kept at all; they are off by default
+func (ts *tombstones) enabled() bool {
+	return ts.ttl > 0 && ts.maxSize > 0
+}
+
+//bury records tombstone of removed tunnel
+func (ts *tombstones) bury(name, remote string) {
+	if !ts.enabled() {
+		return
+	}
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	t := &tombstone{name: name, remote: remote, at: ts.now()}
+	ts.entries[name] = t
+	ts.order = append(ts.order, t)
+	ts.evictLocked()
+}
+
+//has tells if tunnel 'name' to 'remote' is removed within TTL
+func (ts *tombstones) has(name, remote string) bool {
+	if !ts.enabled() {
+		return false
+	}
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	ts.evictLocked()
+	t := ts.entries[name]
+	return t != nil && t.remote == remote
+}
+
+//forget drops tombstone of tunnel 'name'
+func (ts *tombstones) forget(name string) {
+	if !ts.enabled() {
+		return
+	}
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	delete(ts.entries, name)
+}
+
+//evictLocked drops expired tombstones and the oldest ones above max size; tombstones are ordered by time they are added
+func (ts *tombstones) evictLocked() {
+	now := ts.now()
+	for len(ts.order) > 0 {
+		t := ts.order[0]
+		kept := ts.entries[t.name] == t
+		if kept && now.Sub(t.at) < ts.ttl && len(ts.entries) <= ts.maxSize {
+			break
+		}
+		ts.order[0] = nil
+		ts.order = ts.order[1:]
+		if kept {
+			delete(ts.entries, t.name)
+		}
+	}
+}
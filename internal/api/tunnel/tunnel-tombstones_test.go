@@ -0,0 +1,34 @@
+package tunnel
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Tombstones(t *testing.T) {
+	now := time.Unix(1000, 0)
+	ts := newTombstones(time.Minute, 2)
+	ts.now = func() time.Time { return now }
+
+	ts.bury("tun1", "1.1.1.1")
+	assert.True(t, ts.has("tun1", "1.1.1.1"))
+	assert.False(t, ts.has("tun1", "2.2.2.2"))
+	assert.False(t, ts.has("tun2", "1.1.1.2"))
+
+	ts.bury("tun2", "1.1.1.2")
+	ts.bury("tun3", "1.1.1.3")
+	assert.False(t, ts.has("tun1", "1.1.1.1"), "the oldest is evicted above max size")
+	assert.True(t, ts.has("tun2", "1.1.1.2"))
+
+	ts.forget("tun2")
+	assert.False(t, ts.has("tun2", "1.1.1.2"))
+
+	now = now.Add(time.Minute)
+	assert.False(t, ts.has("tun3", "1.1.1.3"), "expired")
+
+	off := newTombstones(0, 0)
+	off.bury("tun1", "1.1.1.1")
+	assert.False(t, off.has("tun1", "1.1.1.1"))
+}
@@ -0,0 +1,138 @@
+package tunnel
+
+import (
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	//tunnelTypeIpip IPIP tunnel; the only type the service creates
+	tunnelTypeIpip = "ipip"
+
+	maxTunnelTTL = 255
+)
+
+//addTunnelField field of AddTunnelRequest applicable to tunnel type
+type addTunnelField struct {
+	name string
+	//check validates the field before tunnel is created; nil if field is taken as is or is checked against host
+	check func(srv *tunnelService, req *tunnel.AddTunnelRequest) error
+}
+
+//tunnelTypeFields AddTunnelRequest fields applicable to each supported tunnel type;
+//AddTunnel validates requests by these checks so GetServiceInfo reports exactly what is validated
+var tunnelTypeFields = map[string][]addTunnelField{
+	tunnelTypeIpip: {
+		{"tunDestIP", func(srv *tunnelService, req *tunnel.AddTunnelRequest) error {
+			ip, err := parseTunDestIP(req.GetTunDestIP())
+			if err != nil {
+				return err
+			}
+			local, err := hostAddrs(srv.nl)
+			if err != nil {
+				return err
+			}
+			return checkNotLocal(req.GetTunDestIP(), ip, local)
+		}},
+		{"noArp", func(_ *tunnelService, req *tunnel.AddTunnelRequest) error {
+			return validateLinkFlag("noArp", req.GetNoArp())
+		}},
+		{"multicast", func(_ *tunnelService, req *tunnel.AddTunnelRequest) error {
+			return validateMulticastFlag(req)
+		}},
+		{"underlayDev", nil},
+		{"mtu", func(srv *tunnelService, req *tunnel.AddTunnelRequest) error {
+			return srv.validateMtu(req.GetMtu())
+		}},
+		{"timeoutMs", nil},
+		{"multicastRoutes", func(_ *tunnelService, req *tunnel.AddTunnelRequest) error {
+			_, err := parseMulticastRoutes(req.GetMulticastRoutes())
+			return err
+		}},
+		{"weight", func(_ *tunnelService, req *tunnel.AddTunnelRequest) error {
+			return validateWeight(req.GetWeight())
+		}},
+		{"clampMss", nil},
+		{"mssValue", func(_ *tunnelService, req *tunnel.AddTunnelRequest) error {
+			_, err := mssOfRequest(req)
+			return err
+		}},
+		{"fwMark", func(_ *tunnelService, req *tunnel.AddTunnelRequest) error {
+			if m := req.GetFwMark(); len(m) > 0 {
+				_, err := parseFwMark(m)
+				return err
+			}
+			return nil
+		}},
+		{"ttl", func(_ *tunnelService, req *tunnel.AddTunnelRequest) error {
+			if ttl := req.GetTtl(); ttl > maxTunnelTTL {
+				return status.Errorf(codes.InvalidArgument, "'ttl': %v is above maximum %v", ttl, maxTunnelTTL)
+			}
+			return nil
+		}},
+		{"template", func(srv *tunnelService, req *tunnel.AddTunnelRequest) error {
+			_, err := srv.templateOf(req.GetTemplate())
+			return err
+		}},
+		{"policyRoute", func(_ *tunnelService, req *tunnel.AddTunnelRequest) error {
+			_, err := parsePolicyRoute(req.GetPolicyRoute())
+			return err
+		}},
+		{"encryption", func(_ *tunnelService, req *tunnel.AddTunnelRequest) error {
+			return validateEncryptionHints(req.GetEncryption())
+		}},
+		{"egressRateBps", func(_ *tunnelService, req *tunnel.AddTunnelRequest) error {
+			_, err := parseEgressShaping(req.GetEgressRateBps(), req.GetQdisc())
+			return err
+		}},
+		{"qdisc", nil},
+		{"idempotencyKey", nil},
+		{"startDown", func(_ *tunnelService, req *tunnel.AddTunnelRequest) error {
+			if !req.GetStartDown() {
+				return nil
+			}
+			if len(req.GetMulticastRoutes()) > 0 {
+				return status.Errorf(codes.InvalidArgument, "'multicastRoutes': can't be added to tunnel with 'startDown'")
+			}
+			if req.GetPolicyRoute() != nil {
+				return status.Errorf(codes.InvalidArgument, "'policyRoute': can't be added to tunnel with 'startDown'")
+			}
+			return nil
+		}},
+	},
+}
+
+//validateAddTunnelFields runs checks of all fields applicable to tunnel type
+func (srv *tunnelService) validateAddTunnelFields(tunnelType string, req *tunnel.AddTunnelRequest) error {
+	fields, ok := tunnelTypeFields[tunnelType]
+	if !ok {
+		return errors.Errorf("unsupported tunnel type '%s'", tunnelType)
+	}
+	for _, f := range fields {
+		if f.check == nil {
+			continue
+		}
+		if err := f.check(srv, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//tunnelTypesInfo describes supported tunnel types for GetServiceInfo
+func tunnelTypesInfo() []*tunnel.TunnelTypeInfo {
+	ret := make([]*tunnel.TunnelTypeInfo, 0, len(tunnelTypeFields))
+	for _, t := range []string{tunnelTypeIpip} {
+		info := &tunnel.TunnelTypeInfo{Type: t, Overhead: tunnelTypeOverhead[t]}
+		for _, f := range tunnelTypeFields[t] {
+			info.Fields = append(info.Fields, f.name)
+			if f.check != nil {
+				info.ValidatedFields = append(info.ValidatedFields, f.name)
+			}
+		}
+		ret = append(ret, info)
+	}
+	return ret
+}
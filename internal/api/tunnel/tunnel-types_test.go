@@ -0,0 +1,29 @@
+package tunnel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_TunnelTypeFields(t *testing.T) {
+	fields := (&tunnel.AddTunnelRequest{}).ProtoReflect().Descriptor().Fields()
+	names := make([]string, 0, fields.Len())
+	for i := 0; i < fields.Len(); i++ {
+		names = append(names, string(fields.Get(i).Name()))
+	}
+	info := tunnelTypesInfo()
+	if assert.Len(t, info, 1) {
+		assert.Equal(t, tunnelTypeIpip, info[0].GetType())
+		assert.ElementsMatch(t, names, info[0].GetFields())
+		assert.Subset(t, info[0].GetFields(), info[0].GetValidatedFields())
+	}
+	srv := NewTunnelService(context.Background(), WithMinMtu(1280)).(*tunnelService)
+	err := srv.validateAddTunnelFields(tunnelTypeIpip, &tunnel.AddTunnelRequest{TunDestIP: "1.1.1.1", Mtu: 1000})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Error(t, srv.validateAddTunnelFields("gre", &tunnel.AddTunnelRequest{}))
+}
@@ -0,0 +1,37 @@
+package tunnel
+
+import (
+	"net"
+
+	"github.com/pkg/errors"
+	"github.com/vishvananda/netlink"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//resolveUnderlayDev finds underlay device by name and checks it is up
+func resolveUnderlayDev(nl netlinkOps, devName string) (netlink.Link, error) {
+	link, err := nl.LinkByName(devName)
+	if errors.As(err, new(netlink.LinkNotFoundError)) {
+		return nil, status.Errorf(codes.InvalidArgument, "'underlayDev': device '%s' is not found", devName)
+	} else if err != nil {
+		return nil, errors.Wrapf(err, "netlink.LinkByName('%s')", devName)
+	}
+	if link.Attrs().Flags&net.FlagUp == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "'underlayDev': device '%s' is not up", devName)
+	}
+	return link, nil
+}
+
+//underlayDevName gets name of underlay device the tunnel is bound to; empty if it is not bound
+func underlayDevName(nl netlinkOps, link netlink.Link) (string, error) {
+	t, ok := link.(*netlink.Iptun)
+	if !ok || t.Link == 0 {
+		return "", nil
+	}
+	dev, err := nl.LinkByIndex(int(t.Link))
+	if err != nil {
+		return "", errors.Wrapf(err, "netlink.LinkByIndex(%v)", t.Link)
+	}
+	return dev.Attrs().Name, nil
+}
@@ -0,0 +1,50 @@
+package tunnel
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/gradusp/crispy-tunnel/pkg/tunnel"
+	"github.com/stretchr/testify/assert"
+	"github.com/vishvananda/netlink"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_AddTunnelUnderlayDev(t *testing.T) {
+	ctx := context.Background()
+	srv := NewTunnelService(ctx, WithExecEnv(fakeCommands(t, "sysctl"))).(*tunnelService)
+	fake := useFakeNetlink(srv,
+		&netlink.Dummy{LinkAttrs: netlink.LinkAttrs{Name: "eth0", Flags: net.FlagUp}},
+		&netlink.Dummy{LinkAttrs: netlink.LinkAttrs{Name: "eth1"}},
+	)
+	_, err := srv.AddTunnel(ctx, &tunnel.AddTunnelRequest{TunDestIP: "10.0.0.1", UnderlayDev: "eth0"})
+	if assert.NoError(t, err) {
+		info, err := srv.GetTunnel(ctx, &tunnel.GetTunnelRequest{TunDestIP: "10.0.0.1"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "eth0", info.GetUnderlayDev())
+		}
+		link, err := fake.LinkByName(mustTunnelName(net.ParseIP("10.0.0.1")))
+		if assert.NoError(t, err) {
+			assert.Equal(t, uint32(1), link.(*netlink.Iptun).Link)
+		}
+	}
+	_, err = srv.AddTunnel(ctx, &tunnel.AddTunnelRequest{TunDestIP: "10.0.0.2"})
+	if assert.NoError(t, err) {
+		info, err := srv.GetTunnel(ctx, &tunnel.GetTunnelRequest{TunDestIP: "10.0.0.2"})
+		if assert.NoError(t, err) {
+			assert.Empty(t, info.GetUnderlayDev())
+		}
+	}
+
+	_, err = srv.AddTunnel(ctx, &tunnel.AddTunnelRequest{TunDestIP: "10.0.0.3", UnderlayDev: "eth9"})
+	if assert.Equal(t, codes.InvalidArgument, status.Code(err)) {
+		assert.Contains(t, err.Error(), "device 'eth9' is not found")
+	}
+	_, err = srv.AddTunnel(ctx, &tunnel.AddTunnelRequest{TunDestIP: "10.0.0.3", UnderlayDev: "eth1"})
+	if assert.Equal(t, codes.FailedPrecondition, status.Code(err)) {
+		assert.Contains(t, err.Error(), "device 'eth1' is not up")
+	}
+	assert.NotContains(t, fake.names(), mustTunnelName(net.ParseIP("10.0.0.3")))
+}
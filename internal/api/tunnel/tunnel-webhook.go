@@ -0,0 +1,165 @@
+package tunnel
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gradusp/go-platform/logger"
+	"github.com/pkg/errors"
+)
+
+const (
+	//DefaultWebhookTimeout default timeout of one webhook POST attempt
+	DefaultWebhookTimeout = 5 * time.Second
+
+	//DefaultWebhookRetries default count of webhook POST retries after the first failed attempt
+	DefaultWebhookRetries = 3
+
+	webhookQueueSize    = 256
+	webhookRetryBackoff = 500 * time.Millisecond
+)
+
+//actions of tunnel change events
+const (
+	webhookActionAdd        = "add"
+	webhookActionRemove     = "remove"
+	webhookActionSetUp      = "set-up"
+	webhookActionSetDown    = "set-down"
+	webhookActionQuarantine = "quarantine"
+	webhookActionRestore    = "restore"
+	webhookActionSwapRemote = "swap-remote"
+	webhookActionPurge      = "purge"
+	webhookActionSetAlias   = "set-alias"
+	webhookActionDrain      = "drain"
+	webhookActionCordon     = "cordon"
+	webhookActionUncordon   = "uncordon"
+
+	webhookResultOK = "ok"
+)
+
+//tunnelChangeEvent JSON body the webhook gets after successful mutation of tunnel
+type tunnelChangeEvent struct {
+	Action    string    `json:"action"`
+	Name      string    `json:"name"`
+	Remote    string    `json:"remote"`
+	Timestamp time.Time `json:"timestamp"`
+	Result    string    `json:"result"`
+}
+
+//webhook posts tunnel change events to HTTP endpoint in background
+//  - events are queued so RPCs never wait for delivery; events which do not fit into the queue are dropped
+//  - each attempt is limited by 'timeout'; transport errors and non-2xx responses are retried up to 'retries' times
+//  - failed and dropped events are logged and counted but never fail the mutation
+type webhook struct {
+	url     string
+	timeout time.Duration
+	retries int
+	backoff time.Duration
+	client  *http.Client
+	metrics *WebhookMetrics
+	queue   chan tunnelChangeEvent
+}
+
+func newWebhook(url string, timeout time.Duration, retries int, metrics *WebhookMetrics) *webhook {
+	if retries < 0 {
+		retries = 0
+	}
+	return &webhook{
+		url:     url,
+		timeout: timeout,
+		retries: retries,
+		backoff: webhookRetryBackoff,
+		client:  new(http.Client),
+		metrics: metrics,
+		queue:   make(chan tunnelChangeEvent, webhookQueueSize),
+	}
+}
+
+//run delivers queued events one by one until 'ctx' is done
+func (w *webhook) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ev := <-w.queue:
+			if err := w.deliver(ctx, ev); err != nil {
+				w.metrics.observe(webhookFailed)
+				logger.FromContext(ctx).Warnf("webhook: '%s' event of tunnel '%s' is not delivered: %v", ev.Action, ev.Name, err)
+			} else {
+				w.metrics.observe(webhookDelivered)
+			}
+		}
+	}
+}
+
+//enqueue queues event without waiting
+func (w *webhook) enqueue(ctx context.Context, ev tunnelChangeEvent) {
+	select {
+	case w.queue <- ev:
+	default:
+		w.metrics.observe(webhookDropped)
+		logger.FromContext(ctx).Warnf("webhook: '%s' event of tunnel '%s' is dropped because queue is full", ev.Action, ev.Name)
+	}
+}
+
+//deliver posts event with retries
+func (w *webhook) deliver(ctx context.Context, ev tunnelChangeEvent) error {
+	body, err := json.Marshal(ev)
+	if err != nil {
+		return errors.Wrap(err, "json.Marshal")
+	}
+	for attempt := 0; ; attempt++ {
+		if err = w.post(ctx, body); err == nil || attempt >= w.retries {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(w.backoff * time.Duration(attempt+1)):
+		}
+	}
+}
+
+//post makes one POST attempt
+func (w *webhook) post(ctx context.Context, body []byte) error {
+	if w.timeout > 0 {
+		var cancel func()
+		ctx, cancel = context.WithTimeout(ctx, w.timeout)
+		defer cancel()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "http.NewRequest")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "http.Client.Do")
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook responded '%s'", resp.Status)
+	}
+	return nil
+}
+
+//notifyChange sends event of successful tunnel mutation to webhook if there is one
+func (srv *tunnelService) notifyChange(ctx context.Context, action, name, remote string) {
+	srv.history.record(name, action, remote, actorOf(ctx))
+	if srv.webhook == nil {
+		return
+	}
+	srv.webhook.enqueue(ctx, tunnelChangeEvent{
+		Action:    action,
+		Name:      name,
+		Remote:    remote,
+		Timestamp: time.Now().UTC(),
+		Result:    webhookResultOK,
+	})
+}
@@ -0,0 +1,59 @@
+package tunnel
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Webhook(t *testing.T) {
+	var mu sync.Mutex
+	var attempts int
+	var got []tunnelChangeEvent
+	hs := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if attempts++; attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		var ev tunnelChangeEvent
+		if err := json.NewDecoder(r.Body).Decode(&ev); err == nil {
+			got = append(got, ev)
+		}
+	}))
+	defer hs.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	wm := NewWebhookMetrics()
+	srv := NewTunnelService(ctx, WithWebhook(hs.URL, time.Second, 1), WithWebhookMetrics(wm)).(*tunnelService)
+	srv.webhook.backoff = time.Millisecond
+	srv.notifyChange(ctx, webhookActionAdd, "tun16843009", "1.1.1.1")
+	assert.Eventually(t, func() bool {
+		return testutil.ToFloat64(wm.events.WithLabelValues(webhookDelivered)) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	mu.Lock()
+	if assert.Len(t, got, 1) {
+		assert.Equal(t, webhookActionAdd, got[0].Action)
+		assert.Equal(t, "tun16843009", got[0].Name)
+		assert.Equal(t, "1.1.1.1", got[0].Remote)
+		assert.Equal(t, webhookResultOK, got[0].Result)
+	}
+	assert.Equal(t, 2, attempts)
+	mu.Unlock()
+
+	w := newWebhook("http://127.0.0.1:1", time.Second, 0, wm)
+	assert.Error(t, w.deliver(ctx, tunnelChangeEvent{}))
+	for i := 0; i < webhookQueueSize+1; i++ {
+		w.enqueue(ctx, tunnelChangeEvent{Action: webhookActionRemove})
+	}
+	assert.Equal(t, float64(1), testutil.ToFloat64(wm.events.WithLabelValues(webhookDropped)))
+}
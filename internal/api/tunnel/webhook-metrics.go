@@ -0,0 +1,48 @@
+package tunnel
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+//results of webhook event delivery
+const (
+	webhookDelivered = "delivered"
+	webhookFailed    = "failed"
+	webhookDropped   = "dropped"
+)
+
+//WebhookMetrics metrics of tunnel change events delivery to webhook
+type WebhookMetrics struct {
+	events *prometheus.CounterVec
+}
+
+var _ prometheus.Collector = (*WebhookMetrics)(nil)
+
+//NewWebhookMetrics creates webhook metrics; register them and pass to 'WithWebhookMetrics'
+func NewWebhookMetrics() *WebhookMetrics {
+	return &WebhookMetrics{
+		events: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "tunnel",
+			Subsystem: "webhook",
+			Name:      "events_total",
+			Help:      "tunnel change events by delivery result ('delivered', 'failed' - after all retries, 'dropped' - queue is full)",
+		}, []string{"result"}),
+	}
+}
+
+//Describe impl prometheus.Collector
+func (m *WebhookMetrics) Describe(ch chan<- *prometheus.Desc) {
+	m.events.Describe(ch)
+}
+
+//Collect impl prometheus.Collector
+func (m *WebhookMetrics) Collect(ch chan<- prometheus.Metric) {
+	m.events.Collect(ch)
+}
+
+func (m *WebhookMetrics) observe(result string) {
+	if m == nil {
+		return
+	}
+	m.events.WithLabelValues(result).Inc()
+}
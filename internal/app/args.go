@@ -4,10 +4,10 @@ import (
 	"flag"
 )
 
-//ConfigFile file with actual app config
+//ConfigFile file with actual app config; it is set once main parses command line
 var ConfigFile string
 
 func init() {
+	//flags are parsed by main: parsing them here breaks test binaries which register their flags later
 	flag.StringVar(&ConfigFile, "config", "", "app config file")
-	flag.Parse()
 }
@@ -13,10 +13,36 @@ trace:
 
 metrics:
   enable: true
+  per-tunnel: false
+  per-tunnel-max-series: 500
 
 server:
   endpoint: tcp://127.0.0.1:9003
   graceful-shutdown: 30s
+  external-url: https://tunnel.example.com
+  unix-socket: /run/crispy-tunnel/tunnel.sock
+  unix-socket-mode: "0660"
+
+tunnel:
+  read-only: false
+  read-timeout: 10s
+  write-timeout: 30s
+  max-concurrency: 4
+  max-tunnels: 1000
+  min-mtu: 1280
+  firewall-backend: iptables
+  maintenance-state-file: /var/lib/crispy-tunnel/maintenance
+  sysctls: rp_filter=0
+  webhook:
+    url: http://127.0.0.1:8080/tunnel-events
+    timeout: 5s
+    retries: 3
+  template-names: edge
+  templates:
+    edge:
+      mtu: 1400
+      no-arp: LINK_FLAG_ON
+      sysctls: accept_local=1 rp_filter=2
 */
 
 const (
@@ -27,10 +53,104 @@ const (
 	ServerEndpoint = config.ValueString("server/endpoint")
 	//ServerGracefulShutdown ...
 	ServerGracefulShutdown = config.ValueDuration("server/graceful-shutdown")
+	//ServerExternalURL URL clients reach the server by; optional
+	ServerExternalURL = config.ValueString("server/external-url")
+	//ServerUnixSocket path of Unix domain socket the server listens on in addition to endpoint; optional
+	ServerUnixSocket = config.ValueString("server/unix-socket")
+	//ServerUnixSocketMode octal permissions of Unix domain socket
+	ServerUnixSocketMode = config.ValueString("server/unix-socket-mode")
 
 	//MetricsEnable ...
 	MetricsEnable = config.ValueBool("metrics/enable")
+	//MetricsPerTunnel export per tunnel series; beware of cardinality on dense hosts
+	MetricsPerTunnel = config.ValueBool("metrics/per-tunnel")
+	//MetricsPerTunnelMaxSeries count of tunnels with their own series, the rest are aggregated
+	MetricsPerTunnelMaxSeries = config.ValueInt("metrics/per-tunnel-max-series")
 
 	//TraceEnable ...
 	TraceEnable = config.ValueBool("trace/enable")
 )
+
+//tunnel service options; every key is optional and service default is kept for missing one
+const (
+	//TunnelReadOnly only report state, reject mutations
+	TunnelReadOnly = config.ValueBool("tunnel/read-only")
+	//TunnelReadTimeout default deadline of read RPCs; 0 turns it off
+	TunnelReadTimeout = config.ValueDuration("tunnel/read-timeout")
+	//TunnelWriteTimeout default deadline of write RPCs; 0 turns it off
+	TunnelWriteTimeout = config.ValueDuration("tunnel/write-timeout")
+	//TunnelMaxConcurrency how many RPCs may work on tunnels concurrently
+	TunnelMaxConcurrency = config.ValueInt("tunnel/max-concurrency")
+	//TunnelMaxTunnels limit of tunnels AddTunnel creates; 0 - no limit
+	TunnelMaxTunnels = config.ValueUInt("tunnel/max-tunnels")
+	//TunnelMinMtu floor of explicit tunnel MTU
+	TunnelMinMtu = config.ValueUInt("tunnel/min-mtu")
+	//TunnelFirewallBackend 'iptables', 'nft' or empty for auto detection
+	TunnelFirewallBackend = config.ValueString("tunnel/firewall-backend")
+	//TunnelMaintenanceStateFile file keeping maintenance mode over restarts
+	TunnelMaintenanceStateFile = config.ValueString("tunnel/maintenance-state-file")
+	//TunnelExecEnv space separated environment of external commands, e.g. 'LC_ALL=C PATH=/usr/sbin:/usr/bin'
+	TunnelExecEnv = config.ValueString("tunnel/exec-env")
+	//TunnelExecTracePropagation pass trace context to external commands
+	TunnelExecTracePropagation = config.ValueBool("tunnel/exec-trace-propagation")
+	//TunnelProcPath procfs mount point
+	TunnelProcPath = config.ValueString("tunnel/proc-path")
+	//TunnelPingPath ping binary of TestTunnel
+	TunnelPingPath = config.ValueString("tunnel/ping-path")
+	//TunnelSpans add span attributes and events
+	TunnelSpans = config.ValueBool("tunnel/spans")
+	//TunnelIndexAnnotation add tunnel index to spans and logs
+	TunnelIndexAnnotation = config.ValueBool("tunnel/index-annotation")
+	//TunnelBestEffortSysctl keep tunnel when rp_filter can't be set on read-only procfs
+	TunnelBestEffortSysctl = config.ValueBool("tunnel/best-effort-sysctl")
+	//TunnelUnmanagedAcknowledged do not start in safe mode because of unmanaged interfaces
+	TunnelUnmanagedAcknowledged = config.ValueBool("tunnel/unmanaged-acknowledged")
+	//TunnelResumableAdd journal AddTunnel steps so interrupted AddTunnel may be resumed
+	TunnelResumableAdd = config.ValueBool("tunnel/resumable-add")
+	//TunnelOperUpWait how long AddTunnel waits for tunnel to become oper-up
+	TunnelOperUpWait = config.ValueDuration("tunnel/oper-up-wait")
+	//TunnelSysctls space separated 'name=value' per interface sysctls every managed tunnel must have, e.g. 'rp_filter=2'
+	TunnelSysctls = config.ValueString("tunnel/sysctls")
+
+	//TunnelHealthDownGrace tunnel is reported down once it is observed down that long
+	TunnelHealthDownGrace = config.ValueDuration("tunnel/health/down-grace")
+	//TunnelHealthUpStable tunnel is reported up again once it is observed up that long
+	TunnelHealthUpStable = config.ValueDuration("tunnel/health/up-stable")
+	//TunnelHealthCacheTTL TTL of health results; 0 turns the cache off
+	TunnelHealthCacheTTL = config.ValueDuration("tunnel/health/cache-ttl")
+	//TunnelHealthCacheJitter jitter of health results TTL
+	TunnelHealthCacheJitter = config.ValueDuration("tunnel/health/cache-jitter")
+
+	//TunnelIdempotencyTTL how long results are kept for idempotency keys; 0 turns keys off
+	TunnelIdempotencyTTL = config.ValueDuration("tunnel/idempotency/ttl")
+	//TunnelIdempotencyCacheSize how many idempotency keys are kept
+	TunnelIdempotencyCacheSize = config.ValueInt("tunnel/idempotency/cache-size")
+	//TunnelTombstonesTTL how long removed tunnels are remembered for replayed RemoveTunnel
+	TunnelTombstonesTTL = config.ValueDuration("tunnel/tombstones/ttl")
+	//TunnelTombstonesSize how many removed tunnels are remembered
+	TunnelTombstonesSize = config.ValueInt("tunnel/tombstones/size")
+	//TunnelHistoryPerTunnel how many latest changes of each tunnel GetTunnelHistory keeps
+	TunnelHistoryPerTunnel = config.ValueInt("tunnel/history/per-tunnel")
+	//TunnelHistoryTTL how long changes are kept for GetTunnelHistory
+	TunnelHistoryTTL = config.ValueDuration("tunnel/history/ttl")
+	//TunnelModuleLoadAttempts how many times modprobe of 'ipip' is tried; 0 turns loading off
+	TunnelModuleLoadAttempts = config.ValueInt("tunnel/module-load/attempts")
+	//TunnelModuleLoadBackoff linear backoff between modprobe attempts
+	TunnelModuleLoadBackoff = config.ValueDuration("tunnel/module-load/backoff")
+
+	//TunnelWebhookURL URL tunnel change events are posted to
+	TunnelWebhookURL = config.ValueString("tunnel/webhook/url")
+	//TunnelWebhookTimeout limit of each POST attempt
+	TunnelWebhookTimeout = config.ValueDuration("tunnel/webhook/timeout")
+	//TunnelWebhookRetries how many times failed POST is retried
+	TunnelWebhookRetries = config.ValueInt("tunnel/webhook/retries")
+
+	//TunnelTemplateNames space or comma separated names of AddTunnel templates under 'tunnel/templates/<name>/'
+	TunnelTemplateNames = config.ValueString("tunnel/template-names")
+)
+
+//TunnelTemplateKey key of template 'name' field 'field' (type, mtu, ttl, no-arp, multicast, weight,
+//clamp-mss, mss-value, fw-mark, sysctls)
+func TunnelTemplateKey(name, field string) string {
+	return "tunnel/templates/" + name + "/" + field
+}
@@ -12,6 +12,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -23,6 +24,287 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+//LinkFlag флаг интерфейса; не заданный оставляем по умолчанию
+type LinkFlag int32
+
+const (
+	//LINK_FLAG_DEFAULT оставить как есть по умолчанию
+	LinkFlag_LINK_FLAG_DEFAULT LinkFlag = 0
+	//LINK_FLAG_ON включить
+	LinkFlag_LINK_FLAG_ON LinkFlag = 1
+	//LINK_FLAG_OFF выключить
+	LinkFlag_LINK_FLAG_OFF LinkFlag = 2
+)
+
+// Enum value maps for LinkFlag.
+var (
+	LinkFlag_name = map[int32]string{
+		0: "LINK_FLAG_DEFAULT",
+		1: "LINK_FLAG_ON",
+		2: "LINK_FLAG_OFF",
+	}
+	LinkFlag_value = map[string]int32{
+		"LINK_FLAG_DEFAULT": 0,
+		"LINK_FLAG_ON":      1,
+		"LINK_FLAG_OFF":     2,
+	}
+)
+
+func (x LinkFlag) Enum() *LinkFlag {
+	p := new(LinkFlag)
+	*p = x
+	return p
+}
+
+func (x LinkFlag) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LinkFlag) Descriptor() protoreflect.EnumDescriptor {
+	return file_tunnel_tunnel_proto_enumTypes[0].Descriptor()
+}
+
+func (LinkFlag) Type() protoreflect.EnumType {
+	return &file_tunnel_tunnel_proto_enumTypes[0]
+}
+
+func (x LinkFlag) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LinkFlag.Descriptor instead.
+func (LinkFlag) EnumDescriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{0}
+}
+
+//StateSortBy порядок туннелей в GetState
+type StateSortBy int32
+
+const (
+	//STATE_SORT_BY_NAME по имени
+	StateSortBy_STATE_SORT_BY_NAME StateSortBy = 0
+	//STATE_SORT_BY_INDEX по числовому индексу в имени
+	StateSortBy_STATE_SORT_BY_INDEX StateSortBy = 1
+	//STATE_SORT_BY_REMOTE_IP по адресу удаленной стороны
+	StateSortBy_STATE_SORT_BY_REMOTE_IP StateSortBy = 2
+)
+
+// Enum value maps for StateSortBy.
+var (
+	StateSortBy_name = map[int32]string{
+		0: "STATE_SORT_BY_NAME",
+		1: "STATE_SORT_BY_INDEX",
+		2: "STATE_SORT_BY_REMOTE_IP",
+	}
+	StateSortBy_value = map[string]int32{
+		"STATE_SORT_BY_NAME":      0,
+		"STATE_SORT_BY_INDEX":     1,
+		"STATE_SORT_BY_REMOTE_IP": 2,
+	}
+)
+
+func (x StateSortBy) Enum() *StateSortBy {
+	p := new(StateSortBy)
+	*p = x
+	return p
+}
+
+func (x StateSortBy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StateSortBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_tunnel_tunnel_proto_enumTypes[1].Descriptor()
+}
+
+func (StateSortBy) Type() protoreflect.EnumType {
+	return &file_tunnel_tunnel_proto_enumTypes[1]
+}
+
+func (x StateSortBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StateSortBy.Descriptor instead.
+func (StateSortBy) EnumDescriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{1}
+}
+
+//OperStateFilter отбор туннелей по рабочему состоянию интерфейса
+type OperStateFilter int32
+
+const (
+	//OPER_STATE_ANY все туннели
+	OperStateFilter_OPER_STATE_ANY OperStateFilter = 0
+	//OPER_STATE_UP работающие: UP или UNKNOWN у поднятого интерфейса (IPIP не сообщает состояние несущей)
+	OperStateFilter_OPER_STATE_UP OperStateFilter = 1
+	//OPER_STATE_DOWN все остальные
+	OperStateFilter_OPER_STATE_DOWN OperStateFilter = 2
+)
+
+// Enum value maps for OperStateFilter.
+var (
+	OperStateFilter_name = map[int32]string{
+		0: "OPER_STATE_ANY",
+		1: "OPER_STATE_UP",
+		2: "OPER_STATE_DOWN",
+	}
+	OperStateFilter_value = map[string]int32{
+		"OPER_STATE_ANY":  0,
+		"OPER_STATE_UP":   1,
+		"OPER_STATE_DOWN": 2,
+	}
+)
+
+func (x OperStateFilter) Enum() *OperStateFilter {
+	p := new(OperStateFilter)
+	*p = x
+	return p
+}
+
+func (x OperStateFilter) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OperStateFilter) Descriptor() protoreflect.EnumDescriptor {
+	return file_tunnel_tunnel_proto_enumTypes[2].Descriptor()
+}
+
+func (OperStateFilter) Type() protoreflect.EnumType {
+	return &file_tunnel_tunnel_proto_enumTypes[2]
+}
+
+func (x OperStateFilter) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OperStateFilter.Descriptor instead.
+func (OperStateFilter) EnumDescriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{2}
+}
+
+//PlanAction действие плана
+type PlanAction int32
+
+const (
+	//PLAN_ACTION_KEEP туннель уже есть
+	PlanAction_PLAN_ACTION_KEEP PlanAction = 0
+	//PLAN_ACTION_CREATE туннель надо создать
+	PlanAction_PLAN_ACTION_CREATE PlanAction = 1
+	//PLAN_ACTION_REMOVE туннель надо удалить
+	PlanAction_PLAN_ACTION_REMOVE PlanAction = 2
+	//PLAN_ACTION_SKIP ничего не делать из-за ошибки в запросе
+	PlanAction_PLAN_ACTION_SKIP PlanAction = 3
+	//PLAN_ACTION_UPDATE туннель есть с другими параметрами, его надо пересоздать
+	PlanAction_PLAN_ACTION_UPDATE PlanAction = 4
+)
+
+// Enum value maps for PlanAction.
+var (
+	PlanAction_name = map[int32]string{
+		0: "PLAN_ACTION_KEEP",
+		1: "PLAN_ACTION_CREATE",
+		2: "PLAN_ACTION_REMOVE",
+		3: "PLAN_ACTION_SKIP",
+		4: "PLAN_ACTION_UPDATE",
+	}
+	PlanAction_value = map[string]int32{
+		"PLAN_ACTION_KEEP":   0,
+		"PLAN_ACTION_CREATE": 1,
+		"PLAN_ACTION_REMOVE": 2,
+		"PLAN_ACTION_SKIP":   3,
+		"PLAN_ACTION_UPDATE": 4,
+	}
+)
+
+func (x PlanAction) Enum() *PlanAction {
+	p := new(PlanAction)
+	*p = x
+	return p
+}
+
+func (x PlanAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlanAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_tunnel_tunnel_proto_enumTypes[3].Descriptor()
+}
+
+func (PlanAction) Type() protoreflect.EnumType {
+	return &file_tunnel_tunnel_proto_enumTypes[3]
+}
+
+func (x PlanAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlanAction.Descriptor instead.
+func (PlanAction) EnumDescriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{3}
+}
+
+//TunnelEventKind вид события туннеля
+type TunnelEventKind int32
+
+const (
+	//TUNNEL_EVENT_UNKNOWN не определено
+	TunnelEventKind_TUNNEL_EVENT_UNKNOWN TunnelEventKind = 0
+	//TUNNEL_EVENT_ADDED туннель появился
+	TunnelEventKind_TUNNEL_EVENT_ADDED TunnelEventKind = 1
+	//TUNNEL_EVENT_REMOVED туннель удален
+	TunnelEventKind_TUNNEL_EVENT_REMOVED TunnelEventKind = 2
+	//TUNNEL_EVENT_UP туннель заработал: UP или UNKNOWN у поднятого интерфейса
+	TunnelEventKind_TUNNEL_EVENT_UP TunnelEventKind = 3
+	//TUNNEL_EVENT_DOWN туннель перестал работать: выключен или потерял несущую
+	TunnelEventKind_TUNNEL_EVENT_DOWN TunnelEventKind = 4
+)
+
+// Enum value maps for TunnelEventKind.
+var (
+	TunnelEventKind_name = map[int32]string{
+		0: "TUNNEL_EVENT_UNKNOWN",
+		1: "TUNNEL_EVENT_ADDED",
+		2: "TUNNEL_EVENT_REMOVED",
+		3: "TUNNEL_EVENT_UP",
+		4: "TUNNEL_EVENT_DOWN",
+	}
+	TunnelEventKind_value = map[string]int32{
+		"TUNNEL_EVENT_UNKNOWN": 0,
+		"TUNNEL_EVENT_ADDED":   1,
+		"TUNNEL_EVENT_REMOVED": 2,
+		"TUNNEL_EVENT_UP":      3,
+		"TUNNEL_EVENT_DOWN":    4,
+	}
+)
+
+func (x TunnelEventKind) Enum() *TunnelEventKind {
+	p := new(TunnelEventKind)
+	*p = x
+	return p
+}
+
+func (x TunnelEventKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TunnelEventKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_tunnel_tunnel_proto_enumTypes[4].Descriptor()
+}
+
+func (TunnelEventKind) Type() protoreflect.EnumType {
+	return &file_tunnel_tunnel_proto_enumTypes[4]
+}
+
+func (x TunnelEventKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TunnelEventKind.Descriptor instead.
+func (TunnelEventKind) EnumDescriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{4}
+}
+
 //AddTunnelRequest добавить туннель
 type AddTunnelRequest struct {
 	state         protoimpl.MessageState
@@ -30,6 +312,42 @@ type AddTunnelRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	TunDestIP string `protobuf:"bytes,1,opt,name=tunDestIP,proto3" json:"tunDestIP,omitempty"`
+	//noArp флаг NOARP интерфейса
+	NoArp LinkFlag `protobuf:"varint,2,opt,name=noArp,proto3,enum=crispy.tunnel.LinkFlag" json:"noArp,omitempty"`
+	//multicast флаг MULTICAST интерфейса
+	Multicast LinkFlag `protobuf:"varint,3,opt,name=multicast,proto3,enum=crispy.tunnel.LinkFlag" json:"multicast,omitempty"`
+	//underlayDev сетевой интерфейс через который пойдет трафик туннеля (не обязательно)
+	UnderlayDev string `protobuf:"bytes,4,opt,name=underlayDev,proto3" json:"underlayDev,omitempty"`
+	//mtu MTU интерфейса; 0 - по умолчанию
+	Mtu uint32 `protobuf:"varint,5,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	//timeoutMs время на операцию (мс); не продлевает срок вызывающего, 0 - по умолчанию
+	TimeoutMs uint32 `protobuf:"varint,6,opt,name=timeoutMs,proto3" json:"timeoutMs,omitempty"`
+	//multicastRoutes multicast маршруты (адреса или подсети из 224.0.0.0/4) через туннель
+	MulticastRoutes []string `protobuf:"bytes,7,rep,name=multicastRoutes,proto3" json:"multicastRoutes,omitempty"`
+	//weight вес туннеля для балансировщиков (1..65535); 0 - не задан
+	Weight uint32 `protobuf:"varint,8,opt,name=weight,proto3" json:"weight,omitempty"`
+	//clampMss ограничивать TCP MSS трафика через туннель
+	ClampMss bool `protobuf:"varint,9,opt,name=clampMss,proto3" json:"clampMss,omitempty"`
+	//mssValue значение MSS; 0 - по PMTU
+	MssValue uint32 `protobuf:"varint,10,opt,name=mssValue,proto3" json:"mssValue,omitempty"`
+	//startDown оставить интерфейс опущенным (поднять позже через SetTunnelState); rp_filter настраивается все равно
+	StartDown bool `protobuf:"varint,11,opt,name=startDown,proto3" json:"startDown,omitempty"`
+	//idempotencyKey ключ идемпотентности; повтор с тем же ключом вернет сохраненный результат
+	IdempotencyKey string `protobuf:"bytes,12,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
+	//fwMark netfilter метка исходящего через туннель трафика: значение[/маска]; пусто - не ставить
+	FwMark string `protobuf:"bytes,13,opt,name=fwMark,proto3" json:"fwMark,omitempty"`
+	//ttl TTL пакетов туннеля (1..255); 0 - наследовать от вложенного пакета
+	Ttl uint32 `protobuf:"varint,14,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	//template имя шаблона параметров; явно заданные поля запроса важнее значений шаблона
+	Template string `protobuf:"bytes,15,opt,name=template,proto3" json:"template,omitempty"`
+	//policyRoute маршрут через туннель в своей таблице и правило fwmark для нее; удаляются вместе с туннелем
+	PolicyRoute *PolicyRoute `protobuf:"bytes,16,opt,name=policyRoute,proto3" json:"policyRoute,omitempty"`
+	//encryption подсказки для внешних IPsec/XDP программ; только метаданные
+	Encryption *EncryptionHints `protobuf:"bytes,17,opt,name=encryption,proto3" json:"encryption,omitempty"`
+	//egressRateBps ограничение исходящего трафика туннеля в битах в секунду; 0 - без ограничения
+	EgressRateBps uint64 `protobuf:"varint,18,opt,name=egressRateBps,proto3" json:"egressRateBps,omitempty"`
+	//qdisc дисциплина ограничения: "tbf" (по умолчанию) или "htb"; только вместе с egressRateBps
+	Qdisc string `protobuf:"bytes,19,opt,name=qdisc,proto3" json:"qdisc,omitempty"`
 }
 
 func (x *AddTunnelRequest) Reset() {
@@ -71,17 +389,146 @@ func (x *AddTunnelRequest) GetTunDestIP() string {
 	return ""
 }
 
-//AddTunnelRequest добавить туннель
-type RemoveTunnelRequest struct {
+func (x *AddTunnelRequest) GetNoArp() LinkFlag {
+	if x != nil {
+		return x.NoArp
+	}
+	return LinkFlag_LINK_FLAG_DEFAULT
+}
+
+func (x *AddTunnelRequest) GetMulticast() LinkFlag {
+	if x != nil {
+		return x.Multicast
+	}
+	return LinkFlag_LINK_FLAG_DEFAULT
+}
+
+func (x *AddTunnelRequest) GetUnderlayDev() string {
+	if x != nil {
+		return x.UnderlayDev
+	}
+	return ""
+}
+
+func (x *AddTunnelRequest) GetMtu() uint32 {
+	if x != nil {
+		return x.Mtu
+	}
+	return 0
+}
+
+func (x *AddTunnelRequest) GetTimeoutMs() uint32 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+func (x *AddTunnelRequest) GetMulticastRoutes() []string {
+	if x != nil {
+		return x.MulticastRoutes
+	}
+	return nil
+}
+
+func (x *AddTunnelRequest) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *AddTunnelRequest) GetClampMss() bool {
+	if x != nil {
+		return x.ClampMss
+	}
+	return false
+}
+
+func (x *AddTunnelRequest) GetMssValue() uint32 {
+	if x != nil {
+		return x.MssValue
+	}
+	return 0
+}
+
+func (x *AddTunnelRequest) GetStartDown() bool {
+	if x != nil {
+		return x.StartDown
+	}
+	return false
+}
+
+func (x *AddTunnelRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *AddTunnelRequest) GetFwMark() string {
+	if x != nil {
+		return x.FwMark
+	}
+	return ""
+}
+
+func (x *AddTunnelRequest) GetTtl() uint32 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+func (x *AddTunnelRequest) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *AddTunnelRequest) GetPolicyRoute() *PolicyRoute {
+	if x != nil {
+		return x.PolicyRoute
+	}
+	return nil
+}
+
+func (x *AddTunnelRequest) GetEncryption() *EncryptionHints {
+	if x != nil {
+		return x.Encryption
+	}
+	return nil
+}
+
+func (x *AddTunnelRequest) GetEgressRateBps() uint64 {
+	if x != nil {
+		return x.EgressRateBps
+	}
+	return 0
+}
+
+func (x *AddTunnelRequest) GetQdisc() string {
+	if x != nil {
+		return x.Qdisc
+	}
+	return ""
+}
+
+//EncryptionHints метаданные туннеля для внешних IPsec/XDP программ; ядро сервис не настраивает
+type EncryptionHints struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TunDestIP string `protobuf:"bytes,1,opt,name=tunDestIP,proto3" json:"tunDestIP,omitempty"`
+	//profile имя профиля шифрования: строчные латинские буквы, цифры, '.', '_', '-' до 32 символов
+	Profile string `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	//spiRange диапазон SPI 'min-max' (десятичные или 0x числа); min не меньше 256
+	SpiRange string `protobuf:"bytes,2,opt,name=spiRange,proto3" json:"spiRange,omitempty"`
 }
 
-func (x *RemoveTunnelRequest) Reset() {
-	*x = RemoveTunnelRequest{}
+func (x *EncryptionHints) Reset() {
+	*x = EncryptionHints{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tunnel_tunnel_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -89,13 +536,13 @@ func (x *RemoveTunnelRequest) Reset() {
 	}
 }
 
-func (x *RemoveTunnelRequest) String() string {
+func (x *EncryptionHints) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveTunnelRequest) ProtoMessage() {}
+func (*EncryptionHints) ProtoMessage() {}
 
-func (x *RemoveTunnelRequest) ProtoReflect() protoreflect.Message {
+func (x *EncryptionHints) ProtoReflect() protoreflect.Message {
 	mi := &file_tunnel_tunnel_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -107,30 +554,43 @@ func (x *RemoveTunnelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveTunnelRequest.ProtoReflect.Descriptor instead.
-func (*RemoveTunnelRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use EncryptionHints.ProtoReflect.Descriptor instead.
+func (*EncryptionHints) Descriptor() ([]byte, []int) {
 	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *RemoveTunnelRequest) GetTunDestIP() string {
+func (x *EncryptionHints) GetProfile() string {
 	if x != nil {
-		return x.TunDestIP
+		return x.Profile
 	}
 	return ""
 }
 
-//GetStateResponse выдаем все туннели
-type GetStateResponse struct {
+func (x *EncryptionHints) GetSpiRange() string {
+	if x != nil {
+		return x.SpiRange
+	}
+	return ""
+}
+
+//PolicyRoute маршрут через туннель в таблице, доступной только по правилу fwmark
+type PolicyRoute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//tunnels список туннелей
-	Tunnels []string `protobuf:"bytes,1,rep,name=tunnels,proto3" json:"tunnels,omitempty"`
+	//table номер таблицы маршрутизации; 0 и 253..255 (default, main, local) недопустимы
+	Table uint32 `protobuf:"varint,1,opt,name=table,proto3" json:"table,omitempty"`
+	//fwMark метка значение[/маска] правила 'fwmark ... lookup table'
+	FwMark string `protobuf:"bytes,2,opt,name=fwMark,proto3" json:"fwMark,omitempty"`
+	//dst сеть назначения маршрута; пусто - 0.0.0.0/0
+	Dst string `protobuf:"bytes,3,opt,name=dst,proto3" json:"dst,omitempty"`
+	//priority приоритет правила; 0 - выбирает ядро
+	Priority uint32 `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
 }
 
-func (x *GetStateResponse) Reset() {
-	*x = GetStateResponse{}
+func (x *PolicyRoute) Reset() {
+	*x = PolicyRoute{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tunnel_tunnel_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -138,13 +598,13 @@ func (x *GetStateResponse) Reset() {
 	}
 }
 
-func (x *GetStateResponse) String() string {
+func (x *PolicyRoute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStateResponse) ProtoMessage() {}
+func (*PolicyRoute) ProtoMessage() {}
 
-func (x *GetStateResponse) ProtoReflect() protoreflect.Message {
+func (x *PolicyRoute) ProtoReflect() protoreflect.Message {
 	mi := &file_tunnel_tunnel_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -156,115 +616,6809 @@ func (x *GetStateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStateResponse.ProtoReflect.Descriptor instead.
-func (*GetStateResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PolicyRoute.ProtoReflect.Descriptor instead.
+func (*PolicyRoute) Descriptor() ([]byte, []int) {
 	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *GetStateResponse) GetTunnels() []string {
+func (x *PolicyRoute) GetTable() uint32 {
 	if x != nil {
-		return x.Tunnels
+		return x.Table
 	}
-	return nil
+	return 0
 }
 
-var File_tunnel_tunnel_proto protoreflect.FileDescriptor
-
-var file_tunnel_tunnel_proto_rawDesc = []byte{
-	0x0a, 0x13, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75,
-	0x6e, 0x6e, 0x65, 0x6c, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e,
-	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e,
-	0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e,
-	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0x30, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x49, 0x50,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x75, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x49,
-	0x50, 0x22, 0x33, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65,
-	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x44,
-	0x65, 0x73, 0x74, 0x49, 0x50, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x75, 0x6e,
-	0x44, 0x65, 0x73, 0x74, 0x49, 0x50, 0x22, 0x2c, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x75,
-	0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x74, 0x75, 0x6e,
-	0x6e, 0x65, 0x6c, 0x73, 0x32, 0xb9, 0x02, 0x0a, 0x0d, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5f, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x54, 0x75, 0x6e,
-	0x6e, 0x65, 0x6c, 0x12, 0x1f, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e,
-	0x6e, 0x65, 0x6c, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x19, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x13, 0x22, 0x0e, 0x2f, 0x76, 0x32, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
-	0x2f, 0x61, 0x64, 0x64, 0x3a, 0x01, 0x2a, 0x12, 0x68, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x22, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79,
-	0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x75,
-	0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x22, 0x11, 0x2f, 0x76, 0x32,
-	0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x3a, 0x01,
-	0x2a, 0x12, 0x5d, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74,
-	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10,
-	0x2f, 0x76, 0x32, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x42, 0xb7, 0x01, 0x5a, 0x07, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x92, 0x41, 0xaa, 0x01,
-	0x12, 0x80, 0x01, 0x0a, 0x11, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x20, 0x70, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x20, 0x41, 0x50, 0x49, 0x22, 0x66, 0x0a, 0x0f, 0x50, 0x61, 0x76, 0x65, 0x6c, 0x20,
-	0x46, 0x69, 0x73, 0x6b, 0x6f, 0x76, 0x69, 0x63, 0x68, 0x12, 0x53, 0x68, 0x74, 0x74, 0x70, 0x73,
-	0x3a, 0x2f, 0x2f, 0x62, 0x6c, 0x6f, 0x67, 0x2e, 0x62, 0x75, 0x6c, 0x6c, 0x67, 0x61, 0x72, 0x65,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x32, 0x30, 0x32, 0x30, 0x2f, 0x30, 0x37, 0x2f, 0x63, 0x6f, 0x6d,
-	0x70, 0x6c, 0x65, 0x74, 0x65, 0x2d, 0x6c, 0x69, 0x73, 0x74, 0x2d, 0x6f, 0x66, 0x2d, 0x73, 0x77,
-	0x61, 0x67, 0x67, 0x65, 0x72, 0x2d, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2d, 0x74, 0x6f,
-	0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2d, 0x66, 0x69, 0x6c, 0x65, 0x32, 0x03,
-	0x32, 0x2e, 0x30, 0x2a, 0x01, 0x01, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+func (x *PolicyRoute) GetFwMark() string {
+	if x != nil {
+		return x.FwMark
+	}
+	return ""
 }
 
-var (
-	file_tunnel_tunnel_proto_rawDescOnce sync.Once
-	file_tunnel_tunnel_proto_rawDescData = file_tunnel_tunnel_proto_rawDesc
-)
-
-func file_tunnel_tunnel_proto_rawDescGZIP() []byte {
-	file_tunnel_tunnel_proto_rawDescOnce.Do(func() {
-		file_tunnel_tunnel_proto_rawDescData = protoimpl.X.CompressGZIP(file_tunnel_tunnel_proto_rawDescData)
-	})
-	return file_tunnel_tunnel_proto_rawDescData
+func (x *PolicyRoute) GetDst() string {
+	if x != nil {
+		return x.Dst
+	}
+	return ""
 }
 
-var file_tunnel_tunnel_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
-var file_tunnel_tunnel_proto_goTypes = []interface{}{
-	(*AddTunnelRequest)(nil),    // 0: crispy.tunnel.AddTunnelRequest
-	(*RemoveTunnelRequest)(nil), // 1: crispy.tunnel.RemoveTunnelRequest
-	(*GetStateResponse)(nil),    // 2: crispy.tunnel.GetStateResponse
-	(*emptypb.Empty)(nil),       // 3: google.protobuf.Empty
+func (x *PolicyRoute) GetPriority() uint32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
 }
-var file_tunnel_tunnel_proto_depIdxs = []int32{
-	0, // 0: crispy.tunnel.TunnelService.AddTunnel:input_type -> crispy.tunnel.AddTunnelRequest
-	1, // 1: crispy.tunnel.TunnelService.RemoveTunnel:input_type -> crispy.tunnel.RemoveTunnelRequest
-	3, // 2: crispy.tunnel.TunnelService.GetState:input_type -> google.protobuf.Empty
-	3, // 3: crispy.tunnel.TunnelService.AddTunnel:output_type -> google.protobuf.Empty
-	3, // 4: crispy.tunnel.TunnelService.RemoveTunnel:output_type -> google.protobuf.Empty
-	2, // 5: crispy.tunnel.TunnelService.GetState:output_type -> crispy.tunnel.GetStateResponse
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+
+//AddTunnelResponse сведения о созданном туннеле
+type AddTunnelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//tunnel фактические параметры туннеля прочитанные после создания
+	Tunnel *TunnelInfo `protobuf:"bytes,1,opt,name=tunnel,proto3" json:"tunnel,omitempty"`
+	//warnings что не удалось настроить в режиме best effort (туннель при этом создан)
+	Warnings []string `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	//ready интерфейс в рабочем состоянии (UP или UNKNOWN у поднятого IPIP);
+	//при включенном ожидании false значит состояние не достигнуто за отведенное время
+	Ready bool `protobuf:"varint,3,opt,name=ready,proto3" json:"ready,omitempty"`
 }
 
-func init() { file_tunnel_tunnel_proto_init() }
-func file_tunnel_tunnel_proto_init() {
-	if File_tunnel_tunnel_proto != nil {
-		return
+func (x *AddTunnelResponse) Reset() {
+	*x = AddTunnelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_tunnel_tunnel_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddTunnelRequest); i {
+}
+
+func (x *AddTunnelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTunnelResponse) ProtoMessage() {}
+
+func (x *AddTunnelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTunnelResponse.ProtoReflect.Descriptor instead.
+func (*AddTunnelResponse) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AddTunnelResponse) GetTunnel() *TunnelInfo {
+	if x != nil {
+		return x.Tunnel
+	}
+	return nil
+}
+
+func (x *AddTunnelResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *AddTunnelResponse) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+//AddTunnelRequest добавить туннель
+type RemoveTunnelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TunDestIP string `protobuf:"bytes,1,opt,name=tunDestIP,proto3" json:"tunDestIP,omitempty"`
+	//timeoutMs время на операцию (мс); не продлевает срок вызывающего, 0 - по умолчанию
+	TimeoutMs uint32 `protobuf:"varint,2,opt,name=timeoutMs,proto3" json:"timeoutMs,omitempty"`
+	//cascadeDelete удалить маршруты и правила ссылающиеся на туннель
+	CascadeDelete bool `protobuf:"varint,3,opt,name=cascadeDelete,proto3" json:"cascadeDelete,omitempty"`
+	//idempotencyKey ключ идемпотентности; повтор с тем же ключом вернет сохраненный результат
+	IdempotencyKey string `protobuf:"bytes,4,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
+	//verifyRemote проверить что удаленная сторона туннеля совпадает с tunDestIP;
+	//без проверки удаляется любой интерфейс с вычисленным именем, даже если его перенастроили вручную
+	VerifyRemote bool `protobuf:"varint,5,opt,name=verifyRemote,proto3" json:"verifyRemote,omitempty"`
+}
+
+func (x *RemoveTunnelRequest) Reset() {
+	*x = RemoveTunnelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveTunnelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTunnelRequest) ProtoMessage() {}
+
+func (x *RemoveTunnelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTunnelRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTunnelRequest) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RemoveTunnelRequest) GetTunDestIP() string {
+	if x != nil {
+		return x.TunDestIP
+	}
+	return ""
+}
+
+func (x *RemoveTunnelRequest) GetTimeoutMs() uint32 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+func (x *RemoveTunnelRequest) GetCascadeDelete() bool {
+	if x != nil {
+		return x.CascadeDelete
+	}
+	return false
+}
+
+func (x *RemoveTunnelRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *RemoveTunnelRequest) GetVerifyRemote() bool {
+	if x != nil {
+		return x.VerifyRemote
+	}
+	return false
+}
+
+//RemoveTunnelResponse что было удалено вместе с туннелем
+type RemoveTunnelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//removedRoutes удаленные маршруты
+	RemovedRoutes []string `protobuf:"bytes,1,rep,name=removedRoutes,proto3" json:"removedRoutes,omitempty"`
+	//removedRules удаленные правила маршрутизации
+	RemovedRules []string `protobuf:"bytes,2,rep,name=removedRules,proto3" json:"removedRules,omitempty"`
+	//replayed туннель уже удален недавно, повтор RemoveTunnel считается успешным (см. tombstones)
+	Replayed bool `protobuf:"varint,3,opt,name=replayed,proto3" json:"replayed,omitempty"`
+}
+
+func (x *RemoveTunnelResponse) Reset() {
+	*x = RemoveTunnelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveTunnelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTunnelResponse) ProtoMessage() {}
+
+func (x *RemoveTunnelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTunnelResponse.ProtoReflect.Descriptor instead.
+func (*RemoveTunnelResponse) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RemoveTunnelResponse) GetRemovedRoutes() []string {
+	if x != nil {
+		return x.RemovedRoutes
+	}
+	return nil
+}
+
+func (x *RemoveTunnelResponse) GetRemovedRules() []string {
+	if x != nil {
+		return x.RemovedRules
+	}
+	return nil
+}
+
+func (x *RemoveTunnelResponse) GetReplayed() bool {
+	if x != nil {
+		return x.Replayed
+	}
+	return false
+}
+
+//GetStateRequest запрос всех туннелей
+type GetStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//detailed выдать сведения о каждом туннеле
+	Detailed bool `protobuf:"varint,1,opt,name=detailed,proto3" json:"detailed,omitempty"`
+	//sortBy порядок туннелей
+	SortBy StateSortBy `protobuf:"varint,2,opt,name=sortBy,proto3,enum=crispy.tunnel.StateSortBy" json:"sortBy,omitempty"`
+	//descending обратный порядок
+	Descending bool `protobuf:"varint,3,opt,name=descending,proto3" json:"descending,omitempty"`
+	//operState отбор по рабочему состоянию интерфейса
+	OperState OperStateFilter `protobuf:"varint,4,opt,name=operState,proto3,enum=crispy.tunnel.OperStateFilter" json:"operState,omitempty"`
+	//partialOnCancel при отмене или истечении срока вернуть уже перечисленные туннели вместо ошибки
+	PartialOnCancel bool `protobuf:"varint,5,opt,name=partialOnCancel,proto3" json:"partialOnCancel,omitempty"`
+	//groupByEgress сгруппировать туннели по исходящему интерфейсу underlay (только с detailed)
+	GroupByEgress bool `protobuf:"varint,6,opt,name=groupByEgress,proto3" json:"groupByEgress,omitempty"`
+	//ifNoneMatch etag прошлого ответа; если состояние не изменилось, ответ содержит только etag и notModified
+	IfNoneMatch string `protobuf:"bytes,7,opt,name=ifNoneMatch,proto3" json:"ifNoneMatch,omitempty"`
+}
+
+func (x *GetStateRequest) Reset() {
+	*x = GetStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStateRequest) ProtoMessage() {}
+
+func (x *GetStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStateRequest.ProtoReflect.Descriptor instead.
+func (*GetStateRequest) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetStateRequest) GetDetailed() bool {
+	if x != nil {
+		return x.Detailed
+	}
+	return false
+}
+
+func (x *GetStateRequest) GetSortBy() StateSortBy {
+	if x != nil {
+		return x.SortBy
+	}
+	return StateSortBy_STATE_SORT_BY_NAME
+}
+
+func (x *GetStateRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *GetStateRequest) GetOperState() OperStateFilter {
+	if x != nil {
+		return x.OperState
+	}
+	return OperStateFilter_OPER_STATE_ANY
+}
+
+func (x *GetStateRequest) GetPartialOnCancel() bool {
+	if x != nil {
+		return x.PartialOnCancel
+	}
+	return false
+}
+
+func (x *GetStateRequest) GetGroupByEgress() bool {
+	if x != nil {
+		return x.GroupByEgress
+	}
+	return false
+}
+
+func (x *GetStateRequest) GetIfNoneMatch() string {
+	if x != nil {
+		return x.IfNoneMatch
+	}
+	return ""
+}
+
+//GetStateResponse выдаем все туннели
+type GetStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//tunnels список туннелей
+	Tunnels []string `protobuf:"bytes,1,rep,name=tunnels,proto3" json:"tunnels,omitempty"`
+	//details сведения о туннелях (если запрошены)
+	Details []*TunnelInfo `protobuf:"bytes,2,rep,name=details,proto3" json:"details,omitempty"`
+	//problems интерфейсы сведения о которых не удалось прочитать
+	Problems []*LinkProblem `protobuf:"bytes,3,rep,name=problems,proto3" json:"problems,omitempty"`
+	//truncated перечисление прервано отменой или истечением срока, список неполон
+	Truncated bool `protobuf:"varint,4,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	//egressGroups туннели по исходящим интерфейсам (если запрошено groupByEgress)
+	EgressGroups []*EgressGroup `protobuf:"bytes,5,rep,name=egressGroups,proto3" json:"egressGroups,omitempty"`
+	//etag хэш туннелей ответа и их основных параметров; не зависит от порядка, пусто если ответ неполон
+	Etag string `protobuf:"bytes,6,opt,name=etag,proto3" json:"etag,omitempty"`
+	//notModified состояние совпадает с ifNoneMatch, туннели не выдаются
+	NotModified bool `protobuf:"varint,7,opt,name=notModified,proto3" json:"notModified,omitempty"`
+}
+
+func (x *GetStateResponse) Reset() {
+	*x = GetStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStateResponse) ProtoMessage() {}
+
+func (x *GetStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStateResponse.ProtoReflect.Descriptor instead.
+func (*GetStateResponse) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetStateResponse) GetTunnels() []string {
+	if x != nil {
+		return x.Tunnels
+	}
+	return nil
+}
+
+func (x *GetStateResponse) GetDetails() []*TunnelInfo {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *GetStateResponse) GetProblems() []*LinkProblem {
+	if x != nil {
+		return x.Problems
+	}
+	return nil
+}
+
+func (x *GetStateResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *GetStateResponse) GetEgressGroups() []*EgressGroup {
+	if x != nil {
+		return x.EgressGroups
+	}
+	return nil
+}
+
+func (x *GetStateResponse) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *GetStateResponse) GetNotModified() bool {
+	if x != nil {
+		return x.NotModified
+	}
+	return false
+}
+
+//EgressGroup туннели, трафик которых уходит через один интерфейс underlay
+type EgressGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//egressDev исходящий интерфейс: к которому привязан туннель или по маршруту до удаленной стороны; пусто - не определен
+	EgressDev string `protobuf:"bytes,1,opt,name=egressDev,proto3" json:"egressDev,omitempty"`
+	//tunnels туннели группы
+	Tunnels []string `protobuf:"bytes,2,rep,name=tunnels,proto3" json:"tunnels,omitempty"`
+}
+
+func (x *EgressGroup) Reset() {
+	*x = EgressGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EgressGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EgressGroup) ProtoMessage() {}
+
+func (x *EgressGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EgressGroup.ProtoReflect.Descriptor instead.
+func (*EgressGroup) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *EgressGroup) GetEgressDev() string {
+	if x != nil {
+		return x.EgressDev
+	}
+	return ""
+}
+
+func (x *EgressGroup) GetTunnels() []string {
+	if x != nil {
+		return x.Tunnels
+	}
+	return nil
+}
+
+//LinkProblem ошибка чтения интерфейса
+type LinkProblem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//name имя сетевого интерфейса
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	//error описание ошибки
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *LinkProblem) Reset() {
+	*x = LinkProblem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LinkProblem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkProblem) ProtoMessage() {}
+
+func (x *LinkProblem) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkProblem.ProtoReflect.Descriptor instead.
+func (*LinkProblem) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *LinkProblem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LinkProblem) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+//GetTunnelRequest запрос сведений о туннеле
+type GetTunnelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TunDestIP string `protobuf:"bytes,1,opt,name=tunDestIP,proto3" json:"tunDestIP,omitempty"`
+}
+
+func (x *GetTunnelRequest) Reset() {
+	*x = GetTunnelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTunnelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTunnelRequest) ProtoMessage() {}
+
+func (x *GetTunnelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTunnelRequest.ProtoReflect.Descriptor instead.
+func (*GetTunnelRequest) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetTunnelRequest) GetTunDestIP() string {
+	if x != nil {
+		return x.TunDestIP
+	}
+	return ""
+}
+
+//TunnelInfo сведения о туннеле
+type TunnelInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//name имя сетевого интерфейса туннеля
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	//remote адрес удаленной стороны туннеля
+	Remote string `protobuf:"bytes,2,opt,name=remote,proto3" json:"remote,omitempty"`
+	//noArp у интерфейса установлен флаг NOARP
+	NoArp bool `protobuf:"varint,3,opt,name=noArp,proto3" json:"noArp,omitempty"`
+	//multicast у интерфейса установлен флаг MULTICAST
+	Multicast bool `protobuf:"varint,4,opt,name=multicast,proto3" json:"multicast,omitempty"`
+	//underlayDev сетевой интерфейс к которому привязан туннель
+	UnderlayDev string `protobuf:"bytes,5,opt,name=underlayDev,proto3" json:"underlayDev,omitempty"`
+	//mtu MTU интерфейса
+	Mtu uint32 `protobuf:"varint,6,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	//multicastRoutes multicast маршруты через туннель
+	MulticastRoutes []string `protobuf:"bytes,7,rep,name=multicastRoutes,proto3" json:"multicastRoutes,omitempty"`
+	//weight вес туннеля для балансировщиков; 0 - не задан
+	Weight uint32 `protobuf:"varint,8,opt,name=weight,proto3" json:"weight,omitempty"`
+	//clampMss ограничение TCP MSS: pmtu или значение; пусто - не ограничен
+	ClampMss string `protobuf:"bytes,9,opt,name=clampMss,proto3" json:"clampMss,omitempty"`
+	//adminUp интерфейс поднят (флаг UP)
+	AdminUp bool `protobuf:"varint,10,opt,name=adminUp,proto3" json:"adminUp,omitempty"`
+	//fwMark метка исходящего трафика значение/маска; пусто - не ставится
+	FwMark string `protobuf:"bytes,11,opt,name=fwMark,proto3" json:"fwMark,omitempty"`
+	//operState рабочее состояние интерфейса (up, down, unknown ...)
+	OperState string `protobuf:"bytes,12,opt,name=operState,proto3" json:"operState,omitempty"`
+	//template шаблон по которому туннель создан
+	Template string `protobuf:"bytes,13,opt,name=template,proto3" json:"template,omitempty"`
+	//alias человекочитаемый псевдоним туннеля
+	Alias string `protobuf:"bytes,14,opt,name=alias,proto3" json:"alias,omitempty"`
+	//policyRoute маршрут через туннель в своей таблице и правило fwmark; нет - не задан
+	PolicyRoute *PolicyRoute `protobuf:"bytes,15,opt,name=policyRoute,proto3" json:"policyRoute,omitempty"`
+	//encryption подсказки для внешних IPsec/XDP программ; нет - не заданы
+	Encryption *EncryptionHints `protobuf:"bytes,16,opt,name=encryption,proto3" json:"encryption,omitempty"`
+	//payloadMtu MTU полезной нагрузки: mtu за вычетом внешнего заголовка типа туннеля (см. TunnelTypeInfo.overhead)
+	PayloadMtu uint32 `protobuf:"varint,17,opt,name=payloadMtu,proto3" json:"payloadMtu,omitempty"`
+	//egressRateBps ограничение исходящего трафика в битах в секунду; 0 - без ограничения
+	EgressRateBps uint64 `protobuf:"varint,18,opt,name=egressRateBps,proto3" json:"egressRateBps,omitempty"`
+	//qdisc дисциплина ограничения исходящего трафика
+	Qdisc string `protobuf:"bytes,19,opt,name=qdisc,proto3" json:"qdisc,omitempty"`
+	//cordoned туннель исключен из пакетных операций и сверки состояния
+	Cordoned bool `protobuf:"varint,20,opt,name=cordoned,proto3" json:"cordoned,omitempty"`
+}
+
+func (x *TunnelInfo) Reset() {
+	*x = TunnelInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TunnelInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TunnelInfo) ProtoMessage() {}
+
+func (x *TunnelInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TunnelInfo.ProtoReflect.Descriptor instead.
+func (*TunnelInfo) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TunnelInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TunnelInfo) GetRemote() string {
+	if x != nil {
+		return x.Remote
+	}
+	return ""
+}
+
+func (x *TunnelInfo) GetNoArp() bool {
+	if x != nil {
+		return x.NoArp
+	}
+	return false
+}
+
+func (x *TunnelInfo) GetMulticast() bool {
+	if x != nil {
+		return x.Multicast
+	}
+	return false
+}
+
+func (x *TunnelInfo) GetUnderlayDev() string {
+	if x != nil {
+		return x.UnderlayDev
+	}
+	return ""
+}
+
+func (x *TunnelInfo) GetMtu() uint32 {
+	if x != nil {
+		return x.Mtu
+	}
+	return 0
+}
+
+func (x *TunnelInfo) GetMulticastRoutes() []string {
+	if x != nil {
+		return x.MulticastRoutes
+	}
+	return nil
+}
+
+func (x *TunnelInfo) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *TunnelInfo) GetClampMss() string {
+	if x != nil {
+		return x.ClampMss
+	}
+	return ""
+}
+
+func (x *TunnelInfo) GetAdminUp() bool {
+	if x != nil {
+		return x.AdminUp
+	}
+	return false
+}
+
+func (x *TunnelInfo) GetFwMark() string {
+	if x != nil {
+		return x.FwMark
+	}
+	return ""
+}
+
+func (x *TunnelInfo) GetOperState() string {
+	if x != nil {
+		return x.OperState
+	}
+	return ""
+}
+
+func (x *TunnelInfo) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *TunnelInfo) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *TunnelInfo) GetPolicyRoute() *PolicyRoute {
+	if x != nil {
+		return x.PolicyRoute
+	}
+	return nil
+}
+
+func (x *TunnelInfo) GetEncryption() *EncryptionHints {
+	if x != nil {
+		return x.Encryption
+	}
+	return nil
+}
+
+func (x *TunnelInfo) GetPayloadMtu() uint32 {
+	if x != nil {
+		return x.PayloadMtu
+	}
+	return 0
+}
+
+func (x *TunnelInfo) GetEgressRateBps() uint64 {
+	if x != nil {
+		return x.EgressRateBps
+	}
+	return 0
+}
+
+func (x *TunnelInfo) GetQdisc() string {
+	if x != nil {
+		return x.Qdisc
+	}
+	return ""
+}
+
+func (x *TunnelInfo) GetCordoned() bool {
+	if x != nil {
+		return x.Cordoned
+	}
+	return false
+}
+
+//ResolveNameRequest вычислить имя туннеля
+type ResolveNameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TunDestIP string `protobuf:"bytes,1,opt,name=tunDestIP,proto3" json:"tunDestIP,omitempty"`
+}
+
+func (x *ResolveNameRequest) Reset() {
+	*x = ResolveNameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveNameRequest) ProtoMessage() {}
+
+func (x *ResolveNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveNameRequest.ProtoReflect.Descriptor instead.
+func (*ResolveNameRequest) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ResolveNameRequest) GetTunDestIP() string {
+	if x != nil {
+		return x.TunDestIP
+	}
+	return ""
+}
+
+//ResolveNameResponse имя туннеля
+type ResolveNameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//name имя сетевого интерфейса туннеля
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *ResolveNameResponse) Reset() {
+	*x = ResolveNameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveNameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveNameResponse) ProtoMessage() {}
+
+func (x *ResolveNameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveNameResponse.ProtoReflect.Descriptor instead.
+func (*ResolveNameResponse) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ResolveNameResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+//DiffStateRequest желаемый набор туннелей
+type DiffStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//desiredTunDestIPs адреса туннелей которые должны быть
+	DesiredTunDestIPs []string `protobuf:"bytes,1,rep,name=desiredTunDestIPs,proto3" json:"desiredTunDestIPs,omitempty"`
+	//dryRun выдать подробный план действий; неверные адреса попадают в план с предупреждением
+	DryRun bool `protobuf:"varint,2,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+}
+
+func (x *DiffStateRequest) Reset() {
+	*x = DiffStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffStateRequest) ProtoMessage() {}
+
+func (x *DiffStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffStateRequest.ProtoReflect.Descriptor instead.
+func (*DiffStateRequest) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DiffStateRequest) GetDesiredTunDestIPs() []string {
+	if x != nil {
+		return x.DesiredTunDestIPs
+	}
+	return nil
+}
+
+func (x *DiffStateRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+//PlanItem пункт плана
+type PlanItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//tunDestIP адрес туннеля
+	TunDestIP string `protobuf:"bytes,1,opt,name=tunDestIP,proto3" json:"tunDestIP,omitempty"`
+	//name имя сетевого интерфейса туннеля
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	//action действие
+	Action PlanAction `protobuf:"varint,3,opt,name=action,proto3,enum=crispy.tunnel.PlanAction" json:"action,omitempty"`
+	//warnings предупреждения
+	Warnings []string `protobuf:"bytes,4,rep,name=warnings,proto3" json:"warnings,omitempty"`
+}
+
+func (x *PlanItem) Reset() {
+	*x = PlanItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlanItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanItem) ProtoMessage() {}
+
+func (x *PlanItem) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanItem.ProtoReflect.Descriptor instead.
+func (*PlanItem) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PlanItem) GetTunDestIP() string {
+	if x != nil {
+		return x.TunDestIP
+	}
+	return ""
+}
+
+func (x *PlanItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlanItem) GetAction() PlanAction {
+	if x != nil {
+		return x.Action
+	}
+	return PlanAction_PLAN_ACTION_KEEP
+}
+
+func (x *PlanItem) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+//DiffStateResponse разница между желаемым и имеющимся набором туннелей
+type DiffStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//toCreate имена туннелей которые надо создать
+	ToCreate []string `protobuf:"bytes,1,rep,name=toCreate,proto3" json:"toCreate,omitempty"`
+	//toRemove имена туннелей которые надо удалить
+	ToRemove []string `protobuf:"bytes,2,rep,name=toRemove,proto3" json:"toRemove,omitempty"`
+	//inSync имена туннелей которые уже есть
+	InSync []string `protobuf:"bytes,3,rep,name=inSync,proto3" json:"inSync,omitempty"`
+	//plan план действий (если запрошен dryRun)
+	Plan []*PlanItem `protobuf:"bytes,4,rep,name=plan,proto3" json:"plan,omitempty"`
+	//cordoned имена туннелей, исключенных из сверки; их нет в toCreate, toRemove и inSync
+	Cordoned []string `protobuf:"bytes,5,rep,name=cordoned,proto3" json:"cordoned,omitempty"`
+}
+
+func (x *DiffStateResponse) Reset() {
+	*x = DiffStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffStateResponse) ProtoMessage() {}
+
+func (x *DiffStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffStateResponse.ProtoReflect.Descriptor instead.
+func (*DiffStateResponse) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DiffStateResponse) GetToCreate() []string {
+	if x != nil {
+		return x.ToCreate
+	}
+	return nil
+}
+
+func (x *DiffStateResponse) GetToRemove() []string {
+	if x != nil {
+		return x.ToRemove
+	}
+	return nil
+}
+
+func (x *DiffStateResponse) GetInSync() []string {
+	if x != nil {
+		return x.InSync
+	}
+	return nil
+}
+
+func (x *DiffStateResponse) GetPlan() []*PlanItem {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+func (x *DiffStateResponse) GetCordoned() []string {
+	if x != nil {
+		return x.Cordoned
+	}
+	return nil
+}
+
+//ValidateTunnelsRequest проверить адреса туннелей
+type ValidateTunnelsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//tunDestIPs адреса туннелей
+	TunDestIPs []string `protobuf:"bytes,1,rep,name=tunDestIPs,proto3" json:"tunDestIPs,omitempty"`
+}
+
+func (x *ValidateTunnelsRequest) Reset() {
+	*x = ValidateTunnelsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateTunnelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateTunnelsRequest) ProtoMessage() {}
+
+func (x *ValidateTunnelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateTunnelsRequest.ProtoReflect.Descriptor instead.
+func (*ValidateTunnelsRequest) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ValidateTunnelsRequest) GetTunDestIPs() []string {
+	if x != nil {
+		return x.TunDestIPs
+	}
+	return nil
+}
+
+//TunnelValidity результат проверки адреса туннеля
+type TunnelValidity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//tunDestIP адрес туннеля
+	TunDestIP string `protobuf:"bytes,1,opt,name=tunDestIP,proto3" json:"tunDestIP,omitempty"`
+	//valid адрес годится для туннеля
+	Valid bool `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
+	//reason почему адрес не годится
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *TunnelValidity) Reset() {
+	*x = TunnelValidity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TunnelValidity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TunnelValidity) ProtoMessage() {}
+
+func (x *TunnelValidity) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TunnelValidity.ProtoReflect.Descriptor instead.
+func (*TunnelValidity) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TunnelValidity) GetTunDestIP() string {
+	if x != nil {
+		return x.TunDestIP
+	}
+	return ""
+}
+
+func (x *TunnelValidity) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *TunnelValidity) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+//ValidateTunnelsResponse результаты проверки в порядке запроса
+type ValidateTunnelsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//results результаты проверки
+	Results []*TunnelValidity `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *ValidateTunnelsResponse) Reset() {
+	*x = ValidateTunnelsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateTunnelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateTunnelsResponse) ProtoMessage() {}
+
+func (x *ValidateTunnelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateTunnelsResponse.ProtoReflect.Descriptor instead.
+func (*ValidateTunnelsResponse) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ValidateTunnelsResponse) GetResults() []*TunnelValidity {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+//QuarantineTunnelRequest поместить туннель в карантин
+type QuarantineTunnelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TunDestIP string `protobuf:"bytes,1,opt,name=tunDestIP,proto3" json:"tunDestIP,omitempty"`
+	//idempotencyKey ключ идемпотентности; повтор с тем же ключом вернет сохраненный результат
+	IdempotencyKey string `protobuf:"bytes,2,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
+}
+
+func (x *QuarantineTunnelRequest) Reset() {
+	*x = QuarantineTunnelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuarantineTunnelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuarantineTunnelRequest) ProtoMessage() {}
+
+func (x *QuarantineTunnelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuarantineTunnelRequest.ProtoReflect.Descriptor instead.
+func (*QuarantineTunnelRequest) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *QuarantineTunnelRequest) GetTunDestIP() string {
+	if x != nil {
+		return x.TunDestIP
+	}
+	return ""
+}
+
+func (x *QuarantineTunnelRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+//RestoreTunnelRequest вернуть туннель из карантина
+type RestoreTunnelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TunDestIP string `protobuf:"bytes,1,opt,name=tunDestIP,proto3" json:"tunDestIP,omitempty"`
+	//idempotencyKey ключ идемпотентности; повтор с тем же ключом вернет сохраненный результат
+	IdempotencyKey string `protobuf:"bytes,2,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
+}
+
+func (x *RestoreTunnelRequest) Reset() {
+	*x = RestoreTunnelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreTunnelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTunnelRequest) ProtoMessage() {}
+
+func (x *RestoreTunnelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTunnelRequest.ProtoReflect.Descriptor instead.
+func (*RestoreTunnelRequest) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RestoreTunnelRequest) GetTunDestIP() string {
+	if x != nil {
+		return x.TunDestIP
+	}
+	return ""
+}
+
+func (x *RestoreTunnelRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+//ListQuarantinedResponse туннели в карантине
+type ListQuarantinedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//tunnels список туннелей
+	Tunnels []string `protobuf:"bytes,1,rep,name=tunnels,proto3" json:"tunnels,omitempty"`
+}
+
+func (x *ListQuarantinedResponse) Reset() {
+	*x = ListQuarantinedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListQuarantinedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuarantinedResponse) ProtoMessage() {}
+
+func (x *ListQuarantinedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuarantinedResponse.ProtoReflect.Descriptor instead.
+func (*ListQuarantinedResponse) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListQuarantinedResponse) GetTunnels() []string {
+	if x != nil {
+		return x.Tunnels
+	}
+	return nil
+}
+
+//PurgeTunnelsRequest удалить туннели
+type PurgeTunnelsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//keepTunDestIPs адреса туннелей которые надо оставить
+	KeepTunDestIPs []string `protobuf:"bytes,1,rep,name=keepTunDestIPs,proto3" json:"keepTunDestIPs,omitempty"`
+	//quarantinedOnly устарело: без 'all' удаляются только туннели в карантине
+	QuarantinedOnly bool `protobuf:"varint,2,opt,name=quarantinedOnly,proto3" json:"quarantinedOnly,omitempty"`
+	//all удалять все управляемые туннели, а не только туннели в карантине
+	All bool `protobuf:"varint,3,opt,name=all,proto3" json:"all,omitempty"`
+	//confirm подтверждение удаления; без него вызов отклоняется
+	Confirm bool `protobuf:"varint,4,opt,name=confirm,proto3" json:"confirm,omitempty"`
+}
+
+func (x *PurgeTunnelsRequest) Reset() {
+	*x = PurgeTunnelsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeTunnelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTunnelsRequest) ProtoMessage() {}
+
+func (x *PurgeTunnelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTunnelsRequest.ProtoReflect.Descriptor instead.
+func (*PurgeTunnelsRequest) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PurgeTunnelsRequest) GetKeepTunDestIPs() []string {
+	if x != nil {
+		return x.KeepTunDestIPs
+	}
+	return nil
+}
+
+func (x *PurgeTunnelsRequest) GetQuarantinedOnly() bool {
+	if x != nil {
+		return x.QuarantinedOnly
+	}
+	return false
+}
+
+func (x *PurgeTunnelsRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+func (x *PurgeTunnelsRequest) GetConfirm() bool {
+	if x != nil {
+		return x.Confirm
+	}
+	return false
+}
+
+//PurgeTunnelsResponse удаленные туннели
+type PurgeTunnelsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//removed имена удаленных туннелей
+	Removed []string `protobuf:"bytes,1,rep,name=removed,proto3" json:"removed,omitempty"`
+	//cordoned имена туннелей, оставленных потому что они исключены из пакетных операций
+	Cordoned []string `protobuf:"bytes,2,rep,name=cordoned,proto3" json:"cordoned,omitempty"`
+}
+
+func (x *PurgeTunnelsResponse) Reset() {
+	*x = PurgeTunnelsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeTunnelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTunnelsResponse) ProtoMessage() {}
+
+func (x *PurgeTunnelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTunnelsResponse.ProtoReflect.Descriptor instead.
+func (*PurgeTunnelsResponse) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PurgeTunnelsResponse) GetRemoved() []string {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
+func (x *PurgeTunnelsResponse) GetCordoned() []string {
+	if x != nil {
+		return x.Cordoned
+	}
+	return nil
+}
+
+//GetTunnelSysctlsRequest запрос sysctl туннеля; задается либо адрес либо имя
+type GetTunnelSysctlsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TunDestIP string `protobuf:"bytes,1,opt,name=tunDestIP,proto3" json:"tunDestIP,omitempty"`
+	//name имя сетевого интерфейса туннеля
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *GetTunnelSysctlsRequest) Reset() {
+	*x = GetTunnelSysctlsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTunnelSysctlsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTunnelSysctlsRequest) ProtoMessage() {}
+
+func (x *GetTunnelSysctlsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTunnelSysctlsRequest.ProtoReflect.Descriptor instead.
+func (*GetTunnelSysctlsRequest) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetTunnelSysctlsRequest) GetTunDestIP() string {
+	if x != nil {
+		return x.TunDestIP
+	}
+	return ""
+}
+
+func (x *GetTunnelSysctlsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+//GetTunnelSysctlsResponse значения sysctl туннеля
+type GetTunnelSysctlsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//name имя сетевого интерфейса туннеля
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	//values значения sysctl по их именам
+	Values map[string]string `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *GetTunnelSysctlsResponse) Reset() {
+	*x = GetTunnelSysctlsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTunnelSysctlsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTunnelSysctlsResponse) ProtoMessage() {}
+
+func (x *GetTunnelSysctlsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTunnelSysctlsResponse.ProtoReflect.Descriptor instead.
+func (*GetTunnelSysctlsResponse) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetTunnelSysctlsResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetTunnelSysctlsResponse) GetValues() map[string]string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+//HealthResponse состояние сервиса
+type HealthResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//healthy сервис работоспособен
+	Healthy bool `protobuf:"varint,1,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	//error почему сервис не работоспособен
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	//tunnels количество туннелей
+	Tunnels int32 `protobuf:"varint,3,opt,name=tunnels,proto3" json:"tunnels,omitempty"`
+}
+
+func (x *HealthResponse) Reset() {
+	*x = HealthResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HealthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthResponse) ProtoMessage() {}
+
+func (x *HealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthResponse.ProtoReflect.Descriptor instead.
+func (*HealthResponse) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *HealthResponse) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+func (x *HealthResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *HealthResponse) GetTunnels() int32 {
+	if x != nil {
+		return x.Tunnels
+	}
+	return 0
+}
+
+//MigrateNamesRequest переименовать туннели
+type MigrateNamesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MigrateNamesRequest) Reset() {
+	*x = MigrateNamesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MigrateNamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrateNamesRequest) ProtoMessage() {}
+
+func (x *MigrateNamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrateNamesRequest.ProtoReflect.Descriptor instead.
+func (*MigrateNamesRequest) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{28}
+}
+
+//NameMigration результат переименования туннеля
+type NameMigration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//from имя туннеля до переименования
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	//to имя туннеля по текущей схеме имен
+	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	//renamed туннель был переименован
+	Renamed bool `protobuf:"varint,3,opt,name=renamed,proto3" json:"renamed,omitempty"`
+	//error почему туннель не удалось переименовать
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	//skipped туннель не переименован, потому что он исключен из пакетных операций
+	Skipped bool `protobuf:"varint,5,opt,name=skipped,proto3" json:"skipped,omitempty"`
+}
+
+func (x *NameMigration) Reset() {
+	*x = NameMigration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NameMigration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NameMigration) ProtoMessage() {}
+
+func (x *NameMigration) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NameMigration.ProtoReflect.Descriptor instead.
+func (*NameMigration) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *NameMigration) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *NameMigration) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *NameMigration) GetRenamed() bool {
+	if x != nil {
+		return x.Renamed
+	}
+	return false
+}
+
+func (x *NameMigration) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *NameMigration) GetSkipped() bool {
+	if x != nil {
+		return x.Skipped
+	}
+	return false
+}
+
+//MigrateNamesResponse результаты переименования
+type MigrateNamesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//results результаты по каждому туннелю
+	Results []*NameMigration `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *MigrateNamesResponse) Reset() {
+	*x = MigrateNamesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MigrateNamesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrateNamesResponse) ProtoMessage() {}
+
+func (x *MigrateNamesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrateNamesResponse.ProtoReflect.Descriptor instead.
+func (*MigrateNamesResponse) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *MigrateNamesResponse) GetResults() []*NameMigration {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+//WatchTunnelEventsRequest следить за событиями туннелей
+type WatchTunnelEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *WatchTunnelEventsRequest) Reset() {
+	*x = WatchTunnelEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchTunnelEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchTunnelEventsRequest) ProtoMessage() {}
+
+func (x *WatchTunnelEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchTunnelEventsRequest.ProtoReflect.Descriptor instead.
+func (*WatchTunnelEventsRequest) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{31}
+}
+
+//TunnelEvent событие туннеля
+type TunnelEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//name имя сетевого интерфейса туннеля
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	//kind вид события
+	Kind TunnelEventKind `protobuf:"varint,2,opt,name=kind,proto3,enum=crispy.tunnel.TunnelEventKind" json:"kind,omitempty"`
+	//at когда событие произошло
+	At *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=at,proto3" json:"at,omitempty"`
+}
+
+func (x *TunnelEvent) Reset() {
+	*x = TunnelEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TunnelEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TunnelEvent) ProtoMessage() {}
+
+func (x *TunnelEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TunnelEvent.ProtoReflect.Descriptor instead.
+func (*TunnelEvent) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *TunnelEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TunnelEvent) GetKind() TunnelEventKind {
+	if x != nil {
+		return x.Kind
+	}
+	return TunnelEventKind_TUNNEL_EVENT_UNKNOWN
+}
+
+func (x *TunnelEvent) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+//ServiceInfo настройки сервиса
+type ServiceInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//minMtu наименьший допустимый MTU туннеля; 0 - не ограничен
+	MinMtu uint32 `protobuf:"varint,1,opt,name=minMtu,proto3" json:"minMtu,omitempty"`
+	//namePrefix префикс имен туннелей
+	NamePrefix string `protobuf:"bytes,2,opt,name=namePrefix,proto3" json:"namePrefix,omitempty"`
+	//detectRule регулярное выражение по которому интерфейс считается туннелем сервиса
+	DetectRule string `protobuf:"bytes,3,opt,name=detectRule,proto3" json:"detectRule,omitempty"`
+	//tunnelTypes поддерживаемые типы туннелей и применимые к ним поля AddTunnelRequest
+	TunnelTypes []*TunnelTypeInfo `protobuf:"bytes,4,rep,name=tunnelTypes,proto3" json:"tunnelTypes,omitempty"`
+	//firewallBackend чем ставятся правила туннелей (iptables или nft); пусто - ни один не найден
+	FirewallBackend string `protobuf:"bytes,5,opt,name=firewallBackend,proto3" json:"firewallBackend,omitempty"`
+	//readOnly сервис только сообщает состояние и ничего не меняет
+	ReadOnly bool `protobuf:"varint,6,opt,name=readOnly,proto3" json:"readOnly,omitempty"`
+	//unmanagedLinks найденные при старте интерфейсы туннелей, которые сервис не создавал
+	UnmanagedLinks []string `protobuf:"bytes,7,rep,name=unmanagedLinks,proto3" json:"unmanagedLinks,omitempty"`
+	//safeMode массовые операции (PurgeTunnels, MigrateNames) запрещены пока unmanagedLinks не подтверждены
+	SafeMode bool `protobuf:"varint,8,opt,name=safeMode,proto3" json:"safeMode,omitempty"`
+	//maintenance режим обслуживания узла: изменяющие вызовы запрещены до SetMaintenanceMode(enabled=false)
+	Maintenance bool `protobuf:"varint,9,opt,name=maintenance,proto3" json:"maintenance,omitempty"`
+}
+
+func (x *ServiceInfo) Reset() {
+	*x = ServiceInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceInfo) ProtoMessage() {}
+
+func (x *ServiceInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceInfo.ProtoReflect.Descriptor instead.
+func (*ServiceInfo) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ServiceInfo) GetMinMtu() uint32 {
+	if x != nil {
+		return x.MinMtu
+	}
+	return 0
+}
+
+func (x *ServiceInfo) GetNamePrefix() string {
+	if x != nil {
+		return x.NamePrefix
+	}
+	return ""
+}
+
+func (x *ServiceInfo) GetDetectRule() string {
+	if x != nil {
+		return x.DetectRule
+	}
+	return ""
+}
+
+func (x *ServiceInfo) GetTunnelTypes() []*TunnelTypeInfo {
+	if x != nil {
+		return x.TunnelTypes
+	}
+	return nil
+}
+
+func (x *ServiceInfo) GetFirewallBackend() string {
+	if x != nil {
+		return x.FirewallBackend
+	}
+	return ""
+}
+
+func (x *ServiceInfo) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+func (x *ServiceInfo) GetUnmanagedLinks() []string {
+	if x != nil {
+		return x.UnmanagedLinks
+	}
+	return nil
+}
+
+func (x *ServiceInfo) GetSafeMode() bool {
+	if x != nil {
+		return x.SafeMode
+	}
+	return false
+}
+
+func (x *ServiceInfo) GetMaintenance() bool {
+	if x != nil {
+		return x.Maintenance
+	}
+	return false
+}
+
+//TunnelTypeInfo поля AddTunnelRequest применимые к типу туннеля
+type TunnelTypeInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//type тип туннеля
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	//fields применимые поля
+	Fields []string `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	//validatedFields поля значения которых проверяются до создания туннеля
+	ValidatedFields []string `protobuf:"bytes,3,rep,name=validatedFields,proto3" json:"validatedFields,omitempty"`
+	//overhead размер внешнего заголовка в байтах который тип туннеля добавляет к пакету
+	Overhead uint32 `protobuf:"varint,4,opt,name=overhead,proto3" json:"overhead,omitempty"`
+}
+
+func (x *TunnelTypeInfo) Reset() {
+	*x = TunnelTypeInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TunnelTypeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TunnelTypeInfo) ProtoMessage() {}
+
+func (x *TunnelTypeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TunnelTypeInfo.ProtoReflect.Descriptor instead.
+func (*TunnelTypeInfo) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *TunnelTypeInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TunnelTypeInfo) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *TunnelTypeInfo) GetValidatedFields() []string {
+	if x != nil {
+		return x.ValidatedFields
+	}
+	return nil
+}
+
+func (x *TunnelTypeInfo) GetOverhead() uint32 {
+	if x != nil {
+		return x.Overhead
+	}
+	return 0
+}
+
+//SysctlCorrection исправленное значение sysctl
+type SysctlCorrection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//name имя сетевого интерфейса туннеля
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	//key имя sysctl
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	//from значение до исправления
+	From string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	//to значение после исправления
+	To string `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *SysctlCorrection) Reset() {
+	*x = SysctlCorrection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SysctlCorrection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SysctlCorrection) ProtoMessage() {}
+
+func (x *SysctlCorrection) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SysctlCorrection.ProtoReflect.Descriptor instead.
+func (*SysctlCorrection) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SysctlCorrection) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SysctlCorrection) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SysctlCorrection) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *SysctlCorrection) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+//RepairSysctlsResponse результат исправления sysctl
+type RepairSysctlsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//corrections исправленные значения
+	Corrections []*SysctlCorrection `protobuf:"bytes,1,rep,name=corrections,proto3" json:"corrections,omitempty"`
+}
+
+func (x *RepairSysctlsResponse) Reset() {
+	*x = RepairSysctlsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepairSysctlsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepairSysctlsResponse) ProtoMessage() {}
+
+func (x *RepairSysctlsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepairSysctlsResponse.ProtoReflect.Descriptor instead.
+func (*RepairSysctlsResponse) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RepairSysctlsResponse) GetCorrections() []*SysctlCorrection {
+	if x != nil {
+		return x.Corrections
+	}
+	return nil
+}
+
+//GetTunnelsRequest запрос сведений о туннелях
+type GetTunnelsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//namesOrIPs имена или адреса туннелей
+	NamesOrIPs []string `protobuf:"bytes,1,rep,name=namesOrIPs,proto3" json:"namesOrIPs,omitempty"`
+}
+
+func (x *GetTunnelsRequest) Reset() {
+	*x = GetTunnelsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTunnelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTunnelsRequest) ProtoMessage() {}
+
+func (x *GetTunnelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTunnelsRequest.ProtoReflect.Descriptor instead.
+func (*GetTunnelsRequest) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetTunnelsRequest) GetNamesOrIPs() []string {
+	if x != nil {
+		return x.NamesOrIPs
+	}
+	return nil
+}
+
+//TunnelLookup сведения о туннеле по запросу
+type TunnelLookup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//query имя или адрес туннеля из запроса
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	//found туннель найден
+	Found bool `protobuf:"varint,2,opt,name=found,proto3" json:"found,omitempty"`
+	//tunnel сведения о туннеле если он найден
+	Tunnel *TunnelInfo `protobuf:"bytes,3,opt,name=tunnel,proto3" json:"tunnel,omitempty"`
+	//error почему сведения не получены
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *TunnelLookup) Reset() {
+	*x = TunnelLookup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TunnelLookup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TunnelLookup) ProtoMessage() {}
+
+func (x *TunnelLookup) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TunnelLookup.ProtoReflect.Descriptor instead.
+func (*TunnelLookup) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *TunnelLookup) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *TunnelLookup) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+func (x *TunnelLookup) GetTunnel() *TunnelInfo {
+	if x != nil {
+		return x.Tunnel
+	}
+	return nil
+}
+
+func (x *TunnelLookup) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+//GetTunnelsResponse сведения о туннелях в порядке запроса
+type GetTunnelsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//results сведения о туннелях
+	Results []*TunnelLookup `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *GetTunnelsResponse) Reset() {
+	*x = GetTunnelsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTunnelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTunnelsResponse) ProtoMessage() {}
+
+func (x *GetTunnelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTunnelsResponse.ProtoReflect.Descriptor instead.
+func (*GetTunnelsResponse) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetTunnelsResponse) GetResults() []*TunnelLookup {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+//TestTunnelRequest проверить связность через туннель
+type TestTunnelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TunDestIP string `protobuf:"bytes,1,opt,name=tunDestIP,proto3" json:"tunDestIP,omitempty"`
+	//count сколько отправить эхо запросов; 0 - по умолчанию
+	Count uint32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	//sourceIP адрес, с которого отправлять запросы; пусто - локальный адрес туннеля, а без него - интерфейс туннеля
+	SourceIP string `protobuf:"bytes,3,opt,name=sourceIP,proto3" json:"sourceIP,omitempty"`
+}
+
+func (x *TestTunnelRequest) Reset() {
+	*x = TestTunnelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestTunnelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestTunnelRequest) ProtoMessage() {}
+
+func (x *TestTunnelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestTunnelRequest.ProtoReflect.Descriptor instead.
+func (*TestTunnelRequest) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *TestTunnelRequest) GetTunDestIP() string {
+	if x != nil {
+		return x.TunDestIP
+	}
+	return ""
+}
+
+func (x *TestTunnelRequest) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *TestTunnelRequest) GetSourceIP() string {
+	if x != nil {
+		return x.SourceIP
+	}
+	return ""
+}
+
+//TestTunnelResponse результат проверки связности
+type TestTunnelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//reachable получен хотя бы один ответ
+	Reachable bool `protobuf:"varint,1,opt,name=reachable,proto3" json:"reachable,omitempty"`
+	//transmitted отправлено эхо запросов
+	Transmitted uint32 `protobuf:"varint,2,opt,name=transmitted,proto3" json:"transmitted,omitempty"`
+	//received получено ответов
+	Received uint32 `protobuf:"varint,3,opt,name=received,proto3" json:"received,omitempty"`
+	//lossPercent доля потерь в процентах
+	LossPercent float64 `protobuf:"fixed64,4,opt,name=lossPercent,proto3" json:"lossPercent,omitempty"`
+	//rttAvgMs среднее время ответа (мс)
+	RttAvgMs float64 `protobuf:"fixed64,5,opt,name=rttAvgMs,proto3" json:"rttAvgMs,omitempty"`
+	//mode способ проверки
+	Mode string `protobuf:"bytes,6,opt,name=mode,proto3" json:"mode,omitempty"`
+	//source к чему привязаны запросы: адрес источника или имя интерфейса туннеля
+	Source string `protobuf:"bytes,7,opt,name=source,proto3" json:"source,omitempty"`
+}
+
+func (x *TestTunnelResponse) Reset() {
+	*x = TestTunnelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestTunnelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestTunnelResponse) ProtoMessage() {}
+
+func (x *TestTunnelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestTunnelResponse.ProtoReflect.Descriptor instead.
+func (*TestTunnelResponse) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *TestTunnelResponse) GetReachable() bool {
+	if x != nil {
+		return x.Reachable
+	}
+	return false
+}
+
+func (x *TestTunnelResponse) GetTransmitted() uint32 {
+	if x != nil {
+		return x.Transmitted
+	}
+	return 0
+}
+
+func (x *TestTunnelResponse) GetReceived() uint32 {
+	if x != nil {
+		return x.Received
+	}
+	return 0
+}
+
+func (x *TestTunnelResponse) GetLossPercent() float64 {
+	if x != nil {
+		return x.LossPercent
+	}
+	return 0
+}
+
+func (x *TestTunnelResponse) GetRttAvgMs() float64 {
+	if x != nil {
+		return x.RttAvgMs
+	}
+	return 0
+}
+
+func (x *TestTunnelResponse) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *TestTunnelResponse) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+//TestAllTunnelsRequest проверить все туннели
+type TestAllTunnelsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//parallelism сколько туннелей проверять одновременно (до 64); 0 - по умолчанию 8
+	Parallelism uint32 `protobuf:"varint,1,opt,name=parallelism,proto3" json:"parallelism,omitempty"`
+	//timeoutMs предельное время проверки одного туннеля (мс); 0 - по умолчанию 5 секунд
+	TimeoutMs uint32 `protobuf:"varint,2,opt,name=timeoutMs,proto3" json:"timeoutMs,omitempty"`
+	//count сколько отправить эхо запросов в каждый туннель; 0 - по умолчанию
+	Count uint32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *TestAllTunnelsRequest) Reset() {
+	*x = TestAllTunnelsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestAllTunnelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestAllTunnelsRequest) ProtoMessage() {}
+
+func (x *TestAllTunnelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestAllTunnelsRequest.ProtoReflect.Descriptor instead.
+func (*TestAllTunnelsRequest) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *TestAllTunnelsRequest) GetParallelism() uint32 {
+	if x != nil {
+		return x.Parallelism
+	}
+	return 0
+}
+
+func (x *TestAllTunnelsRequest) GetTimeoutMs() uint32 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+func (x *TestAllTunnelsRequest) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+//TunnelProbe результат проверки туннеля
+type TunnelProbe struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//name имя сетевого интерфейса туннеля
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	//remote адрес удаленной стороны туннеля
+	Remote string `protobuf:"bytes,2,opt,name=remote,proto3" json:"remote,omitempty"`
+	//reachable получен хотя бы один ответ; с окнами подавления флапов - с их учетом
+	Reachable bool `protobuf:"varint,3,opt,name=reachable,proto3" json:"reachable,omitempty"`
+	//lossPercent доля потерь в процентах
+	LossPercent float64 `protobuf:"fixed64,4,opt,name=lossPercent,proto3" json:"lossPercent,omitempty"`
+	//rttAvgMs среднее время ответа (мс)
+	RttAvgMs float64 `protobuf:"fixed64,5,opt,name=rttAvgMs,proto3" json:"rttAvgMs,omitempty"`
+	//error почему проверка не состоялась; пусто - проверка выполнена
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	//observedReachable результат именно этой проверки без учета окон подавления флапов
+	ObservedReachable bool `protobuf:"varint,7,opt,name=observedReachable,proto3" json:"observedReachable,omitempty"`
+	//source к чему привязаны запросы: локальный адрес туннеля или имя его интерфейса
+	Source string `protobuf:"bytes,8,opt,name=source,proto3" json:"source,omitempty"`
+}
+
+func (x *TunnelProbe) Reset() {
+	*x = TunnelProbe{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TunnelProbe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TunnelProbe) ProtoMessage() {}
+
+func (x *TunnelProbe) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TunnelProbe.ProtoReflect.Descriptor instead.
+func (*TunnelProbe) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *TunnelProbe) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TunnelProbe) GetRemote() string {
+	if x != nil {
+		return x.Remote
+	}
+	return ""
+}
+
+func (x *TunnelProbe) GetReachable() bool {
+	if x != nil {
+		return x.Reachable
+	}
+	return false
+}
+
+func (x *TunnelProbe) GetLossPercent() float64 {
+	if x != nil {
+		return x.LossPercent
+	}
+	return 0
+}
+
+func (x *TunnelProbe) GetRttAvgMs() float64 {
+	if x != nil {
+		return x.RttAvgMs
+	}
+	return 0
+}
+
+func (x *TunnelProbe) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *TunnelProbe) GetObservedReachable() bool {
+	if x != nil {
+		return x.ObservedReachable
+	}
+	return false
+}
+
+func (x *TunnelProbe) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+//TestAllTunnelsResponse результаты проверки всех туннелей
+type TestAllTunnelsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//results результаты по туннелям в порядке имен
+	Results []*TunnelProbe `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	//reachableCount сколько туннелей отвечают
+	ReachableCount uint32 `protobuf:"varint,2,opt,name=reachableCount,proto3" json:"reachableCount,omitempty"`
+	//unreachableCount сколько туннелей не отвечают или не проверены
+	UnreachableCount uint32 `protobuf:"varint,3,opt,name=unreachableCount,proto3" json:"unreachableCount,omitempty"`
+}
+
+func (x *TestAllTunnelsResponse) Reset() {
+	*x = TestAllTunnelsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestAllTunnelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestAllTunnelsResponse) ProtoMessage() {}
+
+func (x *TestAllTunnelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestAllTunnelsResponse.ProtoReflect.Descriptor instead.
+func (*TestAllTunnelsResponse) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *TestAllTunnelsResponse) GetResults() []*TunnelProbe {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *TestAllTunnelsResponse) GetReachableCount() uint32 {
+	if x != nil {
+		return x.ReachableCount
+	}
+	return 0
+}
+
+func (x *TestAllTunnelsResponse) GetUnreachableCount() uint32 {
+	if x != nil {
+		return x.UnreachableCount
+	}
+	return 0
+}
+
+//SetTunnelStateRequest поднять или опустить туннель
+type SetTunnelStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TunDestIP string `protobuf:"bytes,1,opt,name=tunDestIP,proto3" json:"tunDestIP,omitempty"`
+	//up true - поднять интерфейс, false - опустить
+	Up bool `protobuf:"varint,2,opt,name=up,proto3" json:"up,omitempty"`
+	//idempotencyKey ключ идемпотентности; повтор с тем же ключом вернет сохраненный результат
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
+}
+
+func (x *SetTunnelStateRequest) Reset() {
+	*x = SetTunnelStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetTunnelStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTunnelStateRequest) ProtoMessage() {}
+
+func (x *SetTunnelStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTunnelStateRequest.ProtoReflect.Descriptor instead.
+func (*SetTunnelStateRequest) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SetTunnelStateRequest) GetTunDestIP() string {
+	if x != nil {
+		return x.TunDestIP
+	}
+	return ""
+}
+
+func (x *SetTunnelStateRequest) GetUp() bool {
+	if x != nil {
+		return x.Up
+	}
+	return false
+}
+
+func (x *SetTunnelStateRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+//CapacityResponse лимит туннелей и текущее их количество
+type CapacityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//maxTunnels наибольшее количество туннелей сервиса; 0 - не ограничено
+	MaxTunnels uint32 `protobuf:"varint,1,opt,name=maxTunnels,proto3" json:"maxTunnels,omitempty"`
+	//currentManaged количество туннелей сервиса
+	CurrentManaged uint32 `protobuf:"varint,2,opt,name=currentManaged,proto3" json:"currentManaged,omitempty"`
+	//currentTotalTunLinks количество всех IPIP интерфейсов включая чужие
+	CurrentTotalTunLinks uint32 `protobuf:"varint,3,opt,name=currentTotalTunLinks,proto3" json:"currentTotalTunLinks,omitempty"`
+}
+
+func (x *CapacityResponse) Reset() {
+	*x = CapacityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CapacityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CapacityResponse) ProtoMessage() {}
+
+func (x *CapacityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CapacityResponse.ProtoReflect.Descriptor instead.
+func (*CapacityResponse) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CapacityResponse) GetMaxTunnels() uint32 {
+	if x != nil {
+		return x.MaxTunnels
+	}
+	return 0
+}
+
+func (x *CapacityResponse) GetCurrentManaged() uint32 {
+	if x != nil {
+		return x.CurrentManaged
+	}
+	return 0
+}
+
+func (x *CapacityResponse) GetCurrentTotalTunLinks() uint32 {
+	if x != nil {
+		return x.CurrentTotalTunLinks
+	}
+	return 0
+}
+
+//SwapRemoteRequest перенести туннель на новый адрес удаленной стороны
+type SwapRemoteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//currentIP текущий адрес удаленной стороны
+	CurrentIP string `protobuf:"bytes,1,opt,name=currentIP,proto3" json:"currentIP,omitempty"`
+	//newIP новый адрес удаленной стороны
+	NewIP string `protobuf:"bytes,2,opt,name=newIP,proto3" json:"newIP,omitempty"`
+	//idempotencyKey ключ идемпотентности; повтор с тем же ключом вернет сохраненный результат
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
+}
+
+func (x *SwapRemoteRequest) Reset() {
+	*x = SwapRemoteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapRemoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapRemoteRequest) ProtoMessage() {}
+
+func (x *SwapRemoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapRemoteRequest.ProtoReflect.Descriptor instead.
+func (*SwapRemoteRequest) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SwapRemoteRequest) GetCurrentIP() string {
+	if x != nil {
+		return x.CurrentIP
+	}
+	return ""
+}
+
+func (x *SwapRemoteRequest) GetNewIP() string {
+	if x != nil {
+		return x.NewIP
+	}
+	return ""
+}
+
+func (x *SwapRemoteRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+//SwapRemoteResponse результат переноса туннеля
+type SwapRemoteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//oldName имя удаленного туннеля
+	OldName string `protobuf:"bytes,1,opt,name=oldName,proto3" json:"oldName,omitempty"`
+	//newName имя нового туннеля
+	NewName string `protobuf:"bytes,2,opt,name=newName,proto3" json:"newName,omitempty"`
+	//tunnel параметры нового туннеля
+	Tunnel *TunnelInfo `protobuf:"bytes,3,opt,name=tunnel,proto3" json:"tunnel,omitempty"`
+	//migratedRoutes перенесенные маршруты
+	MigratedRoutes []string `protobuf:"bytes,4,rep,name=migratedRoutes,proto3" json:"migratedRoutes,omitempty"`
+	//migratedRules перенесенные правила маршрутизации
+	MigratedRules []string `protobuf:"bytes,5,rep,name=migratedRules,proto3" json:"migratedRules,omitempty"`
+}
+
+func (x *SwapRemoteResponse) Reset() {
+	*x = SwapRemoteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapRemoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapRemoteResponse) ProtoMessage() {}
+
+func (x *SwapRemoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapRemoteResponse.ProtoReflect.Descriptor instead.
+func (*SwapRemoteResponse) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SwapRemoteResponse) GetOldName() string {
+	if x != nil {
+		return x.OldName
+	}
+	return ""
+}
+
+func (x *SwapRemoteResponse) GetNewName() string {
+	if x != nil {
+		return x.NewName
+	}
+	return ""
+}
+
+func (x *SwapRemoteResponse) GetTunnel() *TunnelInfo {
+	if x != nil {
+		return x.Tunnel
+	}
+	return nil
+}
+
+func (x *SwapRemoteResponse) GetMigratedRoutes() []string {
+	if x != nil {
+		return x.MigratedRoutes
+	}
+	return nil
+}
+
+func (x *SwapRemoteResponse) GetMigratedRules() []string {
+	if x != nil {
+		return x.MigratedRules
+	}
+	return nil
+}
+
+//ApplySysctlsRequest установить sysctl всех туннелей сервиса
+type ApplySysctlsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//desired sysctl интерфейса и их значения: rp_filter, arp_ignore, arp_announce, accept_local, forwarding
+	Desired map[string]string `protobuf:"bytes,1,rep,name=desired,proto3" json:"desired,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ApplySysctlsRequest) Reset() {
+	*x = ApplySysctlsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplySysctlsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplySysctlsRequest) ProtoMessage() {}
+
+func (x *ApplySysctlsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplySysctlsRequest.ProtoReflect.Descriptor instead.
+func (*ApplySysctlsRequest) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ApplySysctlsRequest) GetDesired() map[string]string {
+	if x != nil {
+		return x.Desired
+	}
+	return nil
+}
+
+//TunnelSysctlsResult результат установки sysctl туннеля
+type TunnelSysctlsResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//name имя сетевого интерфейса туннеля
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	//changes измененные значения
+	Changes []*SysctlCorrection `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`
+	//error ошибка; пусто - успешно
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *TunnelSysctlsResult) Reset() {
+	*x = TunnelSysctlsResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TunnelSysctlsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TunnelSysctlsResult) ProtoMessage() {}
+
+func (x *TunnelSysctlsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TunnelSysctlsResult.ProtoReflect.Descriptor instead.
+func (*TunnelSysctlsResult) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *TunnelSysctlsResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TunnelSysctlsResult) GetChanges() []*SysctlCorrection {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *TunnelSysctlsResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+//ApplySysctlsResponse результат установки sysctl по туннелям
+type ApplySysctlsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//results результаты по туннелям в порядке имен
+	Results []*TunnelSysctlsResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *ApplySysctlsResponse) Reset() {
+	*x = ApplySysctlsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplySysctlsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplySysctlsResponse) ProtoMessage() {}
+
+func (x *ApplySysctlsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplySysctlsResponse.ProtoReflect.Descriptor instead.
+func (*ApplySysctlsResponse) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ApplySysctlsResponse) GetResults() []*TunnelSysctlsResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+//SetTunnelAliasRequest задать псевдоним туннеля по адресу или имени
+type SetTunnelAliasRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TunDestIP string `protobuf:"bytes,1,opt,name=tunDestIP,proto3" json:"tunDestIP,omitempty"`
+	//name имя сетевого интерфейса туннеля
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	//alias псевдоним до 64 байт без управляющих символов; пусто - убрать
+	Alias string `protobuf:"bytes,3,opt,name=alias,proto3" json:"alias,omitempty"`
+	//idempotencyKey ключ идемпотентности; повтор с тем же ключом вернет сохраненный результат
+	IdempotencyKey string `protobuf:"bytes,4,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
+}
+
+func (x *SetTunnelAliasRequest) Reset() {
+	*x = SetTunnelAliasRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetTunnelAliasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTunnelAliasRequest) ProtoMessage() {}
+
+func (x *SetTunnelAliasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTunnelAliasRequest.ProtoReflect.Descriptor instead.
+func (*SetTunnelAliasRequest) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SetTunnelAliasRequest) GetTunDestIP() string {
+	if x != nil {
+		return x.TunDestIP
+	}
+	return ""
+}
+
+func (x *SetTunnelAliasRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetTunnelAliasRequest) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *SetTunnelAliasRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+//FindByRemoteRequest поиск туннелей по удаленной стороне
+type FindByRemoteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//remoteIP адрес удаленной стороны или сеть (CIDR) для поиска по части адреса
+	RemoteIP string `protobuf:"bytes,1,opt,name=remoteIP,proto3" json:"remoteIP,omitempty"`
+}
+
+func (x *FindByRemoteRequest) Reset() {
+	*x = FindByRemoteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindByRemoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindByRemoteRequest) ProtoMessage() {}
+
+func (x *FindByRemoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindByRemoteRequest.ProtoReflect.Descriptor instead.
+func (*FindByRemoteRequest) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *FindByRemoteRequest) GetRemoteIP() string {
+	if x != nil {
+		return x.RemoteIP
+	}
+	return ""
+}
+
+//FindByRemoteResponse найденные туннели
+type FindByRemoteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//tunnels туннели с подходящим адресом удаленной стороны
+	Tunnels []*TunnelInfo `protobuf:"bytes,1,rep,name=tunnels,proto3" json:"tunnels,omitempty"`
+	//problems интерфейсы, сведения о которых не удалось получить
+	Problems []*LinkProblem `protobuf:"bytes,2,rep,name=problems,proto3" json:"problems,omitempty"`
+}
+
+func (x *FindByRemoteResponse) Reset() {
+	*x = FindByRemoteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindByRemoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindByRemoteResponse) ProtoMessage() {}
+
+func (x *FindByRemoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindByRemoteResponse.ProtoReflect.Descriptor instead.
+func (*FindByRemoteResponse) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *FindByRemoteResponse) GetTunnels() []*TunnelInfo {
+	if x != nil {
+		return x.Tunnels
+	}
+	return nil
+}
+
+func (x *FindByRemoteResponse) GetProblems() []*LinkProblem {
+	if x != nil {
+		return x.Problems
+	}
+	return nil
+}
+
+//AcknowledgeUnmanagedResponse подтвержденные интерфейсы
+type AcknowledgeUnmanagedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//acknowledged интерфейсы, которые больше не блокируют массовые операции
+	Acknowledged []string `protobuf:"bytes,1,rep,name=acknowledged,proto3" json:"acknowledged,omitempty"`
+}
+
+func (x *AcknowledgeUnmanagedResponse) Reset() {
+	*x = AcknowledgeUnmanagedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcknowledgeUnmanagedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcknowledgeUnmanagedResponse) ProtoMessage() {}
+
+func (x *AcknowledgeUnmanagedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcknowledgeUnmanagedResponse.ProtoReflect.Descriptor instead.
+func (*AcknowledgeUnmanagedResponse) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *AcknowledgeUnmanagedResponse) GetAcknowledged() []string {
+	if x != nil {
+		return x.Acknowledged
+	}
+	return nil
+}
+
+//AddTunnelsRequest создать несколько туннелей
+type AddTunnelsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//tunnels туннели в порядке создания
+	Tunnels []*AddTunnelRequest `protobuf:"bytes,1,rep,name=tunnels,proto3" json:"tunnels,omitempty"`
+}
+
+func (x *AddTunnelsRequest) Reset() {
+	*x = AddTunnelsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddTunnelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTunnelsRequest) ProtoMessage() {}
+
+func (x *AddTunnelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTunnelsRequest.ProtoReflect.Descriptor instead.
+func (*AddTunnelsRequest) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *AddTunnelsRequest) GetTunnels() []*AddTunnelRequest {
+	if x != nil {
+		return x.Tunnels
+	}
+	return nil
+}
+
+//RemoveTunnelsRequest удалить несколько туннелей
+type RemoveTunnelsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//tunnels туннели в порядке удаления
+	Tunnels []*RemoveTunnelRequest `protobuf:"bytes,1,rep,name=tunnels,proto3" json:"tunnels,omitempty"`
+}
+
+func (x *RemoveTunnelsRequest) Reset() {
+	*x = RemoveTunnelsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveTunnelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTunnelsRequest) ProtoMessage() {}
+
+func (x *RemoveTunnelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTunnelsRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTunnelsRequest) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *RemoveTunnelsRequest) GetTunnels() []*RemoveTunnelRequest {
+	if x != nil {
+		return x.Tunnels
+	}
+	return nil
+}
+
+//BatchItemResult результат одного элемента пакетного запроса
+type BatchItemResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//tunDestIP адрес туннеля из запроса
+	TunDestIP string `protobuf:"bytes,1,opt,name=tunDestIP,proto3" json:"tunDestIP,omitempty"`
+	//status ok, failed или duplicate-in-request - туннель уже есть в запросе раньше и повторно не обрабатывался
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	//code gRPC код ошибки для failed
+	Code string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	//error текст ошибки для failed
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	//duplicateOf номер первого элемента с тем же туннелем для duplicate-in-request
+	DuplicateOf uint32 `protobuf:"varint,5,opt,name=duplicateOf,proto3" json:"duplicateOf,omitempty"`
+	//tunnel сведения о созданном туннеле
+	Tunnel *TunnelInfo `protobuf:"bytes,6,opt,name=tunnel,proto3" json:"tunnel,omitempty"`
+}
+
+func (x *BatchItemResult) Reset() {
+	*x = BatchItemResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchItemResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchItemResult) ProtoMessage() {}
+
+func (x *BatchItemResult) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchItemResult.ProtoReflect.Descriptor instead.
+func (*BatchItemResult) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *BatchItemResult) GetTunDestIP() string {
+	if x != nil {
+		return x.TunDestIP
+	}
+	return ""
+}
+
+func (x *BatchItemResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BatchItemResult) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *BatchItemResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BatchItemResult) GetDuplicateOf() uint32 {
+	if x != nil {
+		return x.DuplicateOf
+	}
+	return 0
+}
+
+func (x *BatchItemResult) GetTunnel() *TunnelInfo {
+	if x != nil {
+		return x.Tunnel
+	}
+	return nil
+}
+
+//BatchResponse результаты пакетного запроса в порядке элементов запроса
+type BatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*BatchItemResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *BatchResponse) Reset() {
+	*x = BatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchResponse) ProtoMessage() {}
+
+func (x *BatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchResponse.ProtoReflect.Descriptor instead.
+func (*BatchResponse) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *BatchResponse) GetResults() []*BatchItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+//GetTunnelHistoryRequest история туннеля по адресу удаленной стороны или по имени
+type GetTunnelHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TunDestIP string `protobuf:"bytes,1,opt,name=tunDestIP,proto3" json:"tunDestIP,omitempty"`
+	//name имя сетевого интерфейса туннеля
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *GetTunnelHistoryRequest) Reset() {
+	*x = GetTunnelHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTunnelHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTunnelHistoryRequest) ProtoMessage() {}
+
+func (x *GetTunnelHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTunnelHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetTunnelHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetTunnelHistoryRequest) GetTunDestIP() string {
+	if x != nil {
+		return x.TunDestIP
+	}
+	return ""
+}
+
+func (x *GetTunnelHistoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+//TunnelHistoryEvent изменение туннеля
+type TunnelHistoryEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//action что сделано (add, remove, set-up, set-down, quarantine ...)
+	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	//remote адрес удаленной стороны туннеля
+	Remote string `protobuf:"bytes,2,opt,name=remote,proto3" json:"remote,omitempty"`
+	//at когда изменение сделано
+	At *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=at,proto3" json:"at,omitempty"`
+	//actor адрес клиента сделавшего изменение; пусто - неизвестен
+	Actor string `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+}
+
+func (x *TunnelHistoryEvent) Reset() {
+	*x = TunnelHistoryEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TunnelHistoryEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TunnelHistoryEvent) ProtoMessage() {}
+
+func (x *TunnelHistoryEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TunnelHistoryEvent.ProtoReflect.Descriptor instead.
+func (*TunnelHistoryEvent) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *TunnelHistoryEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *TunnelHistoryEvent) GetRemote() string {
+	if x != nil {
+		return x.Remote
+	}
+	return ""
+}
+
+func (x *TunnelHistoryEvent) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *TunnelHistoryEvent) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+//GetTunnelHistoryResponse история туннеля
+type GetTunnelHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//name имя сетевого интерфейса туннеля
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	//events изменения от старых к новым
+	Events []*TunnelHistoryEvent `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *GetTunnelHistoryResponse) Reset() {
+	*x = GetTunnelHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTunnelHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTunnelHistoryResponse) ProtoMessage() {}
+
+func (x *GetTunnelHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTunnelHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetTunnelHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetTunnelHistoryResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetTunnelHistoryResponse) GetEvents() []*TunnelHistoryEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+//RemoveByRemoteCIDRRequest удалить туннели к сети удаленных сторон
+type RemoveByRemoteCIDRRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//remoteCIDR IPv4 сеть удаленных сторон
+	RemoteCIDR string `protobuf:"bytes,1,opt,name=remoteCIDR,proto3" json:"remoteCIDR,omitempty"`
+	//drainSeconds сколько ждать между событием drain и удалением (до 600 с); 0 - удалять сразу
+	DrainSeconds uint32 `protobuf:"varint,2,opt,name=drainSeconds,proto3" json:"drainSeconds,omitempty"`
+	//confirm подтверждение массового удаления; без него запрос отклоняется
+	Confirm bool `protobuf:"varint,3,opt,name=confirm,proto3" json:"confirm,omitempty"`
+}
+
+func (x *RemoveByRemoteCIDRRequest) Reset() {
+	*x = RemoveByRemoteCIDRRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveByRemoteCIDRRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveByRemoteCIDRRequest) ProtoMessage() {}
+
+func (x *RemoveByRemoteCIDRRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveByRemoteCIDRRequest.ProtoReflect.Descriptor instead.
+func (*RemoveByRemoteCIDRRequest) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *RemoveByRemoteCIDRRequest) GetRemoteCIDR() string {
+	if x != nil {
+		return x.RemoteCIDR
+	}
+	return ""
+}
+
+func (x *RemoveByRemoteCIDRRequest) GetDrainSeconds() uint32 {
+	if x != nil {
+		return x.DrainSeconds
+	}
+	return 0
+}
+
+func (x *RemoveByRemoteCIDRRequest) GetConfirm() bool {
+	if x != nil {
+		return x.Confirm
+	}
+	return false
+}
+
+//SetMaintenanceModeRequest войти в режим обслуживания или выйти из него
+type SetMaintenanceModeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//enabled true - войти в режим обслуживания, false - выйти
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	//bringDown при входе выключить (admin-down) все поднятые управляемые туннели
+	BringDown bool `protobuf:"varint,2,opt,name=bringDown,proto3" json:"bringDown,omitempty"`
+	//bringUp при выходе включить туннели, выключенные при входе в режим
+	BringUp bool `protobuf:"varint,3,opt,name=bringUp,proto3" json:"bringUp,omitempty"`
+}
+
+func (x *SetMaintenanceModeRequest) Reset() {
+	*x = SetMaintenanceModeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetMaintenanceModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMaintenanceModeRequest) ProtoMessage() {}
+
+func (x *SetMaintenanceModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMaintenanceModeRequest.ProtoReflect.Descriptor instead.
+func (*SetMaintenanceModeRequest) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SetMaintenanceModeRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SetMaintenanceModeRequest) GetBringDown() bool {
+	if x != nil {
+		return x.BringDown
+	}
+	return false
+}
+
+func (x *SetMaintenanceModeRequest) GetBringUp() bool {
+	if x != nil {
+		return x.BringUp
+	}
+	return false
+}
+
+//SetMaintenanceModeResponse результат смены режима обслуживания
+type SetMaintenanceModeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//enabled режим обслуживания включен
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	//changed туннели выключенные (bringDown) или включенные (bringUp) вызовом
+	Changed []string `protobuf:"bytes,2,rep,name=changed,proto3" json:"changed,omitempty"`
+}
+
+func (x *SetMaintenanceModeResponse) Reset() {
+	*x = SetMaintenanceModeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetMaintenanceModeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMaintenanceModeResponse) ProtoMessage() {}
+
+func (x *SetMaintenanceModeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMaintenanceModeResponse.ProtoReflect.Descriptor instead.
+func (*SetMaintenanceModeResponse) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SetMaintenanceModeResponse) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SetMaintenanceModeResponse) GetChanged() []string {
+	if x != nil {
+		return x.Changed
+	}
+	return nil
+}
+
+//CheckNameCollisionsRequest проверить имена для набора адресов
+type CheckNameCollisionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TunDestIPs []string `protobuf:"bytes,1,rep,name=tunDestIPs,proto3" json:"tunDestIPs,omitempty"`
+}
+
+func (x *CheckNameCollisionsRequest) Reset() {
+	*x = CheckNameCollisionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckNameCollisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckNameCollisionsRequest) ProtoMessage() {}
+
+func (x *CheckNameCollisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckNameCollisionsRequest.ProtoReflect.Descriptor instead.
+func (*CheckNameCollisionsRequest) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CheckNameCollisionsRequest) GetTunDestIPs() []string {
+	if x != nil {
+		return x.TunDestIPs
+	}
+	return nil
+}
+
+//NameCollision имя туннеля, которое получается из нескольких адресов
+type NameCollision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//name имя сетевого интерфейса туннеля
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	//tunDestIPs адреса с этим именем в порядке запроса
+	TunDestIPs []string `protobuf:"bytes,2,rep,name=tunDestIPs,proto3" json:"tunDestIPs,omitempty"`
+}
+
+func (x *NameCollision) Reset() {
+	*x = NameCollision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NameCollision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NameCollision) ProtoMessage() {}
+
+func (x *NameCollision) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NameCollision.ProtoReflect.Descriptor instead.
+func (*NameCollision) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *NameCollision) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NameCollision) GetTunDestIPs() []string {
+	if x != nil {
+		return x.TunDestIPs
+	}
+	return nil
+}
+
+//CheckNameCollisionsResponse коллизии имен; пусто - все имена различны
+type CheckNameCollisionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//collisions имена, полученные более чем из одного адреса, по порядку имен
+	Collisions []*NameCollision `protobuf:"bytes,1,rep,name=collisions,proto3" json:"collisions,omitempty"`
+}
+
+func (x *CheckNameCollisionsResponse) Reset() {
+	*x = CheckNameCollisionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckNameCollisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckNameCollisionsResponse) ProtoMessage() {}
+
+func (x *CheckNameCollisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckNameCollisionsResponse.ProtoReflect.Descriptor instead.
+func (*CheckNameCollisionsResponse) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *CheckNameCollisionsResponse) GetCollisions() []*NameCollision {
+	if x != nil {
+		return x.Collisions
+	}
+	return nil
+}
+
+//CordonTunnelRequest исключить туннель по адресу удаленной стороны или по имени
+type CordonTunnelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TunDestIP string `protobuf:"bytes,1,opt,name=tunDestIP,proto3" json:"tunDestIP,omitempty"`
+	//name имя сетевого интерфейса туннеля
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	//idempotencyKey ключ идемпотентности; повтор с тем же ключом вернет сохраненный результат
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
+}
+
+func (x *CordonTunnelRequest) Reset() {
+	*x = CordonTunnelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CordonTunnelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CordonTunnelRequest) ProtoMessage() {}
+
+func (x *CordonTunnelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CordonTunnelRequest.ProtoReflect.Descriptor instead.
+func (*CordonTunnelRequest) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *CordonTunnelRequest) GetTunDestIP() string {
+	if x != nil {
+		return x.TunDestIP
+	}
+	return ""
+}
+
+func (x *CordonTunnelRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CordonTunnelRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+//UncordonTunnelRequest вернуть туннель по адресу удаленной стороны или по имени
+type UncordonTunnelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TunDestIP string `protobuf:"bytes,1,opt,name=tunDestIP,proto3" json:"tunDestIP,omitempty"`
+	//name имя сетевого интерфейса туннеля
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	//idempotencyKey ключ идемпотентности; повтор с тем же ключом вернет сохраненный результат
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
+}
+
+func (x *UncordonTunnelRequest) Reset() {
+	*x = UncordonTunnelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UncordonTunnelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UncordonTunnelRequest) ProtoMessage() {}
+
+func (x *UncordonTunnelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UncordonTunnelRequest.ProtoReflect.Descriptor instead.
+func (*UncordonTunnelRequest) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *UncordonTunnelRequest) GetTunDestIP() string {
+	if x != nil {
+		return x.TunDestIP
+	}
+	return ""
+}
+
+func (x *UncordonTunnelRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UncordonTunnelRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+//MetricsSnapshot значения метрик на момент запроса
+type MetricsSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//at когда сняты значения
+	At *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=at,proto3" json:"at,omitempty"`
+	//tunnels число управляемых туннелей
+	Tunnels uint32 `protobuf:"varint,2,opt,name=tunnels,proto3" json:"tunnels,omitempty"`
+	//semaphoreHeld занятые слоты семафора параллельных запросов
+	SemaphoreHeld uint32 `protobuf:"varint,3,opt,name=semaphoreHeld,proto3" json:"semaphoreHeld,omitempty"`
+	//semaphoreCapacity емкость семафора
+	SemaphoreCapacity uint32 `protobuf:"varint,4,opt,name=semaphoreCapacity,proto3" json:"semaphoreCapacity,omitempty"`
+	//semaphoreWaiting запросы, ждущие слот семафора
+	SemaphoreWaiting uint32 `protobuf:"varint,5,opt,name=semaphoreWaiting,proto3" json:"semaphoreWaiting,omitempty"`
+	//samples значения подключенных к сервису метрик Прометеуса (внешние команды, вебхук, семафор, туннели);
+	//у гистограмм - только _count и _sum
+	Samples []*MetricSample `protobuf:"bytes,6,rep,name=samples,proto3" json:"samples,omitempty"`
+}
+
+func (x *MetricsSnapshot) Reset() {
+	*x = MetricsSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetricsSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricsSnapshot) ProtoMessage() {}
+
+func (x *MetricsSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricsSnapshot.ProtoReflect.Descriptor instead.
+func (*MetricsSnapshot) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *MetricsSnapshot) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *MetricsSnapshot) GetTunnels() uint32 {
+	if x != nil {
+		return x.Tunnels
+	}
+	return 0
+}
+
+func (x *MetricsSnapshot) GetSemaphoreHeld() uint32 {
+	if x != nil {
+		return x.SemaphoreHeld
+	}
+	return 0
+}
+
+func (x *MetricsSnapshot) GetSemaphoreCapacity() uint32 {
+	if x != nil {
+		return x.SemaphoreCapacity
+	}
+	return 0
+}
+
+func (x *MetricsSnapshot) GetSemaphoreWaiting() uint32 {
+	if x != nil {
+		return x.SemaphoreWaiting
+	}
+	return 0
+}
+
+func (x *MetricsSnapshot) GetSamples() []*MetricSample {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+//MetricSample значение одного ряда метрики
+type MetricSample struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//name имя метрики
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	//labels метки ряда по порядку имен
+	Labels []*MetricLabel `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty"`
+	//value значение
+	Value float64 `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *MetricSample) Reset() {
+	*x = MetricSample{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetricSample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricSample) ProtoMessage() {}
+
+func (x *MetricSample) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricSample.ProtoReflect.Descriptor instead.
+func (*MetricSample) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *MetricSample) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MetricSample) GetLabels() []*MetricLabel {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *MetricSample) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+//MetricLabel метка ряда метрики
+type MetricLabel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *MetricLabel) Reset() {
+	*x = MetricLabel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetricLabel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricLabel) ProtoMessage() {}
+
+func (x *MetricLabel) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricLabel.ProtoReflect.Descriptor instead.
+func (*MetricLabel) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *MetricLabel) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MetricLabel) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+//ApplyRequest желаемое состояние управляемых туннелей
+type ApplyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//tunnels туннели с параметрами как в AddTunnel
+	Tunnels []*AddTunnelRequest `protobuf:"bytes,1,rep,name=tunnels,proto3" json:"tunnels,omitempty"`
+	//prune удалить управляемые туннели, которых нет в tunnels
+	Prune bool `protobuf:"varint,2,opt,name=prune,proto3" json:"prune,omitempty"`
+	//confirm подтверждение prune с пустым tunnels, т.е. удаления всех управляемых туннелей
+	Confirm bool `protobuf:"varint,3,opt,name=confirm,proto3" json:"confirm,omitempty"`
+}
+
+func (x *ApplyRequest) Reset() {
+	*x = ApplyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyRequest) ProtoMessage() {}
+
+func (x *ApplyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyRequest.ProtoReflect.Descriptor instead.
+func (*ApplyRequest) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ApplyRequest) GetTunnels() []*AddTunnelRequest {
+	if x != nil {
+		return x.Tunnels
+	}
+	return nil
+}
+
+func (x *ApplyRequest) GetPrune() bool {
+	if x != nil {
+		return x.Prune
+	}
+	return false
+}
+
+func (x *ApplyRequest) GetConfirm() bool {
+	if x != nil {
+		return x.Confirm
+	}
+	return false
+}
+
+//ApplyItemResult что сделано с туннелем
+type ApplyItemResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//name имя туннеля
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	//tunDestIP адрес удаленной стороны туннеля
+	TunDestIP string `protobuf:"bytes,2,opt,name=tunDestIP,proto3" json:"tunDestIP,omitempty"`
+	//action действие: KEEP, CREATE, UPDATE, REMOVE или SKIP для туннеля на кордоне
+	Action PlanAction `protobuf:"varint,3,opt,name=action,proto3,enum=crispy.tunnel.PlanAction" json:"action,omitempty"`
+	//code gRPC код ошибки, если действие не удалось
+	Code string `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
+	//error текст ошибки или причина SKIP
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	//changed параметры, из-за которых туннель пересоздан
+	Changed []string `protobuf:"bytes,6,rep,name=changed,proto3" json:"changed,omitempty"`
+}
+
+func (x *ApplyItemResult) Reset() {
+	*x = ApplyItemResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyItemResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyItemResult) ProtoMessage() {}
+
+func (x *ApplyItemResult) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyItemResult.ProtoReflect.Descriptor instead.
+func (*ApplyItemResult) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ApplyItemResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApplyItemResult) GetTunDestIP() string {
+	if x != nil {
+		return x.TunDestIP
+	}
+	return ""
+}
+
+func (x *ApplyItemResult) GetAction() PlanAction {
+	if x != nil {
+		return x.Action
+	}
+	return PlanAction_PLAN_ACTION_KEEP
+}
+
+func (x *ApplyItemResult) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ApplyItemResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ApplyItemResult) GetChanged() []string {
+	if x != nil {
+		return x.Changed
+	}
+	return nil
+}
+
+//ApplyResponse результаты по туннелям: сначала из документа по порядку, затем удаленные по имени
+type ApplyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*ApplyItemResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *ApplyResponse) Reset() {
+	*x = ApplyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tunnel_tunnel_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyResponse) ProtoMessage() {}
+
+func (x *ApplyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tunnel_tunnel_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyResponse.ProtoReflect.Descriptor instead.
+func (*ApplyResponse) Descriptor() ([]byte, []int) {
+	return file_tunnel_tunnel_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ApplyResponse) GetResults() []*ApplyItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+var File_tunnel_tunnel_proto protoreflect.FileDescriptor
+
+var file_tunnel_tunnel_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65,
+	0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xa8, 0x05, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x44, 0x65, 0x73, 0x74,
+	0x49, 0x50, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x75, 0x6e, 0x44, 0x65, 0x73,
+	0x74, 0x49, 0x50, 0x12, 0x2d, 0x0a, 0x05, 0x6e, 0x6f, 0x41, 0x72, 0x70, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x17, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x05, 0x6e, 0x6f, 0x41,
+	0x72, 0x70, 0x12, 0x35, 0x0a, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x09,
+	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x6e, 0x64,
+	0x65, 0x72, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x76, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x76, 0x12, 0x10, 0x0a, 0x03, 0x6d,
+	0x74, 0x75, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6d, 0x74, 0x75, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x6c, 0x61, 0x6d, 0x70, 0x4d, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x63, 0x6c, 0x61, 0x6d, 0x70, 0x4d, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x73, 0x73,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x73, 0x73,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x6f,
+	0x77, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44,
+	0x6f, 0x77, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65,
+	0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x66,
+	0x77, 0x4d, 0x61, 0x72, 0x6b, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x77, 0x4d,
+	0x61, 0x72, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e,
+	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x52, 0x0b, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12,
+	0x3e, 0x0a, 0x0a, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69,
+	0x6e, 0x74, 0x73, 0x52, 0x0a, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x24, 0x0a, 0x0d, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x61, 0x74, 0x65, 0x42, 0x70, 0x73,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x61,
+	0x74, 0x65, 0x42, 0x70, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x64, 0x69, 0x73, 0x63, 0x18, 0x13,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x64, 0x69, 0x73, 0x63, 0x22, 0x47, 0x0a, 0x0f, 0x45,
+	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x70, 0x69, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x70, 0x69, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x22, 0x69, 0x0a, 0x0b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x77, 0x4d,
+	0x61, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x77, 0x4d, 0x61, 0x72,
+	0x6b, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x64, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22,
+	0x78, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x06, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x22, 0xc3, 0x01, 0x0a, 0x13, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x49, 0x50, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x75, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x49, 0x50, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x12, 0x24, 0x0a,
+	0x0d, 0x63, 0x61, 0x73, 0x63, 0x61, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x63, 0x61, 0x73, 0x63, 0x61, 0x64, 0x65, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65,
+	0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x76,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x22,
+	0x7c, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d,
+	0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x22, 0x0a,
+	0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x52, 0x75, 0x6c, 0x65,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x22, 0xb1, 0x02,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x32, 0x0a,
+	0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e,
+	0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42,
+	0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x12, 0x3c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x28, 0x0a, 0x0f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x4f, 0x6e, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61,
+	0x6c, 0x4f, 0x6e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0x24, 0x0a, 0x0d, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x42, 0x79, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x20, 0x0a, 0x0b, 0x69, 0x66, 0x4e, 0x6f, 0x6e, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x66, 0x4e, 0x6f, 0x6e, 0x65, 0x4d, 0x61, 0x74, 0x63,
+	0x68, 0x22, 0xad, 0x02, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73,
+	0x12, 0x33, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x36, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79,
+	0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x50, 0x72, 0x6f, 0x62,
+	0x6c, 0x65, 0x6d, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x3e, 0x0a, 0x0c, 0x65,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x2e, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0c, 0x65,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x65,
+	0x74, 0x61, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x74, 0x61, 0x67, 0x12,
+	0x20, 0x0a, 0x0b, 0x6e, 0x6f, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6e, 0x6f, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x22, 0x45, 0x0a, 0x0b, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x12, 0x1c, 0x0a, 0x09, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x44, 0x65, 0x76, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x44, 0x65, 0x76, 0x12, 0x18,
+	0x0a, 0x07, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x22, 0x37, 0x0a, 0x0b, 0x4c, 0x69, 0x6e, 0x6b,
+	0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0x30, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x44, 0x65, 0x73, 0x74,
+	0x49, 0x50, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x75, 0x6e, 0x44, 0x65, 0x73,
+	0x74, 0x49, 0x50, 0x22, 0xf6, 0x04, 0x0a, 0x0a, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x6e, 0x6f, 0x41, 0x72, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6e,
+	0x6f, 0x41, 0x72, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61,
+	0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x44, 0x65,
+	0x76, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x61,
+	0x79, 0x44, 0x65, 0x76, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x74, 0x75, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x03, 0x6d, 0x74, 0x75, 0x12, 0x28, 0x0a, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63,
+	0x61, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x6d,
+	0x70, 0x4d, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x61, 0x6d,
+	0x70, 0x4d, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x70, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x70, 0x12, 0x16,
+	0x0a, 0x06, 0x66, 0x77, 0x4d, 0x61, 0x72, 0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x66, 0x77, 0x4d, 0x61, 0x72, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x3c, 0x0a, 0x0b, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x72,
+	0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x0b, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x12, 0x3e, 0x0a, 0x0a, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70,
+	0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x48, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x0a, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x4d,
+	0x74, 0x75, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x4d, 0x74, 0x75, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x61,
+	0x74, 0x65, 0x42, 0x70, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x65, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x61, 0x74, 0x65, 0x42, 0x70, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x64,
+	0x69, 0x73, 0x63, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x64, 0x69, 0x73, 0x63,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x72, 0x64, 0x6f, 0x6e, 0x65, 0x64, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x63, 0x6f, 0x72, 0x64, 0x6f, 0x6e, 0x65, 0x64, 0x22, 0x32, 0x0a, 0x12,
+	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x49, 0x50, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x75, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x49, 0x50,
+	0x22, 0x29, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x58, 0x0a, 0x10, 0x44,
+	0x69, 0x66, 0x66, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2c, 0x0a, 0x11, 0x64, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x54, 0x75, 0x6e, 0x44, 0x65, 0x73,
+	0x74, 0x49, 0x50, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x64, 0x65, 0x73, 0x69,
+	0x72, 0x65, 0x64, 0x54, 0x75, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x49, 0x50, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64,
+	0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x8b, 0x01, 0x0a, 0x08, 0x50, 0x6c, 0x61, 0x6e, 0x49, 0x74,
+	0x65, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x49, 0x50, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x75, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x49, 0x50,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x73, 0x22, 0xac, 0x01, 0x0a, 0x11, 0x44, 0x69, 0x66, 0x66, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x6f, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x6f, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e, 0x53, 0x79, 0x6e, 0x63, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x69, 0x6e, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x2b, 0x0a, 0x04, 0x70, 0x6c, 0x61,
+	0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79,
+	0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x72, 0x64, 0x6f, 0x6e,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x72, 0x64, 0x6f, 0x6e,
+	0x65, 0x64, 0x22, 0x38, 0x0a, 0x16, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x54, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x74, 0x75, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x49, 0x50, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0a, 0x74, 0x75, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x49, 0x50, 0x73, 0x22, 0x5c, 0x0a, 0x0e,
+	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x75, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x49, 0x50, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x74, 0x75, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x49, 0x50, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x52, 0x0a, 0x17, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e,
+	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x69, 0x74, 0x79, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x5f,
+	0x0a, 0x17, 0x51, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x54, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x75, 0x6e,
+	0x44, 0x65, 0x73, 0x74, 0x49, 0x50, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x75,
+	0x6e, 0x44, 0x65, 0x73, 0x74, 0x49, 0x50, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70,
+	0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22,
+	0x5c, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x44, 0x65,
+	0x73, 0x74, 0x49, 0x50, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x75, 0x6e, 0x44,
+	0x65, 0x73, 0x74, 0x49, 0x50, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69,
+	0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x33, 0x0a,
+	0x17, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x74, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x73, 0x22, 0x93, 0x01, 0x0a, 0x13, 0x50, 0x75, 0x72, 0x67, 0x65, 0x54, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x6b, 0x65,
+	0x65, 0x70, 0x54, 0x75, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x49, 0x50, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0e, 0x6b, 0x65, 0x65, 0x70, 0x54, 0x75, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x49,
+	0x50, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x71, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65,
+	0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x71, 0x75, 0x61,
+	0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x61, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x22, 0x4c, 0x0a, 0x14, 0x50, 0x75, 0x72, 0x67,
+	0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f,
+	0x72, 0x64, 0x6f, 0x6e, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f,
+	0x72, 0x64, 0x6f, 0x6e, 0x65, 0x64, 0x22, 0x4b, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x54, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x53, 0x79, 0x73, 0x63, 0x74, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x49, 0x50, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x75, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x49, 0x50, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0xb6, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x53, 0x79, 0x73, 0x63, 0x74, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4b, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x79,
+	0x73, 0x63, 0x74, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5a, 0x0a, 0x0e,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18,
+	0x0a, 0x07, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x4d, 0x69, 0x67, 0x72,
+	0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x7d, 0x0a, 0x0d, 0x4e, 0x61, 0x6d, 0x65, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x74, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x22, 0x4e,
+	0x0a, 0x14, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79,
+	0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x4d, 0x69, 0x67, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x1a,
+	0x0a, 0x18, 0x57, 0x61, 0x74, 0x63, 0x68, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x81, 0x01, 0x0a, 0x0b, 0x54,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32,
+	0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x63,
+	0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x54, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x12, 0x2a, 0x0a, 0x02, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x61, 0x74, 0x22, 0xd2,
+	0x02, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16,
+	0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x4d, 0x74, 0x75, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x6d, 0x69, 0x6e, 0x4d, 0x74, 0x75, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65,
+	0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x74, 0x65,
+	0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x72,
+	0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x54, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x74, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x66, 0x69, 0x72, 0x65, 0x77,
+	0x61, 0x6c, 0x6c, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x66, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x26, 0x0a,
+	0x0e, 0x75, 0x6e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x75, 0x6e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64,
+	0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x61, 0x66, 0x65, 0x4d, 0x6f, 0x64,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x61, 0x66, 0x65, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x22, 0x82, 0x01, 0x0a, 0x0e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79,
+	0x70, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x6f, 0x76, 0x65, 0x72, 0x68, 0x65, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x6f, 0x76, 0x65, 0x72, 0x68, 0x65, 0x61, 0x64, 0x22, 0x5c, 0x0a, 0x10, 0x53, 0x79, 0x73, 0x63,
+	0x74, 0x6c, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x5a, 0x0a, 0x15, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72,
+	0x53, 0x79, 0x73, 0x63, 0x74, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x41, 0x0a, 0x0b, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x53, 0x79, 0x73, 0x63, 0x74, 0x6c, 0x43, 0x6f, 0x72, 0x72, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0x33, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x4f, 0x72, 0x49, 0x50, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x4f, 0x72, 0x49, 0x50, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x0c, 0x54, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66,
+	0x6f, 0x75, 0x6e, 0x64, 0x12, 0x31, 0x0a, 0x06, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x06, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x4b, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x6f, 0x6f, 0x6b, 0x75,
+	0x70, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x63, 0x0a, 0x11, 0x54, 0x65,
+	0x73, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x49, 0x50, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x74, 0x75, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x49, 0x50, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x50, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x50, 0x22,
+	0xda, 0x01, 0x0a, 0x12, 0x54, 0x65, 0x73, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x61, 0x63, 0x68,
+	0x61, 0x62, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x74,
+	0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x6f, 0x73, 0x73, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x6c, 0x6f, 0x73, 0x73, 0x50, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x74, 0x74, 0x41, 0x76, 0x67, 0x4d, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x72, 0x74, 0x74, 0x41, 0x76, 0x67, 0x4d, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x6d, 0x0a, 0x15,
+	0x54, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65,
+	0x6c, 0x69, 0x73, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x61,
+	0x6c, 0x6c, 0x65, 0x6c, 0x69, 0x73, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x4d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x4d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xf1, 0x01, 0x0a, 0x0b,
+	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x63, 0x68,
+	0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x61, 0x63,
+	0x68, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x6f, 0x73, 0x73, 0x50, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x6c, 0x6f, 0x73, 0x73,
+	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x74, 0x74, 0x41, 0x76,
+	0x67, 0x4d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x72, 0x74, 0x74, 0x41, 0x76,
+	0x67, 0x4d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x11, 0x6f, 0x62, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x64, 0x52, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x52, 0x65,
+	0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22,
+	0xa2, 0x01, 0x0a, 0x16, 0x54, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x72,
+	0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x54, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61,
+	0x62, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x75, 0x6e, 0x72, 0x65,
+	0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x10, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x6d, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x75, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x49, 0x50, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x74, 0x75, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x49, 0x50, 0x12, 0x0e, 0x0a, 0x02, 0x75,
+	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x75, 0x70, 0x12, 0x26, 0x0a, 0x0e, 0x69,
+	0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x4b, 0x65, 0x79, 0x22, 0x8e, 0x01, 0x0a, 0x10, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x54,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x61,
+	0x78, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64,
+	0x12, 0x32, 0x0a, 0x14, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x54, 0x75, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x75, 0x6e, 0x4c,
+	0x69, 0x6e, 0x6b, 0x73, 0x22, 0x6f, 0x0a, 0x11, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x49, 0x50, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x50, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x65, 0x77, 0x49, 0x50,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x65, 0x77, 0x49, 0x50, 0x12, 0x26, 0x0a,
+	0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0xc9, 0x01, 0x0a, 0x12, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x6f, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f,
+	0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x77, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x31, 0x0a, 0x06, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x74, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x12, 0x26, 0x0a, 0x0e, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x64, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x69, 0x67,
+	0x72, 0x61, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6d,
+	0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0d, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x64, 0x52, 0x75, 0x6c, 0x65,
+	0x73, 0x22, 0x9c, 0x01, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x53, 0x79, 0x73, 0x63, 0x74,
+	0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x07, 0x64, 0x65, 0x73,
+	0x69, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x63, 0x72, 0x69,
+	0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79,
+	0x53, 0x79, 0x73, 0x63, 0x74, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x44,
+	0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x64, 0x65, 0x73,
+	0x69, 0x72, 0x65, 0x64, 0x1a, 0x3a, 0x0a, 0x0c, 0x44, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x7a, 0x0a, 0x13, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x79, 0x73, 0x63, 0x74, 0x6c,
+	0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63,
+	0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x53, 0x79, 0x73,
+	0x63, 0x74, 0x6c, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x54, 0x0a, 0x14,
+	0x41, 0x70, 0x70, 0x6c, 0x79, 0x53, 0x79, 0x73, 0x63, 0x74, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x79, 0x73, 0x63,
+	0x74, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x75, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x49, 0x50, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x74, 0x75, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x49, 0x50, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
+	0x6c, 0x69, 0x61, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64,
+	0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x31, 0x0a, 0x13,
+	0x46, 0x69, 0x6e, 0x64, 0x42, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x50, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x50, 0x22,
+	0x83, 0x01, 0x0a, 0x14, 0x46, 0x69, 0x6e, 0x64, 0x42, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x72, 0x69, 0x73,
+	0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x36, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e,
+	0x4c, 0x69, 0x6e, 0x6b, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x62, 0x6c, 0x65, 0x6d, 0x73, 0x22, 0x42, 0x0a, 0x1c, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x55, 0x6e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x63, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x64, 0x22, 0x4e, 0x0a, 0x11, 0x41, 0x64, 0x64,
+	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39,
+	0x0a, 0x07, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e,
+	0x41, 0x64, 0x64, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x07, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x22, 0x54, 0x0a, 0x14, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x3c, 0x0a, 0x07, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x22,
+	0xc6, 0x01, 0x0a, 0x0f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x49, 0x50,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x75, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x49,
+	0x50, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x4f, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x4f, 0x66, 0x12, 0x31, 0x0a, 0x06, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x06, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x49, 0x0a, 0x0d, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x72, 0x69,
+	0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x22, 0x4b, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x75, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x49, 0x50, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x74, 0x75, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x49, 0x50, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x86, 0x01, 0x0a, 0x12, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x02, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x02, 0x61, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x69, 0x0a, 0x18, 0x47, 0x65, 0x74,
+	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x72, 0x69, 0x73,
+	0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x22, 0x79, 0x0a, 0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x79,
+	0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x49, 0x44, 0x52, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x49, 0x44, 0x52, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x49, 0x44,
+	0x52, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x22,
+	0x6d, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x72, 0x69, 0x6e, 0x67, 0x44,
+	0x6f, 0x77, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x62, 0x72, 0x69, 0x6e, 0x67,
+	0x44, 0x6f, 0x77, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x70, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x62, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x70, 0x22, 0x50,
+	0x0a, 0x1a, 0x53, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x22, 0x3c, 0x0a, 0x1a, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x6c,
+	0x6c, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x74, 0x75, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x49, 0x50, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x74, 0x75, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x49, 0x50, 0x73, 0x22, 0x43,
+	0x0a, 0x0d, 0x4e, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x75, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x49, 0x50,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x75, 0x6e, 0x44, 0x65, 0x73, 0x74,
+	0x49, 0x50, 0x73, 0x22, 0x5b, 0x0a, 0x1b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4e, 0x61, 0x6d, 0x65,
+	0x43, 0x6f, 0x6c, 0x6c, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e,
+	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x6f, 0x0a, 0x13, 0x43, 0x6f, 0x72, 0x64, 0x6f, 0x6e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x44, 0x65,
+	0x73, 0x74, 0x49, 0x50, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x75, 0x6e, 0x44,
+	0x65, 0x73, 0x74, 0x49, 0x50, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x64, 0x65,
+	0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65,
+	0x79, 0x22, 0x71, 0x0a, 0x15, 0x55, 0x6e, 0x63, 0x6f, 0x72, 0x64, 0x6f, 0x6e, 0x54, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x75,
+	0x6e, 0x44, 0x65, 0x73, 0x74, 0x49, 0x50, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
+	0x75, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x49, 0x50, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e,
+	0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x4b, 0x65, 0x79, 0x22, 0x8e, 0x02, 0x0a, 0x0f, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x2a, 0x0a, 0x02, 0x61, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x02, 0x61, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x24,
+	0x0a, 0x0d, 0x73, 0x65, 0x6d, 0x61, 0x70, 0x68, 0x6f, 0x72, 0x65, 0x48, 0x65, 0x6c, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x73, 0x65, 0x6d, 0x61, 0x70, 0x68, 0x6f, 0x72, 0x65,
+	0x48, 0x65, 0x6c, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x73, 0x65, 0x6d, 0x61, 0x70, 0x68, 0x6f, 0x72,
+	0x65, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x11, 0x73, 0x65, 0x6d, 0x61, 0x70, 0x68, 0x6f, 0x72, 0x65, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69,
+	0x74, 0x79, 0x12, 0x2a, 0x0a, 0x10, 0x73, 0x65, 0x6d, 0x61, 0x70, 0x68, 0x6f, 0x72, 0x65, 0x57,
+	0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x73, 0x65,
+	0x6d, 0x61, 0x70, 0x68, 0x6f, 0x72, 0x65, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x35,
+	0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x07, 0x73, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x73, 0x22, 0x6c, 0x0a, 0x0c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x53,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x72, 0x69, 0x73,
+	0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0x37, 0x0a, 0x0b, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x79, 0x0a, 0x0c,
+	0x41, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x07,
+	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x41, 0x64,
+	0x64, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07,
+	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x75, 0x6e, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x22, 0xba, 0x01, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x6c,
+	0x79, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x75, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x49, 0x50, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x74, 0x75, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x49, 0x50, 0x12, 0x31, 0x0a,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e,
+	0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x50, 0x6c,
+	0x61, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x22, 0x49, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e,
+	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2a,
+	0x46, 0x0a, 0x08, 0x4c, 0x69, 0x6e, 0x6b, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x15, 0x0a, 0x11, 0x4c,
+	0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x4c, 0x41, 0x47, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54,
+	0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x4c, 0x41, 0x47, 0x5f,
+	0x4f, 0x4e, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x46, 0x4c, 0x41,
+	0x47, 0x5f, 0x4f, 0x46, 0x46, 0x10, 0x02, 0x2a, 0x5b, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
+	0x53, 0x4f, 0x52, 0x54, 0x5f, 0x42, 0x59, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x00, 0x12, 0x17,
+	0x0a, 0x13, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x42, 0x59, 0x5f,
+	0x49, 0x4e, 0x44, 0x45, 0x58, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x42, 0x59, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x5f,
+	0x49, 0x50, 0x10, 0x02, 0x2a, 0x4d, 0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x0e, 0x4f, 0x50, 0x45, 0x52, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x4e, 0x59, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x4f,
+	0x50, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x50, 0x10, 0x01, 0x12, 0x13,
+	0x0a, 0x0f, 0x4f, 0x50, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x4f, 0x57,
+	0x4e, 0x10, 0x02, 0x2a, 0x80, 0x01, 0x0a, 0x0a, 0x50, 0x6c, 0x61, 0x6e, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x4b, 0x45, 0x45, 0x50, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x4c, 0x41, 0x4e,
+	0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x01,
+	0x12, 0x16, 0x0a, 0x12, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x4c, 0x41, 0x4e,
+	0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4b, 0x49, 0x50, 0x10, 0x03, 0x12, 0x16,
+	0x0a, 0x12, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x50,
+	0x44, 0x41, 0x54, 0x45, 0x10, 0x04, 0x2a, 0x89, 0x01, 0x0a, 0x0f, 0x54, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x14, 0x54, 0x55,
+	0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
+	0x57, 0x4e, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14,
+	0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x4d,
+	0x4f, 0x56, 0x45, 0x44, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x50, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x54,
+	0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x4f, 0x57, 0x4e,
+	0x10, 0x04, 0x32, 0x9f, 0x22, 0x0a, 0x0d, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x69, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x54, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x12, 0x1f, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x22, 0x0e, 0x2f, 0x76,
+	0x32, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x61, 0x64, 0x64, 0x3a, 0x01, 0x2a, 0x12,
+	0x75, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x12,
+	0x22, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16,
+	0x22, 0x11, 0x2f, 0x76, 0x32, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x6c, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x54, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x73, 0x12, 0x20, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e,
+	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x22, 0x13, 0x2f, 0x76,
+	0x32, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x61, 0x64, 0x64, 0x2d, 0x6d, 0x61, 0x6e,
+	0x79, 0x3a, 0x01, 0x2a, 0x12, 0x75, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x23, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x72, 0x69,
+	0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b,
+	0x22, 0x16, 0x2f, 0x76, 0x32, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x2d, 0x6d, 0x61, 0x6e, 0x79, 0x3a, 0x01, 0x2a, 0x12, 0x65, 0x0a, 0x08, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79,
+	0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79,
+	0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12,
+	0x12, 0x10, 0x2f, 0x76, 0x32, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x5f, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x12,
+	0x1f, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e,
+	0x47, 0x65, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x16, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x76, 0x32, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f,
+	0x67, 0x65, 0x74, 0x12, 0x71, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x73, 0x12, 0x20, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x22, 0x13,
+	0x2f, 0x76, 0x32, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x6d,
+	0x61, 0x6e, 0x79, 0x3a, 0x01, 0x2a, 0x12, 0x6d, 0x0a, 0x0a, 0x54, 0x65, 0x73, 0x74, 0x54, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x20, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e,
+	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x14, 0x22, 0x0f, 0x2f, 0x76, 0x32, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x74, 0x65,
+	0x73, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x7d, 0x0a, 0x0e, 0x54, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x6c,
+	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x24, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79,
+	0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x54,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x54, 0x65,
+	0x73, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x22, 0x13, 0x2f, 0x76,
+	0x32, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x2d, 0x61, 0x6c,
+	0x6c, 0x3a, 0x01, 0x2a, 0x12, 0x75, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x21, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e,
+	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x19, 0x12, 0x17, 0x2f, 0x76, 0x32, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x72,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x2d, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x99, 0x01, 0x0a, 0x13,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x29, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x6c,
+	0x6c, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a,
+	0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x25, 0x22, 0x20, 0x2f, 0x76, 0x32, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x2d, 0x6e, 0x61, 0x6d, 0x65, 0x2d, 0x63, 0x6f, 0x6c, 0x6c, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x74, 0x0a, 0x10, 0x51, 0x75, 0x61, 0x72, 0x61,
+	0x6e, 0x74, 0x69, 0x6e, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x26, 0x2e, 0x63, 0x72,
+	0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x51, 0x75, 0x61, 0x72,
+	0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x20, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1a, 0x22, 0x15, 0x2f, 0x76, 0x32, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f,
+	0x71, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x6b, 0x0a,
+	0x0d, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x23,
+	0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1d, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x17, 0x22, 0x12, 0x2f, 0x76, 0x32, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f,
+	0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x71, 0x0a, 0x0f, 0x4c, 0x69,
+	0x73, 0x74, 0x51, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x26, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x61, 0x72, 0x61, 0x6e,
+	0x74, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x76, 0x32, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x2f, 0x71, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x74, 0x0a,
+	0x0c, 0x50, 0x75, 0x72, 0x67, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x22, 0x2e,
+	0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x50, 0x75,
+	0x72, 0x67, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x22, 0x10,
+	0x2f, 0x76, 0x32, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x70, 0x75, 0x72, 0x67, 0x65,
+	0x3a, 0x01, 0x2a, 0x12, 0x7f, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x53, 0x79, 0x73, 0x63, 0x74, 0x6c, 0x73, 0x12, 0x26, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79,
+	0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x53, 0x79, 0x73, 0x63, 0x74, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x27, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e,
+	0x47, 0x65, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x79, 0x73, 0x63, 0x74, 0x6c, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14,
+	0x12, 0x12, 0x2f, 0x76, 0x32, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x73, 0x79, 0x73,
+	0x63, 0x74, 0x6c, 0x73, 0x12, 0x5a, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1d, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e,
+	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f,
+	0x76, 0x32, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x12, 0x7c, 0x0a, 0x0c, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x12, 0x22, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1d, 0x22, 0x18, 0x2f, 0x76, 0x32, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x6d, 0x69,
+	0x67, 0x72, 0x61, 0x74, 0x65, 0x2d, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x7b,
+	0x0a, 0x11, 0x57, 0x61, 0x74, 0x63, 0x68, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x27, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63,
+	0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x54, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19,
+	0x12, 0x17, 0x2f, 0x76, 0x32, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x77, 0x61, 0x74,
+	0x63, 0x68, 0x2d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x30, 0x01, 0x12, 0x65, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x76, 0x32, 0x2f, 0x74,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2d, 0x69, 0x6e,
+	0x66, 0x6f, 0x12, 0x73, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x79, 0x73, 0x63,
+	0x74, 0x6c, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x24, 0x2e, 0x63, 0x72,
+	0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x70, 0x61,
+	0x69, 0x72, 0x53, 0x79, 0x73, 0x63, 0x74, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x22, 0x19, 0x2f, 0x76, 0x32, 0x2f, 0x74,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x2d, 0x73, 0x79, 0x73,
+	0x63, 0x74, 0x6c, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x80, 0x01, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x25, 0x2e, 0x63, 0x72,
+	0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x18, 0x22, 0x13, 0x2f, 0x76, 0x32, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x70, 0x0a, 0x09, 0x44, 0x69,
+	0x66, 0x66, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79,
+	0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70,
+	0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1a, 0x22, 0x15, 0x2f, 0x76, 0x32, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x64,
+	0x69, 0x66, 0x66, 0x2d, 0x73, 0x74, 0x61, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x72, 0x0a, 0x0e,
+	0x53, 0x65, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x24,
+	0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x53,
+	0x65, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x22,
+	0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x22, 0x14, 0x2f, 0x76, 0x32, 0x2f, 0x74, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x2f, 0x73, 0x65, 0x74, 0x2d, 0x73, 0x74, 0x61, 0x74, 0x65, 0x3a, 0x01, 0x2a,
+	0x12, 0x63, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79,
+	0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15,
+	0x12, 0x13, 0x2f, 0x76, 0x32, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x63, 0x61, 0x70,
+	0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x74, 0x0a, 0x0a, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x12, 0x20, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b,
+	0x22, 0x16, 0x2f, 0x76, 0x32, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x73, 0x77, 0x61,
+	0x70, 0x2d, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x7c, 0x0a, 0x0c, 0x41,
+	0x70, 0x70, 0x6c, 0x79, 0x53, 0x79, 0x73, 0x63, 0x74, 0x6c, 0x73, 0x12, 0x22, 0x2e, 0x63, 0x72,
+	0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x41, 0x70, 0x70, 0x6c,
+	0x79, 0x53, 0x79, 0x73, 0x63, 0x74, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e,
+	0x41, 0x70, 0x70, 0x6c, 0x79, 0x53, 0x79, 0x73, 0x63, 0x74, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22, 0x18, 0x2f, 0x76,
+	0x32, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x2d, 0x73,
+	0x79, 0x73, 0x63, 0x74, 0x6c, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x88, 0x01, 0x0a, 0x14, 0x41, 0x63,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x55, 0x6e, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x64, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2b, 0x2e, 0x63, 0x72, 0x69,
+	0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x41, 0x63, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x55, 0x6e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x22,
+	0x20, 0x2f, 0x76, 0x32, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x61, 0x63, 0x6b, 0x6e,
+	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x2d, 0x75, 0x6e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x64, 0x3a, 0x01, 0x2a, 0x12, 0x7a, 0x0a, 0x0c, 0x46, 0x69, 0x6e, 0x64, 0x42, 0x79, 0x52, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x42, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70,
+	0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x42, 0x79, 0x52,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x76, 0x32, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x2f, 0x66, 0x69, 0x6e, 0x64, 0x2d, 0x62, 0x79, 0x2d, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x12, 0x72, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x6c, 0x69,
+	0x61, 0x73, 0x12, 0x24, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x2e, 0x53, 0x65, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x6c, 0x69, 0x61,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70,
+	0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
+	0x6e, 0x66, 0x6f, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x22, 0x14, 0x2f, 0x76, 0x32,
+	0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x73, 0x65, 0x74, 0x2d, 0x61, 0x6c, 0x69, 0x61,
+	0x73, 0x3a, 0x01, 0x2a, 0x12, 0x6b, 0x0a, 0x0c, 0x43, 0x6f, 0x72, 0x64, 0x6f, 0x6e, 0x54, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x22, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x72, 0x64, 0x6f, 0x6e, 0x54, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70,
+	0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
+	0x6e, 0x66, 0x6f, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x22, 0x11, 0x2f, 0x76, 0x32,
+	0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x64, 0x6f, 0x6e, 0x3a, 0x01,
+	0x2a, 0x12, 0x71, 0x0a, 0x0e, 0x55, 0x6e, 0x63, 0x6f, 0x72, 0x64, 0x6f, 0x6e, 0x54, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x12, 0x24, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x2e, 0x55, 0x6e, 0x63, 0x6f, 0x72, 0x64, 0x6f, 0x6e, 0x54, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x72, 0x69, 0x73,
+	0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x49, 0x6e, 0x66, 0x6f, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x22, 0x13, 0x2f, 0x76,
+	0x32, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x75, 0x6e, 0x63, 0x6f, 0x72, 0x64, 0x6f,
+	0x6e, 0x3a, 0x01, 0x2a, 0x12, 0x89, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42,
+	0x79, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x49, 0x44, 0x52, 0x12, 0x28, 0x2e, 0x63, 0x72,
+	0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x42, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x49, 0x44, 0x52, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x22, 0x20, 0x2f, 0x76, 0x32,
+	0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x2d, 0x62,
+	0x79, 0x2d, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x2d, 0x63, 0x69, 0x64, 0x72, 0x3a, 0x01, 0x2a,
+	0x12, 0x8c, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x28, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79,
+	0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x29, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1b, 0x22, 0x16, 0x2f, 0x76, 0x32, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x3a, 0x01, 0x2a, 0x12,
+	0x71, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1e, 0x2e,
+	0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0x23, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x76, 0x32, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x2f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2d, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x12, 0x7f, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x26, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e,
+	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x2e, 0x63, 0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x47,
+	0x65, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12,
+	0x12, 0x2f, 0x76, 0x32, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x68, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x12, 0x5f, 0x0a, 0x05, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x1b, 0x2e, 0x63,
+	0x72, 0x69, 0x73, 0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x41, 0x70, 0x70,
+	0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x72, 0x69, 0x73,
+	0x70, 0x79, 0x2e, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x22,
+	0x10, 0x2f, 0x76, 0x32, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2f, 0x61, 0x70, 0x70, 0x6c,
+	0x79, 0x3a, 0x01, 0x2a, 0x42, 0xb7, 0x01, 0x5a, 0x07, 0x2f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x92, 0x41, 0xaa, 0x01, 0x12, 0x80, 0x01, 0x0a, 0x11, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x20,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x20, 0x41, 0x50, 0x49, 0x22, 0x66, 0x0a, 0x0f, 0x50, 0x61,
+	0x76, 0x65, 0x6c, 0x20, 0x46, 0x69, 0x73, 0x6b, 0x6f, 0x76, 0x69, 0x63, 0x68, 0x12, 0x53, 0x68,
+	0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x62, 0x6c, 0x6f, 0x67, 0x2e, 0x62, 0x75, 0x6c, 0x6c,
+	0x67, 0x61, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x32, 0x30, 0x32, 0x30, 0x2f, 0x30, 0x37,
+	0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x2d, 0x6c, 0x69, 0x73, 0x74, 0x2d, 0x6f,
+	0x66, 0x2d, 0x73, 0x77, 0x61, 0x67, 0x67, 0x65, 0x72, 0x2d, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2d, 0x74, 0x6f, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2d, 0x66, 0x69,
+	0x6c, 0x65, 0x32, 0x03, 0x32, 0x2e, 0x30, 0x2a, 0x01, 0x01, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_tunnel_tunnel_proto_rawDescOnce sync.Once
+	file_tunnel_tunnel_proto_rawDescData = file_tunnel_tunnel_proto_rawDesc
+)
+
+func file_tunnel_tunnel_proto_rawDescGZIP() []byte {
+	file_tunnel_tunnel_proto_rawDescOnce.Do(func() {
+		file_tunnel_tunnel_proto_rawDescData = protoimpl.X.CompressGZIP(file_tunnel_tunnel_proto_rawDescData)
+	})
+	return file_tunnel_tunnel_proto_rawDescData
+}
+
+var file_tunnel_tunnel_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_tunnel_tunnel_proto_msgTypes = make([]protoimpl.MessageInfo, 79)
+var file_tunnel_tunnel_proto_goTypes = []interface{}{
+	(LinkFlag)(0),                        // 0: crispy.tunnel.LinkFlag
+	(StateSortBy)(0),                     // 1: crispy.tunnel.StateSortBy
+	(OperStateFilter)(0),                 // 2: crispy.tunnel.OperStateFilter
+	(PlanAction)(0),                      // 3: crispy.tunnel.PlanAction
+	(TunnelEventKind)(0),                 // 4: crispy.tunnel.TunnelEventKind
+	(*AddTunnelRequest)(nil),             // 5: crispy.tunnel.AddTunnelRequest
+	(*EncryptionHints)(nil),              // 6: crispy.tunnel.EncryptionHints
+	(*PolicyRoute)(nil),                  // 7: crispy.tunnel.PolicyRoute
+	(*AddTunnelResponse)(nil),            // 8: crispy.tunnel.AddTunnelResponse
+	(*RemoveTunnelRequest)(nil),          // 9: crispy.tunnel.RemoveTunnelRequest
+	(*RemoveTunnelResponse)(nil),         // 10: crispy.tunnel.RemoveTunnelResponse
+	(*GetStateRequest)(nil),              // 11: crispy.tunnel.GetStateRequest
+	(*GetStateResponse)(nil),             // 12: crispy.tunnel.GetStateResponse
+	(*EgressGroup)(nil),                  // 13: crispy.tunnel.EgressGroup
+	(*LinkProblem)(nil),                  // 14: crispy.tunnel.LinkProblem
+	(*GetTunnelRequest)(nil),             // 15: crispy.tunnel.GetTunnelRequest
+	(*TunnelInfo)(nil),                   // 16: crispy.tunnel.TunnelInfo
+	(*ResolveNameRequest)(nil),           // 17: crispy.tunnel.ResolveNameRequest
+	(*ResolveNameResponse)(nil),          // 18: crispy.tunnel.ResolveNameResponse
+	(*DiffStateRequest)(nil),             // 19: crispy.tunnel.DiffStateRequest
+	(*PlanItem)(nil),                     // 20: crispy.tunnel.PlanItem
+	(*DiffStateResponse)(nil),            // 21: crispy.tunnel.DiffStateResponse
+	(*ValidateTunnelsRequest)(nil),       // 22: crispy.tunnel.ValidateTunnelsRequest
+	(*TunnelValidity)(nil),               // 23: crispy.tunnel.TunnelValidity
+	(*ValidateTunnelsResponse)(nil),      // 24: crispy.tunnel.ValidateTunnelsResponse
+	(*QuarantineTunnelRequest)(nil),      // 25: crispy.tunnel.QuarantineTunnelRequest
+	(*RestoreTunnelRequest)(nil),         // 26: crispy.tunnel.RestoreTunnelRequest
+	(*ListQuarantinedResponse)(nil),      // 27: crispy.tunnel.ListQuarantinedResponse
+	(*PurgeTunnelsRequest)(nil),          // 28: crispy.tunnel.PurgeTunnelsRequest
+	(*PurgeTunnelsResponse)(nil),         // 29: crispy.tunnel.PurgeTunnelsResponse
+	(*GetTunnelSysctlsRequest)(nil),      // 30: crispy.tunnel.GetTunnelSysctlsRequest
+	(*GetTunnelSysctlsResponse)(nil),     // 31: crispy.tunnel.GetTunnelSysctlsResponse
+	(*HealthResponse)(nil),               // 32: crispy.tunnel.HealthResponse
+	(*MigrateNamesRequest)(nil),          // 33: crispy.tunnel.MigrateNamesRequest
+	(*NameMigration)(nil),                // 34: crispy.tunnel.NameMigration
+	(*MigrateNamesResponse)(nil),         // 35: crispy.tunnel.MigrateNamesResponse
+	(*WatchTunnelEventsRequest)(nil),     // 36: crispy.tunnel.WatchTunnelEventsRequest
+	(*TunnelEvent)(nil),                  // 37: crispy.tunnel.TunnelEvent
+	(*ServiceInfo)(nil),                  // 38: crispy.tunnel.ServiceInfo
+	(*TunnelTypeInfo)(nil),               // 39: crispy.tunnel.TunnelTypeInfo
+	(*SysctlCorrection)(nil),             // 40: crispy.tunnel.SysctlCorrection
+	(*RepairSysctlsResponse)(nil),        // 41: crispy.tunnel.RepairSysctlsResponse
+	(*GetTunnelsRequest)(nil),            // 42: crispy.tunnel.GetTunnelsRequest
+	(*TunnelLookup)(nil),                 // 43: crispy.tunnel.TunnelLookup
+	(*GetTunnelsResponse)(nil),           // 44: crispy.tunnel.GetTunnelsResponse
+	(*TestTunnelRequest)(nil),            // 45: crispy.tunnel.TestTunnelRequest
+	(*TestTunnelResponse)(nil),           // 46: crispy.tunnel.TestTunnelResponse
+	(*TestAllTunnelsRequest)(nil),        // 47: crispy.tunnel.TestAllTunnelsRequest
+	(*TunnelProbe)(nil),                  // 48: crispy.tunnel.TunnelProbe
+	(*TestAllTunnelsResponse)(nil),       // 49: crispy.tunnel.TestAllTunnelsResponse
+	(*SetTunnelStateRequest)(nil),        // 50: crispy.tunnel.SetTunnelStateRequest
+	(*CapacityResponse)(nil),             // 51: crispy.tunnel.CapacityResponse
+	(*SwapRemoteRequest)(nil),            // 52: crispy.tunnel.SwapRemoteRequest
+	(*SwapRemoteResponse)(nil),           // 53: crispy.tunnel.SwapRemoteResponse
+	(*ApplySysctlsRequest)(nil),          // 54: crispy.tunnel.ApplySysctlsRequest
+	(*TunnelSysctlsResult)(nil),          // 55: crispy.tunnel.TunnelSysctlsResult
+	(*ApplySysctlsResponse)(nil),         // 56: crispy.tunnel.ApplySysctlsResponse
+	(*SetTunnelAliasRequest)(nil),        // 57: crispy.tunnel.SetTunnelAliasRequest
+	(*FindByRemoteRequest)(nil),          // 58: crispy.tunnel.FindByRemoteRequest
+	(*FindByRemoteResponse)(nil),         // 59: crispy.tunnel.FindByRemoteResponse
+	(*AcknowledgeUnmanagedResponse)(nil), // 60: crispy.tunnel.AcknowledgeUnmanagedResponse
+	(*AddTunnelsRequest)(nil),            // 61: crispy.tunnel.AddTunnelsRequest
+	(*RemoveTunnelsRequest)(nil),         // 62: crispy.tunnel.RemoveTunnelsRequest
+	(*BatchItemResult)(nil),              // 63: crispy.tunnel.BatchItemResult
+	(*BatchResponse)(nil),                // 64: crispy.tunnel.BatchResponse
+	(*GetTunnelHistoryRequest)(nil),      // 65: crispy.tunnel.GetTunnelHistoryRequest
+	(*TunnelHistoryEvent)(nil),           // 66: crispy.tunnel.TunnelHistoryEvent
+	(*GetTunnelHistoryResponse)(nil),     // 67: crispy.tunnel.GetTunnelHistoryResponse
+	(*RemoveByRemoteCIDRRequest)(nil),    // 68: crispy.tunnel.RemoveByRemoteCIDRRequest
+	(*SetMaintenanceModeRequest)(nil),    // 69: crispy.tunnel.SetMaintenanceModeRequest
+	(*SetMaintenanceModeResponse)(nil),   // 70: crispy.tunnel.SetMaintenanceModeResponse
+	(*CheckNameCollisionsRequest)(nil),   // 71: crispy.tunnel.CheckNameCollisionsRequest
+	(*NameCollision)(nil),                // 72: crispy.tunnel.NameCollision
+	(*CheckNameCollisionsResponse)(nil),  // 73: crispy.tunnel.CheckNameCollisionsResponse
+	(*CordonTunnelRequest)(nil),          // 74: crispy.tunnel.CordonTunnelRequest
+	(*UncordonTunnelRequest)(nil),        // 75: crispy.tunnel.UncordonTunnelRequest
+	(*MetricsSnapshot)(nil),              // 76: crispy.tunnel.MetricsSnapshot
+	(*MetricSample)(nil),                 // 77: crispy.tunnel.MetricSample
+	(*MetricLabel)(nil),                  // 78: crispy.tunnel.MetricLabel
+	(*ApplyRequest)(nil),                 // 79: crispy.tunnel.ApplyRequest
+	(*ApplyItemResult)(nil),              // 80: crispy.tunnel.ApplyItemResult
+	(*ApplyResponse)(nil),                // 81: crispy.tunnel.ApplyResponse
+	nil,                                  // 82: crispy.tunnel.GetTunnelSysctlsResponse.ValuesEntry
+	nil,                                  // 83: crispy.tunnel.ApplySysctlsRequest.DesiredEntry
+	(*timestamppb.Timestamp)(nil),        // 84: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                // 85: google.protobuf.Empty
+}
+var file_tunnel_tunnel_proto_depIdxs = []int32{
+	0,  // 0: crispy.tunnel.AddTunnelRequest.noArp:type_name -> crispy.tunnel.LinkFlag
+	0,  // 1: crispy.tunnel.AddTunnelRequest.multicast:type_name -> crispy.tunnel.LinkFlag
+	7,  // 2: crispy.tunnel.AddTunnelRequest.policyRoute:type_name -> crispy.tunnel.PolicyRoute
+	6,  // 3: crispy.tunnel.AddTunnelRequest.encryption:type_name -> crispy.tunnel.EncryptionHints
+	16, // 4: crispy.tunnel.AddTunnelResponse.tunnel:type_name -> crispy.tunnel.TunnelInfo
+	1,  // 5: crispy.tunnel.GetStateRequest.sortBy:type_name -> crispy.tunnel.StateSortBy
+	2,  // 6: crispy.tunnel.GetStateRequest.operState:type_name -> crispy.tunnel.OperStateFilter
+	16, // 7: crispy.tunnel.GetStateResponse.details:type_name -> crispy.tunnel.TunnelInfo
+	14, // 8: crispy.tunnel.GetStateResponse.problems:type_name -> crispy.tunnel.LinkProblem
+	13, // 9: crispy.tunnel.GetStateResponse.egressGroups:type_name -> crispy.tunnel.EgressGroup
+	7,  // 10: crispy.tunnel.TunnelInfo.policyRoute:type_name -> crispy.tunnel.PolicyRoute
+	6,  // 11: crispy.tunnel.TunnelInfo.encryption:type_name -> crispy.tunnel.EncryptionHints
+	3,  // 12: crispy.tunnel.PlanItem.action:type_name -> crispy.tunnel.PlanAction
+	20, // 13: crispy.tunnel.DiffStateResponse.plan:type_name -> crispy.tunnel.PlanItem
+	23, // 14: crispy.tunnel.ValidateTunnelsResponse.results:type_name -> crispy.tunnel.TunnelValidity
+	82, // 15: crispy.tunnel.GetTunnelSysctlsResponse.values:type_name -> crispy.tunnel.GetTunnelSysctlsResponse.ValuesEntry
+	34, // 16: crispy.tunnel.MigrateNamesResponse.results:type_name -> crispy.tunnel.NameMigration
+	4,  // 17: crispy.tunnel.TunnelEvent.kind:type_name -> crispy.tunnel.TunnelEventKind
+	84, // 18: crispy.tunnel.TunnelEvent.at:type_name -> google.protobuf.Timestamp
+	39, // 19: crispy.tunnel.ServiceInfo.tunnelTypes:type_name -> crispy.tunnel.TunnelTypeInfo
+	40, // 20: crispy.tunnel.RepairSysctlsResponse.corrections:type_name -> crispy.tunnel.SysctlCorrection
+	16, // 21: crispy.tunnel.TunnelLookup.tunnel:type_name -> crispy.tunnel.TunnelInfo
+	43, // 22: crispy.tunnel.GetTunnelsResponse.results:type_name -> crispy.tunnel.TunnelLookup
+	48, // 23: crispy.tunnel.TestAllTunnelsResponse.results:type_name -> crispy.tunnel.TunnelProbe
+	16, // 24: crispy.tunnel.SwapRemoteResponse.tunnel:type_name -> crispy.tunnel.TunnelInfo
+	83, // 25: crispy.tunnel.ApplySysctlsRequest.desired:type_name -> crispy.tunnel.ApplySysctlsRequest.DesiredEntry
+	40, // 26: crispy.tunnel.TunnelSysctlsResult.changes:type_name -> crispy.tunnel.SysctlCorrection
+	55, // 27: crispy.tunnel.ApplySysctlsResponse.results:type_name -> crispy.tunnel.TunnelSysctlsResult
+	16, // 28: crispy.tunnel.FindByRemoteResponse.tunnels:type_name -> crispy.tunnel.TunnelInfo
+	14, // 29: crispy.tunnel.FindByRemoteResponse.problems:type_name -> crispy.tunnel.LinkProblem
+	5,  // 30: crispy.tunnel.AddTunnelsRequest.tunnels:type_name -> crispy.tunnel.AddTunnelRequest
+	9,  // 31: crispy.tunnel.RemoveTunnelsRequest.tunnels:type_name -> crispy.tunnel.RemoveTunnelRequest
+	16, // 32: crispy.tunnel.BatchItemResult.tunnel:type_name -> crispy.tunnel.TunnelInfo
+	63, // 33: crispy.tunnel.BatchResponse.results:type_name -> crispy.tunnel.BatchItemResult
+	84, // 34: crispy.tunnel.TunnelHistoryEvent.at:type_name -> google.protobuf.Timestamp
+	66, // 35: crispy.tunnel.GetTunnelHistoryResponse.events:type_name -> crispy.tunnel.TunnelHistoryEvent
+	72, // 36: crispy.tunnel.CheckNameCollisionsResponse.collisions:type_name -> crispy.tunnel.NameCollision
+	84, // 37: crispy.tunnel.MetricsSnapshot.at:type_name -> google.protobuf.Timestamp
+	77, // 38: crispy.tunnel.MetricsSnapshot.samples:type_name -> crispy.tunnel.MetricSample
+	78, // 39: crispy.tunnel.MetricSample.labels:type_name -> crispy.tunnel.MetricLabel
+	5,  // 40: crispy.tunnel.ApplyRequest.tunnels:type_name -> crispy.tunnel.AddTunnelRequest
+	3,  // 41: crispy.tunnel.ApplyItemResult.action:type_name -> crispy.tunnel.PlanAction
+	80, // 42: crispy.tunnel.ApplyResponse.results:type_name -> crispy.tunnel.ApplyItemResult
+	5,  // 43: crispy.tunnel.TunnelService.AddTunnel:input_type -> crispy.tunnel.AddTunnelRequest
+	9,  // 44: crispy.tunnel.TunnelService.RemoveTunnel:input_type -> crispy.tunnel.RemoveTunnelRequest
+	61, // 45: crispy.tunnel.TunnelService.AddTunnels:input_type -> crispy.tunnel.AddTunnelsRequest
+	62, // 46: crispy.tunnel.TunnelService.RemoveTunnels:input_type -> crispy.tunnel.RemoveTunnelsRequest
+	11, // 47: crispy.tunnel.TunnelService.GetState:input_type -> crispy.tunnel.GetStateRequest
+	15, // 48: crispy.tunnel.TunnelService.GetTunnel:input_type -> crispy.tunnel.GetTunnelRequest
+	42, // 49: crispy.tunnel.TunnelService.GetTunnels:input_type -> crispy.tunnel.GetTunnelsRequest
+	45, // 50: crispy.tunnel.TunnelService.TestTunnel:input_type -> crispy.tunnel.TestTunnelRequest
+	47, // 51: crispy.tunnel.TunnelService.TestAllTunnels:input_type -> crispy.tunnel.TestAllTunnelsRequest
+	17, // 52: crispy.tunnel.TunnelService.ResolveName:input_type -> crispy.tunnel.ResolveNameRequest
+	71, // 53: crispy.tunnel.TunnelService.CheckNameCollisions:input_type -> crispy.tunnel.CheckNameCollisionsRequest
+	25, // 54: crispy.tunnel.TunnelService.QuarantineTunnel:input_type -> crispy.tunnel.QuarantineTunnelRequest
+	26, // 55: crispy.tunnel.TunnelService.RestoreTunnel:input_type -> crispy.tunnel.RestoreTunnelRequest
+	85, // 56: crispy.tunnel.TunnelService.ListQuarantined:input_type -> google.protobuf.Empty
+	28, // 57: crispy.tunnel.TunnelService.PurgeTunnels:input_type -> crispy.tunnel.PurgeTunnelsRequest
+	30, // 58: crispy.tunnel.TunnelService.GetTunnelSysctls:input_type -> crispy.tunnel.GetTunnelSysctlsRequest
+	85, // 59: crispy.tunnel.TunnelService.Health:input_type -> google.protobuf.Empty
+	33, // 60: crispy.tunnel.TunnelService.MigrateNames:input_type -> crispy.tunnel.MigrateNamesRequest
+	36, // 61: crispy.tunnel.TunnelService.WatchTunnelEvents:input_type -> crispy.tunnel.WatchTunnelEventsRequest
+	85, // 62: crispy.tunnel.TunnelService.GetServiceInfo:input_type -> google.protobuf.Empty
+	85, // 63: crispy.tunnel.TunnelService.RepairSysctls:input_type -> google.protobuf.Empty
+	22, // 64: crispy.tunnel.TunnelService.ValidateTunnels:input_type -> crispy.tunnel.ValidateTunnelsRequest
+	19, // 65: crispy.tunnel.TunnelService.DiffState:input_type -> crispy.tunnel.DiffStateRequest
+	50, // 66: crispy.tunnel.TunnelService.SetTunnelState:input_type -> crispy.tunnel.SetTunnelStateRequest
+	85, // 67: crispy.tunnel.TunnelService.GetCapacity:input_type -> google.protobuf.Empty
+	52, // 68: crispy.tunnel.TunnelService.SwapRemote:input_type -> crispy.tunnel.SwapRemoteRequest
+	54, // 69: crispy.tunnel.TunnelService.ApplySysctls:input_type -> crispy.tunnel.ApplySysctlsRequest
+	85, // 70: crispy.tunnel.TunnelService.AcknowledgeUnmanaged:input_type -> google.protobuf.Empty
+	58, // 71: crispy.tunnel.TunnelService.FindByRemote:input_type -> crispy.tunnel.FindByRemoteRequest
+	57, // 72: crispy.tunnel.TunnelService.SetTunnelAlias:input_type -> crispy.tunnel.SetTunnelAliasRequest
+	74, // 73: crispy.tunnel.TunnelService.CordonTunnel:input_type -> crispy.tunnel.CordonTunnelRequest
+	75, // 74: crispy.tunnel.TunnelService.UncordonTunnel:input_type -> crispy.tunnel.UncordonTunnelRequest
+	68, // 75: crispy.tunnel.TunnelService.RemoveByRemoteCIDR:input_type -> crispy.tunnel.RemoveByRemoteCIDRRequest
+	69, // 76: crispy.tunnel.TunnelService.SetMaintenanceMode:input_type -> crispy.tunnel.SetMaintenanceModeRequest
+	85, // 77: crispy.tunnel.TunnelService.GetMetricsSnapshot:input_type -> google.protobuf.Empty
+	65, // 78: crispy.tunnel.TunnelService.GetTunnelHistory:input_type -> crispy.tunnel.GetTunnelHistoryRequest
+	79, // 79: crispy.tunnel.TunnelService.Apply:input_type -> crispy.tunnel.ApplyRequest
+	8,  // 80: crispy.tunnel.TunnelService.AddTunnel:output_type -> crispy.tunnel.AddTunnelResponse
+	10, // 81: crispy.tunnel.TunnelService.RemoveTunnel:output_type -> crispy.tunnel.RemoveTunnelResponse
+	64, // 82: crispy.tunnel.TunnelService.AddTunnels:output_type -> crispy.tunnel.BatchResponse
+	64, // 83: crispy.tunnel.TunnelService.RemoveTunnels:output_type -> crispy.tunnel.BatchResponse
+	12, // 84: crispy.tunnel.TunnelService.GetState:output_type -> crispy.tunnel.GetStateResponse
+	16, // 85: crispy.tunnel.TunnelService.GetTunnel:output_type -> crispy.tunnel.TunnelInfo
+	44, // 86: crispy.tunnel.TunnelService.GetTunnels:output_type -> crispy.tunnel.GetTunnelsResponse
+	46, // 87: crispy.tunnel.TunnelService.TestTunnel:output_type -> crispy.tunnel.TestTunnelResponse
+	49, // 88: crispy.tunnel.TunnelService.TestAllTunnels:output_type -> crispy.tunnel.TestAllTunnelsResponse
+	18, // 89: crispy.tunnel.TunnelService.ResolveName:output_type -> crispy.tunnel.ResolveNameResponse
+	73, // 90: crispy.tunnel.TunnelService.CheckNameCollisions:output_type -> crispy.tunnel.CheckNameCollisionsResponse
+	85, // 91: crispy.tunnel.TunnelService.QuarantineTunnel:output_type -> google.protobuf.Empty
+	85, // 92: crispy.tunnel.TunnelService.RestoreTunnel:output_type -> google.protobuf.Empty
+	27, // 93: crispy.tunnel.TunnelService.ListQuarantined:output_type -> crispy.tunnel.ListQuarantinedResponse
+	29, // 94: crispy.tunnel.TunnelService.PurgeTunnels:output_type -> crispy.tunnel.PurgeTunnelsResponse
+	31, // 95: crispy.tunnel.TunnelService.GetTunnelSysctls:output_type -> crispy.tunnel.GetTunnelSysctlsResponse
+	32, // 96: crispy.tunnel.TunnelService.Health:output_type -> crispy.tunnel.HealthResponse
+	35, // 97: crispy.tunnel.TunnelService.MigrateNames:output_type -> crispy.tunnel.MigrateNamesResponse
+	37, // 98: crispy.tunnel.TunnelService.WatchTunnelEvents:output_type -> crispy.tunnel.TunnelEvent
+	38, // 99: crispy.tunnel.TunnelService.GetServiceInfo:output_type -> crispy.tunnel.ServiceInfo
+	41, // 100: crispy.tunnel.TunnelService.RepairSysctls:output_type -> crispy.tunnel.RepairSysctlsResponse
+	24, // 101: crispy.tunnel.TunnelService.ValidateTunnels:output_type -> crispy.tunnel.ValidateTunnelsResponse
+	21, // 102: crispy.tunnel.TunnelService.DiffState:output_type -> crispy.tunnel.DiffStateResponse
+	16, // 103: crispy.tunnel.TunnelService.SetTunnelState:output_type -> crispy.tunnel.TunnelInfo
+	51, // 104: crispy.tunnel.TunnelService.GetCapacity:output_type -> crispy.tunnel.CapacityResponse
+	53, // 105: crispy.tunnel.TunnelService.SwapRemote:output_type -> crispy.tunnel.SwapRemoteResponse
+	56, // 106: crispy.tunnel.TunnelService.ApplySysctls:output_type -> crispy.tunnel.ApplySysctlsResponse
+	60, // 107: crispy.tunnel.TunnelService.AcknowledgeUnmanaged:output_type -> crispy.tunnel.AcknowledgeUnmanagedResponse
+	59, // 108: crispy.tunnel.TunnelService.FindByRemote:output_type -> crispy.tunnel.FindByRemoteResponse
+	16, // 109: crispy.tunnel.TunnelService.SetTunnelAlias:output_type -> crispy.tunnel.TunnelInfo
+	16, // 110: crispy.tunnel.TunnelService.CordonTunnel:output_type -> crispy.tunnel.TunnelInfo
+	16, // 111: crispy.tunnel.TunnelService.UncordonTunnel:output_type -> crispy.tunnel.TunnelInfo
+	64, // 112: crispy.tunnel.TunnelService.RemoveByRemoteCIDR:output_type -> crispy.tunnel.BatchResponse
+	70, // 113: crispy.tunnel.TunnelService.SetMaintenanceMode:output_type -> crispy.tunnel.SetMaintenanceModeResponse
+	76, // 114: crispy.tunnel.TunnelService.GetMetricsSnapshot:output_type -> crispy.tunnel.MetricsSnapshot
+	67, // 115: crispy.tunnel.TunnelService.GetTunnelHistory:output_type -> crispy.tunnel.GetTunnelHistoryResponse
+	81, // 116: crispy.tunnel.TunnelService.Apply:output_type -> crispy.tunnel.ApplyResponse
+	80, // [80:117] is the sub-list for method output_type
+	43, // [43:80] is the sub-list for method input_type
+	43, // [43:43] is the sub-list for extension type_name
+	43, // [43:43] is the sub-list for extension extendee
+	0,  // [0:43] is the sub-list for field type_name
+}
+
+func init() { file_tunnel_tunnel_proto_init() }
+func file_tunnel_tunnel_proto_init() {
+	if File_tunnel_tunnel_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_tunnel_tunnel_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddTunnelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EncryptionHints); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PolicyRoute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddTunnelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveTunnelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveTunnelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EgressGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LinkProblem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTunnelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TunnelInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveNameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveNameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlanItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffStateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateTunnelsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TunnelValidity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateTunnelsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuarantineTunnelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreTunnelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListQuarantinedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeTunnelsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeTunnelsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTunnelSysctlsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTunnelSysctlsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HealthResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MigrateNamesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NameMigration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MigrateNamesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchTunnelEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TunnelEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TunnelTypeInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SysctlCorrection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepairSysctlsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTunnelsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TunnelLookup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTunnelsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestTunnelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestTunnelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestAllTunnelsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TunnelProbe); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestAllTunnelsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetTunnelStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CapacityResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapRemoteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapRemoteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplySysctlsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -275,8 +7429,8 @@ func file_tunnel_tunnel_proto_init() {
 				return nil
 			}
 		}
-		file_tunnel_tunnel_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveTunnelRequest); i {
+		file_tunnel_tunnel_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TunnelSysctlsResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -287,8 +7441,308 @@ func file_tunnel_tunnel_proto_init() {
 				return nil
 			}
 		}
-		file_tunnel_tunnel_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStateResponse); i {
+		file_tunnel_tunnel_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplySysctlsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetTunnelAliasRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindByRemoteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindByRemoteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcknowledgeUnmanagedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddTunnelsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveTunnelsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchItemResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTunnelHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TunnelHistoryEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTunnelHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveByRemoteCIDRRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetMaintenanceModeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetMaintenanceModeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckNameCollisionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NameCollision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckNameCollisionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CordonTunnelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UncordonTunnelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MetricsSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MetricSample); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MetricLabel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyItemResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tunnel_tunnel_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -305,13 +7759,14 @@ func file_tunnel_tunnel_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_tunnel_tunnel_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   3,
+			NumEnums:      5,
+			NumMessages:   79,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_tunnel_tunnel_proto_goTypes,
 		DependencyIndexes: file_tunnel_tunnel_proto_depIdxs,
+		EnumInfos:         file_tunnel_tunnel_proto_enumTypes,
 		MessageInfos:      file_tunnel_tunnel_proto_msgTypes,
 	}.Build()
 	File_tunnel_tunnel_proto = out.File
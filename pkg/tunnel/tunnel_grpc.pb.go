@@ -20,11 +20,82 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type TunnelServiceClient interface {
 	//AddTunnel добавить туннель
-	AddTunnel(ctx context.Context, in *AddTunnelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AddTunnel(ctx context.Context, in *AddTunnelRequest, opts ...grpc.CallOption) (*AddTunnelResponse, error)
 	//RemoveTunnel удалить туннель
-	RemoveTunnel(ctx context.Context, in *RemoveTunnelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RemoveTunnel(ctx context.Context, in *RemoveTunnelRequest, opts ...grpc.CallOption) (*RemoveTunnelResponse, error)
+	//AddTunnels создать несколько туннелей; каждый туннель создается один раз даже если повторен в запросе
+	AddTunnels(ctx context.Context, in *AddTunnelsRequest, opts ...grpc.CallOption) (*BatchResponse, error)
+	//RemoveTunnels удалить несколько туннелей; каждый туннель удаляется один раз даже если повторен в запросе
+	RemoveTunnels(ctx context.Context, in *RemoveTunnelsRequest, opts ...grpc.CallOption) (*BatchResponse, error)
 	//GetState вернуть все туннели
-	GetState(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetStateResponse, error)
+	//большой ответ можно сжать: gRPC клиент включает gzip через grpc.UseCompressor(gzip.Name),
+	//REST клиент - заголовком 'Accept-Encoding: gzip'
+	GetState(ctx context.Context, in *GetStateRequest, opts ...grpc.CallOption) (*GetStateResponse, error)
+	//GetTunnel вернуть сведения о туннеле
+	GetTunnel(ctx context.Context, in *GetTunnelRequest, opts ...grpc.CallOption) (*TunnelInfo, error)
+	//GetTunnels вернуть сведения о нескольких туннелях
+	GetTunnels(ctx context.Context, in *GetTunnelsRequest, opts ...grpc.CallOption) (*GetTunnelsResponse, error)
+	//TestTunnel проверить связность через туннель
+	TestTunnel(ctx context.Context, in *TestTunnelRequest, opts ...grpc.CallOption) (*TestTunnelResponse, error)
+	//TestAllTunnels проверить связность через все туннели сервиса
+	TestAllTunnels(ctx context.Context, in *TestAllTunnelsRequest, opts ...grpc.CallOption) (*TestAllTunnelsResponse, error)
+	//ResolveName вычислить имя туннеля для IP ничего не создавая
+	ResolveName(ctx context.Context, in *ResolveNameRequest, opts ...grpc.CallOption) (*ResolveNameResponse, error)
+	//CheckNameCollisions найти имена туннелей, которые получаются сразу из нескольких IP; ничего не создает
+	CheckNameCollisions(ctx context.Context, in *CheckNameCollisionsRequest, opts ...grpc.CallOption) (*CheckNameCollisionsResponse, error)
+	//QuarantineTunnel выключить туннель и пометить его как карантинный вместо удаления
+	QuarantineTunnel(ctx context.Context, in *QuarantineTunnelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	//RestoreTunnel вернуть туннель из карантина
+	RestoreTunnel(ctx context.Context, in *RestoreTunnelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	//ListQuarantined вернуть все туннели в карантине
+	ListQuarantined(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListQuarantinedResponse, error)
+	//PurgeTunnels удалить туннели в карантине (или все туннели при 'all') кроме оставляемых; требует 'confirm'
+	PurgeTunnels(ctx context.Context, in *PurgeTunnelsRequest, opts ...grpc.CallOption) (*PurgeTunnelsResponse, error)
+	//GetTunnelSysctls вернуть текущие значения sysctl интерфейса туннеля
+	GetTunnelSysctls(ctx context.Context, in *GetTunnelSysctlsRequest, opts ...grpc.CallOption) (*GetTunnelSysctlsResponse, error)
+	//Health проверить работоспособность сервиса
+	Health(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*HealthResponse, error)
+	//MigrateNames переименовать туннели в соответствии с текущей схемой имен; исключенные из пакетных операций туннели пропускаются
+	MigrateNames(ctx context.Context, in *MigrateNamesRequest, opts ...grpc.CallOption) (*MigrateNamesResponse, error)
+	//WatchTunnelEvents следить за событиями туннелей
+	WatchTunnelEvents(ctx context.Context, in *WatchTunnelEventsRequest, opts ...grpc.CallOption) (TunnelService_WatchTunnelEventsClient, error)
+	//GetServiceInfo вернуть настройки сервиса
+	GetServiceInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ServiceInfo, error)
+	//RepairSysctls привести sysctl туннелей к значениям политики с учетом sysctl их шаблонов
+	RepairSysctls(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RepairSysctlsResponse, error)
+	//ValidateTunnels проверить адреса туннелей ничего не создавая; адреса самого хоста не годятся
+	ValidateTunnels(ctx context.Context, in *ValidateTunnelsRequest, opts ...grpc.CallOption) (*ValidateTunnelsResponse, error)
+	//DiffState сравнить желаемый набор туннелей с имеющимся ничего не меняя
+	DiffState(ctx context.Context, in *DiffStateRequest, opts ...grpc.CallOption) (*DiffStateResponse, error)
+	//SetTunnelState поднять или опустить интерфейс туннеля
+	SetTunnelState(ctx context.Context, in *SetTunnelStateRequest, opts ...grpc.CallOption) (*TunnelInfo, error)
+	//GetCapacity лимит туннелей и текущее их количество
+	GetCapacity(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CapacityResponse, error)
+	//SwapRemote перенести туннель на новый адрес удаленной стороны вместе с маршрутами
+	SwapRemote(ctx context.Context, in *SwapRemoteRequest, opts ...grpc.CallOption) (*SwapRemoteResponse, error)
+	//ApplySysctls установить sysctl всех туннелей сервиса; значения остаются политикой для новых туннелей и RepairSysctls
+	ApplySysctls(ctx context.Context, in *ApplySysctlsRequest, opts ...grpc.CallOption) (*ApplySysctlsResponse, error)
+	//AcknowledgeUnmanaged подтвердить найденные при старте чужие интерфейсы и выйти из safe mode
+	AcknowledgeUnmanaged(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AcknowledgeUnmanagedResponse, error)
+	//FindByRemote найти туннели по адресу или сети удаленной стороны
+	FindByRemote(ctx context.Context, in *FindByRemoteRequest, opts ...grpc.CallOption) (*FindByRemoteResponse, error)
+	//SetTunnelAlias задать или убрать человекочитаемый псевдоним туннеля
+	SetTunnelAlias(ctx context.Context, in *SetTunnelAliasRequest, opts ...grpc.CallOption) (*TunnelInfo, error)
+	//CordonTunnel исключить туннель из пакетных операций и сверки состояния, не удаляя его
+	CordonTunnel(ctx context.Context, in *CordonTunnelRequest, opts ...grpc.CallOption) (*TunnelInfo, error)
+	//UncordonTunnel вернуть туннель в пакетные операции и сверку состояния
+	UncordonTunnel(ctx context.Context, in *UncordonTunnelRequest, opts ...grpc.CallOption) (*TunnelInfo, error)
+	//RemoveByRemoteCIDR удалить все управляемые туннели, удаленная сторона которых в сети; требует confirm
+	RemoveByRemoteCIDR(ctx context.Context, in *RemoveByRemoteCIDRRequest, opts ...grpc.CallOption) (*BatchResponse, error)
+	//SetMaintenanceMode войти в режим обслуживания узла (изменения запрещены) или выйти из него
+	SetMaintenanceMode(ctx context.Context, in *SetMaintenanceModeRequest, opts ...grpc.CallOption) (*SetMaintenanceModeResponse, error)
+	//GetMetricsSnapshot текущие значения метрик сервиса; для окружений, где Прометеус не может их забрать
+	GetMetricsSnapshot(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MetricsSnapshot, error)
+	//GetTunnelHistory история изменений туннеля этим сервисом; история должна быть включена
+	GetTunnelHistory(ctx context.Context, in *GetTunnelHistoryRequest, opts ...grpc.CallOption) (*GetTunnelHistoryResponse, error)
+	//Apply привести управляемые туннели к желаемому состоянию: создать недостающие, пересоздать отличающиеся
+	//параметрами и с prune удалить не указанные; документ проверяется целиком до изменений, но применяется не атомарно
+	Apply(ctx context.Context, in *ApplyRequest, opts ...grpc.CallOption) (*ApplyResponse, error)
 }
 
 type tunnelServiceClient struct {
@@ -35,8 +106,8 @@ func NewTunnelServiceClient(cc grpc.ClientConnInterface) TunnelServiceClient {
 	return &tunnelServiceClient{cc}
 }
 
-func (c *tunnelServiceClient) AddTunnel(ctx context.Context, in *AddTunnelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
+func (c *tunnelServiceClient) AddTunnel(ctx context.Context, in *AddTunnelRequest, opts ...grpc.CallOption) (*AddTunnelResponse, error) {
+	out := new(AddTunnelResponse)
 	err := c.cc.Invoke(ctx, "/crispy.tunnel.TunnelService/AddTunnel", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -44,8 +115,8 @@ func (c *tunnelServiceClient) AddTunnel(ctx context.Context, in *AddTunnelReques
 	return out, nil
 }
 
-func (c *tunnelServiceClient) RemoveTunnel(ctx context.Context, in *RemoveTunnelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
+func (c *tunnelServiceClient) RemoveTunnel(ctx context.Context, in *RemoveTunnelRequest, opts ...grpc.CallOption) (*RemoveTunnelResponse, error) {
+	out := new(RemoveTunnelResponse)
 	err := c.cc.Invoke(ctx, "/crispy.tunnel.TunnelService/RemoveTunnel", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -53,7 +124,25 @@ func (c *tunnelServiceClient) RemoveTunnel(ctx context.Context, in *RemoveTunnel
 	return out, nil
 }
 
-func (c *tunnelServiceClient) GetState(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetStateResponse, error) {
+func (c *tunnelServiceClient) AddTunnels(ctx context.Context, in *AddTunnelsRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, "/crispy.tunnel.TunnelService/AddTunnels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tunnelServiceClient) RemoveTunnels(ctx context.Context, in *RemoveTunnelsRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, "/crispy.tunnel.TunnelService/RemoveTunnels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tunnelServiceClient) GetState(ctx context.Context, in *GetStateRequest, opts ...grpc.CallOption) (*GetStateResponse, error) {
 	out := new(GetStateResponse)
 	err := c.cc.Invoke(ctx, "/crispy.tunnel.TunnelService/GetState", in, out, opts...)
 	if err != nil {
@@ -62,16 +151,398 @@ func (c *tunnelServiceClient) GetState(ctx context.Context, in *emptypb.Empty, o
 	return out, nil
 }
 
+func (c *tunnelServiceClient) GetTunnel(ctx context.Context, in *GetTunnelRequest, opts ...grpc.CallOption) (*TunnelInfo, error) {
+	out := new(TunnelInfo)
+	err := c.cc.Invoke(ctx, "/crispy.tunnel.TunnelService/GetTunnel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tunnelServiceClient) GetTunnels(ctx context.Context, in *GetTunnelsRequest, opts ...grpc.CallOption) (*GetTunnelsResponse, error) {
+	out := new(GetTunnelsResponse)
+	err := c.cc.Invoke(ctx, "/crispy.tunnel.TunnelService/GetTunnels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tunnelServiceClient) TestTunnel(ctx context.Context, in *TestTunnelRequest, opts ...grpc.CallOption) (*TestTunnelResponse, error) {
+	out := new(TestTunnelResponse)
+	err := c.cc.Invoke(ctx, "/crispy.tunnel.TunnelService/TestTunnel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tunnelServiceClient) TestAllTunnels(ctx context.Context, in *TestAllTunnelsRequest, opts ...grpc.CallOption) (*TestAllTunnelsResponse, error) {
+	out := new(TestAllTunnelsResponse)
+	err := c.cc.Invoke(ctx, "/crispy.tunnel.TunnelService/TestAllTunnels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tunnelServiceClient) ResolveName(ctx context.Context, in *ResolveNameRequest, opts ...grpc.CallOption) (*ResolveNameResponse, error) {
+	out := new(ResolveNameResponse)
+	err := c.cc.Invoke(ctx, "/crispy.tunnel.TunnelService/ResolveName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tunnelServiceClient) CheckNameCollisions(ctx context.Context, in *CheckNameCollisionsRequest, opts ...grpc.CallOption) (*CheckNameCollisionsResponse, error) {
+	out := new(CheckNameCollisionsResponse)
+	err := c.cc.Invoke(ctx, "/crispy.tunnel.TunnelService/CheckNameCollisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tunnelServiceClient) QuarantineTunnel(ctx context.Context, in *QuarantineTunnelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/crispy.tunnel.TunnelService/QuarantineTunnel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tunnelServiceClient) RestoreTunnel(ctx context.Context, in *RestoreTunnelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/crispy.tunnel.TunnelService/RestoreTunnel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tunnelServiceClient) ListQuarantined(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListQuarantinedResponse, error) {
+	out := new(ListQuarantinedResponse)
+	err := c.cc.Invoke(ctx, "/crispy.tunnel.TunnelService/ListQuarantined", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tunnelServiceClient) PurgeTunnels(ctx context.Context, in *PurgeTunnelsRequest, opts ...grpc.CallOption) (*PurgeTunnelsResponse, error) {
+	out := new(PurgeTunnelsResponse)
+	err := c.cc.Invoke(ctx, "/crispy.tunnel.TunnelService/PurgeTunnels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tunnelServiceClient) GetTunnelSysctls(ctx context.Context, in *GetTunnelSysctlsRequest, opts ...grpc.CallOption) (*GetTunnelSysctlsResponse, error) {
+	out := new(GetTunnelSysctlsResponse)
+	err := c.cc.Invoke(ctx, "/crispy.tunnel.TunnelService/GetTunnelSysctls", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tunnelServiceClient) Health(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*HealthResponse, error) {
+	out := new(HealthResponse)
+	err := c.cc.Invoke(ctx, "/crispy.tunnel.TunnelService/Health", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tunnelServiceClient) MigrateNames(ctx context.Context, in *MigrateNamesRequest, opts ...grpc.CallOption) (*MigrateNamesResponse, error) {
+	out := new(MigrateNamesResponse)
+	err := c.cc.Invoke(ctx, "/crispy.tunnel.TunnelService/MigrateNames", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tunnelServiceClient) WatchTunnelEvents(ctx context.Context, in *WatchTunnelEventsRequest, opts ...grpc.CallOption) (TunnelService_WatchTunnelEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TunnelService_ServiceDesc.Streams[0], "/crispy.tunnel.TunnelService/WatchTunnelEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tunnelServiceWatchTunnelEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TunnelService_WatchTunnelEventsClient interface {
+	Recv() (*TunnelEvent, error)
+	grpc.ClientStream
+}
+
+type tunnelServiceWatchTunnelEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *tunnelServiceWatchTunnelEventsClient) Recv() (*TunnelEvent, error) {
+	m := new(TunnelEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tunnelServiceClient) GetServiceInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ServiceInfo, error) {
+	out := new(ServiceInfo)
+	err := c.cc.Invoke(ctx, "/crispy.tunnel.TunnelService/GetServiceInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tunnelServiceClient) RepairSysctls(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RepairSysctlsResponse, error) {
+	out := new(RepairSysctlsResponse)
+	err := c.cc.Invoke(ctx, "/crispy.tunnel.TunnelService/RepairSysctls", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tunnelServiceClient) ValidateTunnels(ctx context.Context, in *ValidateTunnelsRequest, opts ...grpc.CallOption) (*ValidateTunnelsResponse, error) {
+	out := new(ValidateTunnelsResponse)
+	err := c.cc.Invoke(ctx, "/crispy.tunnel.TunnelService/ValidateTunnels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tunnelServiceClient) DiffState(ctx context.Context, in *DiffStateRequest, opts ...grpc.CallOption) (*DiffStateResponse, error) {
+	out := new(DiffStateResponse)
+	err := c.cc.Invoke(ctx, "/crispy.tunnel.TunnelService/DiffState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tunnelServiceClient) SetTunnelState(ctx context.Context, in *SetTunnelStateRequest, opts ...grpc.CallOption) (*TunnelInfo, error) {
+	out := new(TunnelInfo)
+	err := c.cc.Invoke(ctx, "/crispy.tunnel.TunnelService/SetTunnelState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tunnelServiceClient) GetCapacity(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CapacityResponse, error) {
+	out := new(CapacityResponse)
+	err := c.cc.Invoke(ctx, "/crispy.tunnel.TunnelService/GetCapacity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tunnelServiceClient) SwapRemote(ctx context.Context, in *SwapRemoteRequest, opts ...grpc.CallOption) (*SwapRemoteResponse, error) {
+	out := new(SwapRemoteResponse)
+	err := c.cc.Invoke(ctx, "/crispy.tunnel.TunnelService/SwapRemote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tunnelServiceClient) ApplySysctls(ctx context.Context, in *ApplySysctlsRequest, opts ...grpc.CallOption) (*ApplySysctlsResponse, error) {
+	out := new(ApplySysctlsResponse)
+	err := c.cc.Invoke(ctx, "/crispy.tunnel.TunnelService/ApplySysctls", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tunnelServiceClient) AcknowledgeUnmanaged(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AcknowledgeUnmanagedResponse, error) {
+	out := new(AcknowledgeUnmanagedResponse)
+	err := c.cc.Invoke(ctx, "/crispy.tunnel.TunnelService/AcknowledgeUnmanaged", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tunnelServiceClient) FindByRemote(ctx context.Context, in *FindByRemoteRequest, opts ...grpc.CallOption) (*FindByRemoteResponse, error) {
+	out := new(FindByRemoteResponse)
+	err := c.cc.Invoke(ctx, "/crispy.tunnel.TunnelService/FindByRemote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tunnelServiceClient) SetTunnelAlias(ctx context.Context, in *SetTunnelAliasRequest, opts ...grpc.CallOption) (*TunnelInfo, error) {
+	out := new(TunnelInfo)
+	err := c.cc.Invoke(ctx, "/crispy.tunnel.TunnelService/SetTunnelAlias", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tunnelServiceClient) CordonTunnel(ctx context.Context, in *CordonTunnelRequest, opts ...grpc.CallOption) (*TunnelInfo, error) {
+	out := new(TunnelInfo)
+	err := c.cc.Invoke(ctx, "/crispy.tunnel.TunnelService/CordonTunnel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tunnelServiceClient) UncordonTunnel(ctx context.Context, in *UncordonTunnelRequest, opts ...grpc.CallOption) (*TunnelInfo, error) {
+	out := new(TunnelInfo)
+	err := c.cc.Invoke(ctx, "/crispy.tunnel.TunnelService/UncordonTunnel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tunnelServiceClient) RemoveByRemoteCIDR(ctx context.Context, in *RemoveByRemoteCIDRRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, "/crispy.tunnel.TunnelService/RemoveByRemoteCIDR", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tunnelServiceClient) SetMaintenanceMode(ctx context.Context, in *SetMaintenanceModeRequest, opts ...grpc.CallOption) (*SetMaintenanceModeResponse, error) {
+	out := new(SetMaintenanceModeResponse)
+	err := c.cc.Invoke(ctx, "/crispy.tunnel.TunnelService/SetMaintenanceMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tunnelServiceClient) GetMetricsSnapshot(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MetricsSnapshot, error) {
+	out := new(MetricsSnapshot)
+	err := c.cc.Invoke(ctx, "/crispy.tunnel.TunnelService/GetMetricsSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tunnelServiceClient) GetTunnelHistory(ctx context.Context, in *GetTunnelHistoryRequest, opts ...grpc.CallOption) (*GetTunnelHistoryResponse, error) {
+	out := new(GetTunnelHistoryResponse)
+	err := c.cc.Invoke(ctx, "/crispy.tunnel.TunnelService/GetTunnelHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tunnelServiceClient) Apply(ctx context.Context, in *ApplyRequest, opts ...grpc.CallOption) (*ApplyResponse, error) {
+	out := new(ApplyResponse)
+	err := c.cc.Invoke(ctx, "/crispy.tunnel.TunnelService/Apply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TunnelServiceServer is the server API for TunnelService service.
 // All implementations must embed UnimplementedTunnelServiceServer
 // for forward compatibility
 type TunnelServiceServer interface {
 	//AddTunnel добавить туннель
-	AddTunnel(context.Context, *AddTunnelRequest) (*emptypb.Empty, error)
+	AddTunnel(context.Context, *AddTunnelRequest) (*AddTunnelResponse, error)
 	//RemoveTunnel удалить туннель
-	RemoveTunnel(context.Context, *RemoveTunnelRequest) (*emptypb.Empty, error)
+	RemoveTunnel(context.Context, *RemoveTunnelRequest) (*RemoveTunnelResponse, error)
+	//AddTunnels создать несколько туннелей; каждый туннель создается один раз даже если повторен в запросе
+	AddTunnels(context.Context, *AddTunnelsRequest) (*BatchResponse, error)
+	//RemoveTunnels удалить несколько туннелей; каждый туннель удаляется один раз даже если повторен в запросе
+	RemoveTunnels(context.Context, *RemoveTunnelsRequest) (*BatchResponse, error)
 	//GetState вернуть все туннели
-	GetState(context.Context, *emptypb.Empty) (*GetStateResponse, error)
+	//большой ответ можно сжать: gRPC клиент включает gzip через grpc.UseCompressor(gzip.Name),
+	//REST клиент - заголовком 'Accept-Encoding: gzip'
+	GetState(context.Context, *GetStateRequest) (*GetStateResponse, error)
+	//GetTunnel вернуть сведения о туннеле
+	GetTunnel(context.Context, *GetTunnelRequest) (*TunnelInfo, error)
+	//GetTunnels вернуть сведения о нескольких туннелях
+	GetTunnels(context.Context, *GetTunnelsRequest) (*GetTunnelsResponse, error)
+	//TestTunnel проверить связность через туннель
+	TestTunnel(context.Context, *TestTunnelRequest) (*TestTunnelResponse, error)
+	//TestAllTunnels проверить связность через все туннели сервиса
+	TestAllTunnels(context.Context, *TestAllTunnelsRequest) (*TestAllTunnelsResponse, error)
+	//ResolveName вычислить имя туннеля для IP ничего не создавая
+	ResolveName(context.Context, *ResolveNameRequest) (*ResolveNameResponse, error)
+	//CheckNameCollisions найти имена туннелей, которые получаются сразу из нескольких IP; ничего не создает
+	CheckNameCollisions(context.Context, *CheckNameCollisionsRequest) (*CheckNameCollisionsResponse, error)
+	//QuarantineTunnel выключить туннель и пометить его как карантинный вместо удаления
+	QuarantineTunnel(context.Context, *QuarantineTunnelRequest) (*emptypb.Empty, error)
+	//RestoreTunnel вернуть туннель из карантина
+	RestoreTunnel(context.Context, *RestoreTunnelRequest) (*emptypb.Empty, error)
+	//ListQuarantined вернуть все туннели в карантине
+	ListQuarantined(context.Context, *emptypb.Empty) (*ListQuarantinedResponse, error)
+	//PurgeTunnels удалить туннели в карантине (или все туннели при 'all') кроме оставляемых; требует 'confirm'
+	PurgeTunnels(context.Context, *PurgeTunnelsRequest) (*PurgeTunnelsResponse, error)
+	//GetTunnelSysctls вернуть текущие значения sysctl интерфейса туннеля
+	GetTunnelSysctls(context.Context, *GetTunnelSysctlsRequest) (*GetTunnelSysctlsResponse, error)
+	//Health проверить работоспособность сервиса
+	Health(context.Context, *emptypb.Empty) (*HealthResponse, error)
+	//MigrateNames переименовать туннели в соответствии с текущей схемой имен; исключенные из пакетных операций туннели пропускаются
+	MigrateNames(context.Context, *MigrateNamesRequest) (*MigrateNamesResponse, error)
+	//WatchTunnelEvents следить за событиями туннелей
+	WatchTunnelEvents(*WatchTunnelEventsRequest, TunnelService_WatchTunnelEventsServer) error
+	//GetServiceInfo вернуть настройки сервиса
+	GetServiceInfo(context.Context, *emptypb.Empty) (*ServiceInfo, error)
+	//RepairSysctls привести sysctl туннелей к значениям политики с учетом sysctl их шаблонов
+	RepairSysctls(context.Context, *emptypb.Empty) (*RepairSysctlsResponse, error)
+	//ValidateTunnels проверить адреса туннелей ничего не создавая; адреса самого хоста не годятся
+	ValidateTunnels(context.Context, *ValidateTunnelsRequest) (*ValidateTunnelsResponse, error)
+	//DiffState сравнить желаемый набор туннелей с имеющимся ничего не меняя
+	DiffState(context.Context, *DiffStateRequest) (*DiffStateResponse, error)
+	//SetTunnelState поднять или опустить интерфейс туннеля
+	SetTunnelState(context.Context, *SetTunnelStateRequest) (*TunnelInfo, error)
+	//GetCapacity лимит туннелей и текущее их количество
+	GetCapacity(context.Context, *emptypb.Empty) (*CapacityResponse, error)
+	//SwapRemote перенести туннель на новый адрес удаленной стороны вместе с маршрутами
+	SwapRemote(context.Context, *SwapRemoteRequest) (*SwapRemoteResponse, error)
+	//ApplySysctls установить sysctl всех туннелей сервиса; значения остаются политикой для новых туннелей и RepairSysctls
+	ApplySysctls(context.Context, *ApplySysctlsRequest) (*ApplySysctlsResponse, error)
+	//AcknowledgeUnmanaged подтвердить найденные при старте чужие интерфейсы и выйти из safe mode
+	AcknowledgeUnmanaged(context.Context, *emptypb.Empty) (*AcknowledgeUnmanagedResponse, error)
+	//FindByRemote найти туннели по адресу или сети удаленной стороны
+	FindByRemote(context.Context, *FindByRemoteRequest) (*FindByRemoteResponse, error)
+	//SetTunnelAlias задать или убрать человекочитаемый псевдоним туннеля
+	SetTunnelAlias(context.Context, *SetTunnelAliasRequest) (*TunnelInfo, error)
+	//CordonTunnel исключить туннель из пакетных операций и сверки состояния, не удаляя его
+	CordonTunnel(context.Context, *CordonTunnelRequest) (*TunnelInfo, error)
+	//UncordonTunnel вернуть туннель в пакетные операции и сверку состояния
+	UncordonTunnel(context.Context, *UncordonTunnelRequest) (*TunnelInfo, error)
+	//RemoveByRemoteCIDR удалить все управляемые туннели, удаленная сторона которых в сети; требует confirm
+	RemoveByRemoteCIDR(context.Context, *RemoveByRemoteCIDRRequest) (*BatchResponse, error)
+	//SetMaintenanceMode войти в режим обслуживания узла (изменения запрещены) или выйти из него
+	SetMaintenanceMode(context.Context, *SetMaintenanceModeRequest) (*SetMaintenanceModeResponse, error)
+	//GetMetricsSnapshot текущие значения метрик сервиса; для окружений, где Прометеус не может их забрать
+	GetMetricsSnapshot(context.Context, *emptypb.Empty) (*MetricsSnapshot, error)
+	//GetTunnelHistory история изменений туннеля этим сервисом; история должна быть включена
+	GetTunnelHistory(context.Context, *GetTunnelHistoryRequest) (*GetTunnelHistoryResponse, error)
+	//Apply привести управляемые туннели к желаемому состоянию: создать недостающие, пересоздать отличающиеся
+	//параметрами и с prune удалить не указанные; документ проверяется целиком до изменений, но применяется не атомарно
+	Apply(context.Context, *ApplyRequest) (*ApplyResponse, error)
 	mustEmbedUnimplementedTunnelServiceServer()
 }
 
@@ -79,15 +550,117 @@ type TunnelServiceServer interface {
 type UnimplementedTunnelServiceServer struct {
 }
 
-func (UnimplementedTunnelServiceServer) AddTunnel(context.Context, *AddTunnelRequest) (*emptypb.Empty, error) {
+func (UnimplementedTunnelServiceServer) AddTunnel(context.Context, *AddTunnelRequest) (*AddTunnelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddTunnel not implemented")
 }
-func (UnimplementedTunnelServiceServer) RemoveTunnel(context.Context, *RemoveTunnelRequest) (*emptypb.Empty, error) {
+func (UnimplementedTunnelServiceServer) RemoveTunnel(context.Context, *RemoveTunnelRequest) (*RemoveTunnelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveTunnel not implemented")
 }
-func (UnimplementedTunnelServiceServer) GetState(context.Context, *emptypb.Empty) (*GetStateResponse, error) {
+func (UnimplementedTunnelServiceServer) AddTunnels(context.Context, *AddTunnelsRequest) (*BatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTunnels not implemented")
+}
+func (UnimplementedTunnelServiceServer) RemoveTunnels(context.Context, *RemoveTunnelsRequest) (*BatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTunnels not implemented")
+}
+func (UnimplementedTunnelServiceServer) GetState(context.Context, *GetStateRequest) (*GetStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetState not implemented")
 }
+func (UnimplementedTunnelServiceServer) GetTunnel(context.Context, *GetTunnelRequest) (*TunnelInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTunnel not implemented")
+}
+func (UnimplementedTunnelServiceServer) GetTunnels(context.Context, *GetTunnelsRequest) (*GetTunnelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTunnels not implemented")
+}
+func (UnimplementedTunnelServiceServer) TestTunnel(context.Context, *TestTunnelRequest) (*TestTunnelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestTunnel not implemented")
+}
+func (UnimplementedTunnelServiceServer) TestAllTunnels(context.Context, *TestAllTunnelsRequest) (*TestAllTunnelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestAllTunnels not implemented")
+}
+func (UnimplementedTunnelServiceServer) ResolveName(context.Context, *ResolveNameRequest) (*ResolveNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveName not implemented")
+}
+func (UnimplementedTunnelServiceServer) CheckNameCollisions(context.Context, *CheckNameCollisionsRequest) (*CheckNameCollisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckNameCollisions not implemented")
+}
+func (UnimplementedTunnelServiceServer) QuarantineTunnel(context.Context, *QuarantineTunnelRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuarantineTunnel not implemented")
+}
+func (UnimplementedTunnelServiceServer) RestoreTunnel(context.Context, *RestoreTunnelRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreTunnel not implemented")
+}
+func (UnimplementedTunnelServiceServer) ListQuarantined(context.Context, *emptypb.Empty) (*ListQuarantinedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQuarantined not implemented")
+}
+func (UnimplementedTunnelServiceServer) PurgeTunnels(context.Context, *PurgeTunnelsRequest) (*PurgeTunnelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeTunnels not implemented")
+}
+func (UnimplementedTunnelServiceServer) GetTunnelSysctls(context.Context, *GetTunnelSysctlsRequest) (*GetTunnelSysctlsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTunnelSysctls not implemented")
+}
+func (UnimplementedTunnelServiceServer) Health(context.Context, *emptypb.Empty) (*HealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Health not implemented")
+}
+func (UnimplementedTunnelServiceServer) MigrateNames(context.Context, *MigrateNamesRequest) (*MigrateNamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateNames not implemented")
+}
+func (UnimplementedTunnelServiceServer) WatchTunnelEvents(*WatchTunnelEventsRequest, TunnelService_WatchTunnelEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchTunnelEvents not implemented")
+}
+func (UnimplementedTunnelServiceServer) GetServiceInfo(context.Context, *emptypb.Empty) (*ServiceInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceInfo not implemented")
+}
+func (UnimplementedTunnelServiceServer) RepairSysctls(context.Context, *emptypb.Empty) (*RepairSysctlsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepairSysctls not implemented")
+}
+func (UnimplementedTunnelServiceServer) ValidateTunnels(context.Context, *ValidateTunnelsRequest) (*ValidateTunnelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateTunnels not implemented")
+}
+func (UnimplementedTunnelServiceServer) DiffState(context.Context, *DiffStateRequest) (*DiffStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffState not implemented")
+}
+func (UnimplementedTunnelServiceServer) SetTunnelState(context.Context, *SetTunnelStateRequest) (*TunnelInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTunnelState not implemented")
+}
+func (UnimplementedTunnelServiceServer) GetCapacity(context.Context, *emptypb.Empty) (*CapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCapacity not implemented")
+}
+func (UnimplementedTunnelServiceServer) SwapRemote(context.Context, *SwapRemoteRequest) (*SwapRemoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapRemote not implemented")
+}
+func (UnimplementedTunnelServiceServer) ApplySysctls(context.Context, *ApplySysctlsRequest) (*ApplySysctlsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplySysctls not implemented")
+}
+func (UnimplementedTunnelServiceServer) AcknowledgeUnmanaged(context.Context, *emptypb.Empty) (*AcknowledgeUnmanagedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeUnmanaged not implemented")
+}
+func (UnimplementedTunnelServiceServer) FindByRemote(context.Context, *FindByRemoteRequest) (*FindByRemoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindByRemote not implemented")
+}
+func (UnimplementedTunnelServiceServer) SetTunnelAlias(context.Context, *SetTunnelAliasRequest) (*TunnelInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTunnelAlias not implemented")
+}
+func (UnimplementedTunnelServiceServer) CordonTunnel(context.Context, *CordonTunnelRequest) (*TunnelInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CordonTunnel not implemented")
+}
+func (UnimplementedTunnelServiceServer) UncordonTunnel(context.Context, *UncordonTunnelRequest) (*TunnelInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UncordonTunnel not implemented")
+}
+func (UnimplementedTunnelServiceServer) RemoveByRemoteCIDR(context.Context, *RemoveByRemoteCIDRRequest) (*BatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveByRemoteCIDR not implemented")
+}
+func (UnimplementedTunnelServiceServer) SetMaintenanceMode(context.Context, *SetMaintenanceModeRequest) (*SetMaintenanceModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMaintenanceMode not implemented")
+}
+func (UnimplementedTunnelServiceServer) GetMetricsSnapshot(context.Context, *emptypb.Empty) (*MetricsSnapshot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetricsSnapshot not implemented")
+}
+func (UnimplementedTunnelServiceServer) GetTunnelHistory(context.Context, *GetTunnelHistoryRequest) (*GetTunnelHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTunnelHistory not implemented")
+}
+func (UnimplementedTunnelServiceServer) Apply(context.Context, *ApplyRequest) (*ApplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Apply not implemented")
+}
 func (UnimplementedTunnelServiceServer) mustEmbedUnimplementedTunnelServiceServer() {}
 
 // UnsafeTunnelServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -137,20 +710,635 @@ func _TunnelService_RemoveTunnel_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TunnelService_GetState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _TunnelService_AddTunnels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTunnelsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TunnelServiceServer).GetState(ctx, in)
+		return srv.(TunnelServiceServer).AddTunnels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crispy.tunnel.TunnelService/AddTunnels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TunnelServiceServer).AddTunnels(ctx, req.(*AddTunnelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TunnelService_RemoveTunnels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTunnelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TunnelServiceServer).RemoveTunnels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crispy.tunnel.TunnelService/RemoveTunnels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TunnelServiceServer).RemoveTunnels(ctx, req.(*RemoveTunnelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TunnelService_GetState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TunnelServiceServer).GetState(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
 		FullMethod: "/crispy.tunnel.TunnelService/GetState",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TunnelServiceServer).GetState(ctx, req.(*emptypb.Empty))
+		return srv.(TunnelServiceServer).GetState(ctx, req.(*GetStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TunnelService_GetTunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTunnelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TunnelServiceServer).GetTunnel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crispy.tunnel.TunnelService/GetTunnel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TunnelServiceServer).GetTunnel(ctx, req.(*GetTunnelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TunnelService_GetTunnels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTunnelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TunnelServiceServer).GetTunnels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crispy.tunnel.TunnelService/GetTunnels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TunnelServiceServer).GetTunnels(ctx, req.(*GetTunnelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TunnelService_TestTunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestTunnelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TunnelServiceServer).TestTunnel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crispy.tunnel.TunnelService/TestTunnel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TunnelServiceServer).TestTunnel(ctx, req.(*TestTunnelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TunnelService_TestAllTunnels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestAllTunnelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TunnelServiceServer).TestAllTunnels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crispy.tunnel.TunnelService/TestAllTunnels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TunnelServiceServer).TestAllTunnels(ctx, req.(*TestAllTunnelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TunnelService_ResolveName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TunnelServiceServer).ResolveName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crispy.tunnel.TunnelService/ResolveName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TunnelServiceServer).ResolveName(ctx, req.(*ResolveNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TunnelService_CheckNameCollisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckNameCollisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TunnelServiceServer).CheckNameCollisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crispy.tunnel.TunnelService/CheckNameCollisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TunnelServiceServer).CheckNameCollisions(ctx, req.(*CheckNameCollisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TunnelService_QuarantineTunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuarantineTunnelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TunnelServiceServer).QuarantineTunnel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crispy.tunnel.TunnelService/QuarantineTunnel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TunnelServiceServer).QuarantineTunnel(ctx, req.(*QuarantineTunnelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TunnelService_RestoreTunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreTunnelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TunnelServiceServer).RestoreTunnel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crispy.tunnel.TunnelService/RestoreTunnel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TunnelServiceServer).RestoreTunnel(ctx, req.(*RestoreTunnelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TunnelService_ListQuarantined_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TunnelServiceServer).ListQuarantined(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crispy.tunnel.TunnelService/ListQuarantined",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TunnelServiceServer).ListQuarantined(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TunnelService_PurgeTunnels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeTunnelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TunnelServiceServer).PurgeTunnels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crispy.tunnel.TunnelService/PurgeTunnels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TunnelServiceServer).PurgeTunnels(ctx, req.(*PurgeTunnelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TunnelService_GetTunnelSysctls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTunnelSysctlsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TunnelServiceServer).GetTunnelSysctls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crispy.tunnel.TunnelService/GetTunnelSysctls",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TunnelServiceServer).GetTunnelSysctls(ctx, req.(*GetTunnelSysctlsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TunnelService_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TunnelServiceServer).Health(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crispy.tunnel.TunnelService/Health",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TunnelServiceServer).Health(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TunnelService_MigrateNames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrateNamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TunnelServiceServer).MigrateNames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crispy.tunnel.TunnelService/MigrateNames",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TunnelServiceServer).MigrateNames(ctx, req.(*MigrateNamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TunnelService_WatchTunnelEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchTunnelEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TunnelServiceServer).WatchTunnelEvents(m, &tunnelServiceWatchTunnelEventsServer{stream})
+}
+
+type TunnelService_WatchTunnelEventsServer interface {
+	Send(*TunnelEvent) error
+	grpc.ServerStream
+}
+
+type tunnelServiceWatchTunnelEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *tunnelServiceWatchTunnelEventsServer) Send(m *TunnelEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TunnelService_GetServiceInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TunnelServiceServer).GetServiceInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crispy.tunnel.TunnelService/GetServiceInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TunnelServiceServer).GetServiceInfo(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TunnelService_RepairSysctls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TunnelServiceServer).RepairSysctls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crispy.tunnel.TunnelService/RepairSysctls",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TunnelServiceServer).RepairSysctls(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TunnelService_ValidateTunnels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateTunnelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TunnelServiceServer).ValidateTunnels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crispy.tunnel.TunnelService/ValidateTunnels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TunnelServiceServer).ValidateTunnels(ctx, req.(*ValidateTunnelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TunnelService_DiffState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TunnelServiceServer).DiffState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crispy.tunnel.TunnelService/DiffState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TunnelServiceServer).DiffState(ctx, req.(*DiffStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TunnelService_SetTunnelState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTunnelStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TunnelServiceServer).SetTunnelState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crispy.tunnel.TunnelService/SetTunnelState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TunnelServiceServer).SetTunnelState(ctx, req.(*SetTunnelStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TunnelService_GetCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TunnelServiceServer).GetCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crispy.tunnel.TunnelService/GetCapacity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TunnelServiceServer).GetCapacity(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TunnelService_SwapRemote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwapRemoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TunnelServiceServer).SwapRemote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crispy.tunnel.TunnelService/SwapRemote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TunnelServiceServer).SwapRemote(ctx, req.(*SwapRemoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TunnelService_ApplySysctls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplySysctlsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TunnelServiceServer).ApplySysctls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crispy.tunnel.TunnelService/ApplySysctls",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TunnelServiceServer).ApplySysctls(ctx, req.(*ApplySysctlsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TunnelService_AcknowledgeUnmanaged_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TunnelServiceServer).AcknowledgeUnmanaged(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crispy.tunnel.TunnelService/AcknowledgeUnmanaged",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TunnelServiceServer).AcknowledgeUnmanaged(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TunnelService_FindByRemote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindByRemoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TunnelServiceServer).FindByRemote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crispy.tunnel.TunnelService/FindByRemote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TunnelServiceServer).FindByRemote(ctx, req.(*FindByRemoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TunnelService_SetTunnelAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTunnelAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TunnelServiceServer).SetTunnelAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crispy.tunnel.TunnelService/SetTunnelAlias",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TunnelServiceServer).SetTunnelAlias(ctx, req.(*SetTunnelAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TunnelService_CordonTunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CordonTunnelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TunnelServiceServer).CordonTunnel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crispy.tunnel.TunnelService/CordonTunnel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TunnelServiceServer).CordonTunnel(ctx, req.(*CordonTunnelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TunnelService_UncordonTunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UncordonTunnelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TunnelServiceServer).UncordonTunnel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crispy.tunnel.TunnelService/UncordonTunnel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TunnelServiceServer).UncordonTunnel(ctx, req.(*UncordonTunnelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TunnelService_RemoveByRemoteCIDR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveByRemoteCIDRRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TunnelServiceServer).RemoveByRemoteCIDR(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crispy.tunnel.TunnelService/RemoveByRemoteCIDR",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TunnelServiceServer).RemoveByRemoteCIDR(ctx, req.(*RemoveByRemoteCIDRRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TunnelService_SetMaintenanceMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMaintenanceModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TunnelServiceServer).SetMaintenanceMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crispy.tunnel.TunnelService/SetMaintenanceMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TunnelServiceServer).SetMaintenanceMode(ctx, req.(*SetMaintenanceModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TunnelService_GetMetricsSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TunnelServiceServer).GetMetricsSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crispy.tunnel.TunnelService/GetMetricsSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TunnelServiceServer).GetMetricsSnapshot(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TunnelService_GetTunnelHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTunnelHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TunnelServiceServer).GetTunnelHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crispy.tunnel.TunnelService/GetTunnelHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TunnelServiceServer).GetTunnelHistory(ctx, req.(*GetTunnelHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TunnelService_Apply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TunnelServiceServer).Apply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crispy.tunnel.TunnelService/Apply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TunnelServiceServer).Apply(ctx, req.(*ApplyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -170,11 +1358,149 @@ var TunnelService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveTunnel",
 			Handler:    _TunnelService_RemoveTunnel_Handler,
 		},
+		{
+			MethodName: "AddTunnels",
+			Handler:    _TunnelService_AddTunnels_Handler,
+		},
+		{
+			MethodName: "RemoveTunnels",
+			Handler:    _TunnelService_RemoveTunnels_Handler,
+		},
 		{
 			MethodName: "GetState",
 			Handler:    _TunnelService_GetState_Handler,
 		},
+		{
+			MethodName: "GetTunnel",
+			Handler:    _TunnelService_GetTunnel_Handler,
+		},
+		{
+			MethodName: "GetTunnels",
+			Handler:    _TunnelService_GetTunnels_Handler,
+		},
+		{
+			MethodName: "TestTunnel",
+			Handler:    _TunnelService_TestTunnel_Handler,
+		},
+		{
+			MethodName: "TestAllTunnels",
+			Handler:    _TunnelService_TestAllTunnels_Handler,
+		},
+		{
+			MethodName: "ResolveName",
+			Handler:    _TunnelService_ResolveName_Handler,
+		},
+		{
+			MethodName: "CheckNameCollisions",
+			Handler:    _TunnelService_CheckNameCollisions_Handler,
+		},
+		{
+			MethodName: "QuarantineTunnel",
+			Handler:    _TunnelService_QuarantineTunnel_Handler,
+		},
+		{
+			MethodName: "RestoreTunnel",
+			Handler:    _TunnelService_RestoreTunnel_Handler,
+		},
+		{
+			MethodName: "ListQuarantined",
+			Handler:    _TunnelService_ListQuarantined_Handler,
+		},
+		{
+			MethodName: "PurgeTunnels",
+			Handler:    _TunnelService_PurgeTunnels_Handler,
+		},
+		{
+			MethodName: "GetTunnelSysctls",
+			Handler:    _TunnelService_GetTunnelSysctls_Handler,
+		},
+		{
+			MethodName: "Health",
+			Handler:    _TunnelService_Health_Handler,
+		},
+		{
+			MethodName: "MigrateNames",
+			Handler:    _TunnelService_MigrateNames_Handler,
+		},
+		{
+			MethodName: "GetServiceInfo",
+			Handler:    _TunnelService_GetServiceInfo_Handler,
+		},
+		{
+			MethodName: "RepairSysctls",
+			Handler:    _TunnelService_RepairSysctls_Handler,
+		},
+		{
+			MethodName: "ValidateTunnels",
+			Handler:    _TunnelService_ValidateTunnels_Handler,
+		},
+		{
+			MethodName: "DiffState",
+			Handler:    _TunnelService_DiffState_Handler,
+		},
+		{
+			MethodName: "SetTunnelState",
+			Handler:    _TunnelService_SetTunnelState_Handler,
+		},
+		{
+			MethodName: "GetCapacity",
+			Handler:    _TunnelService_GetCapacity_Handler,
+		},
+		{
+			MethodName: "SwapRemote",
+			Handler:    _TunnelService_SwapRemote_Handler,
+		},
+		{
+			MethodName: "ApplySysctls",
+			Handler:    _TunnelService_ApplySysctls_Handler,
+		},
+		{
+			MethodName: "AcknowledgeUnmanaged",
+			Handler:    _TunnelService_AcknowledgeUnmanaged_Handler,
+		},
+		{
+			MethodName: "FindByRemote",
+			Handler:    _TunnelService_FindByRemote_Handler,
+		},
+		{
+			MethodName: "SetTunnelAlias",
+			Handler:    _TunnelService_SetTunnelAlias_Handler,
+		},
+		{
+			MethodName: "CordonTunnel",
+			Handler:    _TunnelService_CordonTunnel_Handler,
+		},
+		{
+			MethodName: "UncordonTunnel",
+			Handler:    _TunnelService_UncordonTunnel_Handler,
+		},
+		{
+			MethodName: "RemoveByRemoteCIDR",
+			Handler:    _TunnelService_RemoveByRemoteCIDR_Handler,
+		},
+		{
+			MethodName: "SetMaintenanceMode",
+			Handler:    _TunnelService_SetMaintenanceMode_Handler,
+		},
+		{
+			MethodName: "GetMetricsSnapshot",
+			Handler:    _TunnelService_GetMetricsSnapshot_Handler,
+		},
+		{
+			MethodName: "GetTunnelHistory",
+			Handler:    _TunnelService_GetTunnelHistory_Handler,
+		},
+		{
+			MethodName: "Apply",
+			Handler:    _TunnelService_Apply_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchTunnelEvents",
+			Handler:       _TunnelService_WatchTunnelEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "tunnel/tunnel.proto",
 }